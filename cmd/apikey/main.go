@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"snitch/internal/shared/apikey"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+const usage = `Usage: apikey [flags] <command>
+
+Commands:
+  create <name>    Issue a new API key
+  list             List API keys
+  revoke <key-id>  Revoke an API key
+  rotate <key-id>  Issue a replacement for an API key and revoke the old one
+
+Flags:
+`
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func main() {
+	dbHost := flag.String("db-host", envOr("SNITCH_DB_HOST", "localhost"), "database service host")
+	dbPort := flag.String("db-port", envOr("SNITCH_DB_PORT", "5200"), "database service port")
+	caCertPath := flag.String("ca-cert", envOr("CA_CERT_FILE_PATH", "./certs/ca/ca-cert.pem"), "CA certificate the database service is verified against")
+	certPath := flag.String("cert", envOr("CERT_FILE_PATH", "./certs/admin/cert.pem"), "client certificate presented to the database service")
+	keyPath := flag.String("key", envOr("KEY_FILE_PATH", "./certs/admin/key.pem"), "private key for the client certificate")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	caCert, err := os.ReadFile(*caCertPath)
+	if err != nil {
+		log.Fatalf("Failed to read CA certificate: %v", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		log.Fatalf("Failed to parse CA certificate")
+	}
+
+	cert, err := tls.LoadX509KeyPair(*certPath, *keyPath)
+	if err != nil {
+		log.Fatalf("Failed to load client certificate: %v", err)
+	}
+
+	dbClient := snitchv1connect.NewDatabaseServiceClient(
+		&http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:      caCertPool,
+					Certificates: []tls.Certificate{cert},
+				},
+			},
+		},
+		"https://"+net.JoinHostPort(*dbHost, *dbPort),
+	)
+
+	ctx := context.Background()
+
+	switch {
+	case args[0] == "create" && len(args) == 2:
+		err = createKey(ctx, dbClient, args[1])
+	case args[0] == "list" && len(args) == 1:
+		err = listKeys(ctx, dbClient)
+	case args[0] == "revoke" && len(args) == 2:
+		err = revokeKey(ctx, dbClient, args[1])
+	case args[0] == "rotate" && len(args) == 2:
+		err = rotateKey(ctx, dbClient, args[1])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// createKey issues a key and prints it; only its hash is stored, so this is the only time it can be read
+func createKey(ctx context.Context, dbClient snitchv1connect.DatabaseServiceClient, name string) error {
+	key, err := apikey.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	createResp, err := dbClient.CreateAPIKey(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateAPIKeyRequest{
+		KeyId:   key.ID,
+		Name:    name,
+		KeyHash: key.Hash(),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to store API key: %w", err)
+	}
+
+	fmt.Printf("Created API key %s (%s)\n", createResp.Msg.Key.KeyId, createResp.Msg.Key.Name)
+	fmt.Println("Set it as SNITCH_API_KEY for the bot; it won't be shown again:")
+	fmt.Println(key.String())
+	return nil
+}
+
+func listKeys(ctx context.Context, dbClient snitchv1connect.DatabaseServiceClient) error {
+	listResp, err := dbClient.ListAPIKeys(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListAPIKeysRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "KEY ID\tNAME\tCREATED\tREVOKED")
+	for _, key := range listResp.Msg.Keys {
+		revoked := "-"
+		if key.RevokedAt != nil {
+			revoked = *key.RevokedAt
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", key.KeyId, key.Name, key.CreatedAt, revoked)
+	}
+	return writer.Flush()
+}
+
+func revokeKey(ctx context.Context, dbClient snitchv1connect.DatabaseServiceClient, keyID string) error {
+	revokeResp, err := dbClient.RevokeAPIKey(ctx, connect.NewRequest(&snitchv1.DatabaseServiceRevokeAPIKeyRequest{KeyId: keyID}))
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	fmt.Printf("Revoked API key %s (%s)\n", revokeResp.Msg.Key.KeyId, revokeResp.Msg.Key.Name)
+	return nil
+}
+
+// rotateKey replaces a key with a new one of the same name; the old key stops working once the backend's cache expires
+func rotateKey(ctx context.Context, dbClient snitchv1connect.DatabaseServiceClient, keyID string) error {
+	getResp, err := dbClient.GetAPIKey(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetAPIKeyRequest{KeyId: keyID}))
+	if err != nil {
+		return fmt.Errorf("failed to get API key: %w", err)
+	}
+	if getResp.Msg.Key.RevokedAt != nil {
+		return fmt.Errorf("API key %s is already revoked", keyID)
+	}
+
+	if err := createKey(ctx, dbClient, getResp.Msg.Key.Name); err != nil {
+		return err
+	}
+	return revokeKey(ctx, dbClient, keyID)
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"expvar"
 	"flag"
 	"fmt"
 	"log"
@@ -18,12 +20,22 @@ import (
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
 	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
 	port := flag.Int("port", 4200, "port to listen on")
+	slowSubscriberPolicy := flag.String("slow-subscriber-policy", string(service.DefaultSlowSubscriberPolicy), "what to do when a subscriber's channel is full: drop-oldest, block or disconnect")
+	slowSubscriberTimeout := flag.Duration("slow-subscriber-timeout", service.DefaultSlowSubscriberTimeout, "how long the block policy waits for a slow subscriber")
+	metricsAddr := flag.String("metrics-addr", "127.0.0.1:4201", "address to serve expvar metrics on, empty to disable")
+	outboxInterval := flag.Duration("outbox-interval", service.DefaultOutboxInterval, "how often to check group outboxes for unpublished events")
 	flag.Parse()
 
+	policy, err := service.ParseSlowSubscriberPolicy(*slowSubscriberPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	config, err := backendconfig.FromEnv()
 	if err != nil {
 		log.Fatalf("Failed to load backend configuration from environment: %v", err)
@@ -39,6 +51,12 @@ func main() {
 		log.Fatal("Failed to parse CA certificate")
 	}
 
+	// Load TLS certificate for backend service; it also authenticates the backend to the database service
+	cert, err := tls.LoadX509KeyPair(config.CertFilePath, config.KeyFilePath)
+	if err != nil {
+		log.Fatal("Failed to load TLS certificate", "error", err)
+	}
+
 	// Create database service client (Connect RPC over HTTPS)
 	dbServiceURL, err := config.DbURL()
 	if err != nil {
@@ -49,28 +67,43 @@ func main() {
 			Timeout: 30 * time.Second,
 			Transport: &http.Transport{
 				TLSClientConfig: &tls.Config{
-					RootCAs: caCertPool,
+					RootCAs:      caCertPool,
+					Certificates: []tls.Certificate{cert},
 				},
 			},
 		},
 		dbServiceURL.String(),
 	)
 
-	eventService := service.NewEventService(dbClient)
-	registrar := service.NewRegisterServer(dbClient)
+	// Replicas share events through Redis; a single backend delivers them in memory
+	var eventService *service.EventService
+	if config.RedisURL != "" {
+		redisOptions, err := redis.ParseURL(config.RedisURL)
+		if err != nil {
+			log.Fatalf("Failed to parse redis URL: %v", err)
+		}
+		broker := service.NewRedisBroker(redis.NewClient(redisOptions), service.DefaultRedisChannel, slog.Default())
+		eventService, err = service.NewEventServiceWithBroker(context.Background(), dbClient, broker)
+		if err != nil {
+			log.Fatalf("Failed to subscribe to redis: %v", err)
+		}
+	} else {
+		eventService = service.NewEventService(dbClient)
+	}
+	eventService.SetSlowSubscriberPolicy(policy, *slowSubscriberTimeout)
+	expvar.Publish("events", expvar.Func(eventService.Metrics))
+	go eventService.RunOutboxRelay(context.Background(), *outboxInterval)
+	registrar := service.NewRegisterServer(dbClient, eventService)
 	reportServer := service.NewReportServer(dbClient, eventService)
 	userServer := service.NewUserServer(dbClient)
-
-	// Load TLS certificate for backend service
-	cert, err := tls.LoadX509KeyPair(config.CertFilePath, config.KeyFilePath)
-	if err != nil {
-		log.Fatal("Failed to load TLS certificate", "error", err)
-	}
+	moderationServer := service.NewModerationServer(dbClient, eventService)
+	configServer := service.NewConfigServer(dbClient)
 
 	baseInterceptors := connect.WithInterceptors(
 		interceptor.NewRecoveryInterceptor(),
 		interceptor.NewLogInterceptor(),
 		interceptor.NewTraceInterceptor(),
+		interceptor.NewAuthInterceptor(dbClient),
 	)
 
 	mux := http.NewServeMux()
@@ -78,6 +111,8 @@ func main() {
 	mux.Handle(snitchv1connect.NewReportServiceHandler(reportServer, baseInterceptors))
 	mux.Handle(snitchv1connect.NewUserHistoryServiceHandler(userServer, baseInterceptors))
 	mux.Handle(snitchv1connect.NewEventServiceHandler(eventService, baseInterceptors))
+	mux.Handle(snitchv1connect.NewModerationServiceHandler(moderationServer, baseInterceptors))
+	mux.Handle(snitchv1connect.NewConfigServiceHandler(configServer, baseInterceptors))
 
 	// Configure TLS
 	tlsConfig := &tls.Config{
@@ -93,6 +128,20 @@ func main() {
 		// No ReadTimeout/WriteTimeout for streaming support
 	}
 
+	// Metrics are kept off the public listener, which has no authentication outside the RPC handlers
+	if *metricsAddr != "" {
+		go func() {
+			metricsServer := &http.Server{
+				Addr:              *metricsAddr,
+				Handler:           expvar.Handler(),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Metrics server stopped", "error", err)
+			}
+		}()
+	}
+
 	slog.Info("Starting backend service with TLS", "port", *port, "db_url", dbServiceURL, "cert", config.CertFilePath)
 
 	if err := server.ListenAndServeTLS("", ""); !errors.Is(err, http.ErrServerClosed) {
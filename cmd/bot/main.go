@@ -14,10 +14,14 @@ import (
 
 	"snitch/internal/bot/botconfig"
 	"snitch/internal/bot/events"
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/moderation"
 	"snitch/internal/bot/slashcommand"
 	"snitch/internal/bot/slashcommand/handler"
 	"snitch/internal/bot/slashcommand/middleware"
+	"snitch/internal/shared/apikey"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -39,20 +43,58 @@ func main() {
 		log.Fatalf("Failed to parse CA certificate")
 	}
 
+	// Every backend call, including the event streams, authenticates with the bot's API key
+	// and targets the group picked for the interaction it was made for, if any
 	httpClient := http.Client{
 		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs: caCertPool,
+		Transport: &groupselector.Transport{
+			Base: &apikey.Transport{
+				Key: config.APIKey,
+				Base: &http.Transport{
+					TLSClientConfig: &tls.Config{
+						RootCAs: caCertPool,
+					},
+				},
 			},
 		},
 	}
 
+	reportForms := handler.NewReportForms()
+
+	mainSession, err := discordgo.New("Bot " + config.DiscordToken)
+	if err != nil {
+		log.Fatalf("Failed to create Discord session: %v", err)
+	}
+
+	slogger := slog.Default()
+	backendURL, err := config.BackendURL()
+	if err != nil {
+		log.Fatalf("Failed to get backend URL: %v", err)
+	}
+
+	// Commands that put a server into a group subscribe to its events right away
+	eventClient := events.NewClient(backendURL.String(), mainSession, slogger, &httpClient)
+
 	// initialize map of command name to command handler
 	commandHandlers := map[string]slashcommand.SlashCommandHandlerFunc{
-		"register": handler.CreateRegisterCommandHandler(config, httpClient),
-		"report":   handler.CreateReportCommandHandler(config, httpClient),
-		"user":     handler.CreateUserCommandHandler(config, httpClient),
+		"register":       handler.CreateRegisterCommandHandler(config, httpClient, eventClient),
+		"report":         handler.CreateReportCommandHandler(config, httpClient, reportForms),
+		"user":           handler.CreateUserCommandHandler(config, httpClient),
+		"config":         handler.CreateConfigCommandHandler(config, httpClient),
+		"Report user":    handler.CreateReportUserCommandHandler(reportForms),
+		"Report message": handler.CreateReportMessageCommandHandler(reportForms),
+	}
+
+	// initialize map of modal custom ID name to modal submit handler
+	modalHandlers := map[string]slashcommand.SlashCommandHandlerFunc{
+		handler.ReportFormModal: handler.CreateReportFormHandler(config, httpClient, reportForms),
+	}
+
+	// initialize map of component custom ID name to component handler
+	componentHandlers := map[string]slashcommand.SlashCommandHandlerFunc{
+		handler.ReportsPageButton:    handler.CreateReportsPageHandler(config, httpClient),
+		moderation.BanApprovalButton: handler.CreateBanApprovalHandler(),
+		moderation.JoinRequestButton: handler.CreateJoinRequestHandler(config, httpClient, eventClient),
 	}
 
 	commands := slashcommand.InitializeCommands()
@@ -65,27 +107,22 @@ func main() {
 		}
 	}
 
-	mainSession, err := discordgo.New("Bot " + config.DiscordToken)
-	if err != nil {
-		log.Fatalf("Failed to create Discord session: %v", err)
-	}
+	// Member joins are a privileged intent and must also be enabled in the developer portal
+	mainSession.Identify.Intents = discordgo.IntentsAllWithoutPrivileged | discordgo.IntentsGuildMembers
 	defer func() {
 		if err := mainSession.Close(); err != nil {
 			log.Printf("Failed to close Discord session: %v", err)
 		}
 	}()
 
-	slogger := slog.Default()
-	backendURL, err := config.BackendURL()
-	if err != nil {
-		log.Fatalf("Failed to get backend URL: %v", err)
-	}
-
-	eventClient := events.NewClient(backendURL.String(), mainSession, slogger, &httpClient)
+	configClient := snitchv1connect.NewConfigServiceClient(&httpClient, backendURL.String())
 
-	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_CREATED, events.CreateReportCreatedHandler(slogger))
-	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_DELETED, events.CreateReportDeletedHandler(slogger))
-	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_USER_BANNED, events.CreateUserBannedHandler(slogger))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_CREATED, events.CreateReportCreatedHandler(slogger, eventClient, configClient))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_DELETED, events.CreateReportDeletedHandler(slogger, eventClient, configClient))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_USER_BANNED, events.CreateUserBannedHandler(slogger, eventClient, configClient))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_JOIN_REQUESTED, events.CreateJoinRequestedHandler(slogger, eventClient, configClient))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED, events.CreateServerRemovedHandler(slogger, eventClient, configClient))
+	eventClient.RegisterHandler(snitchv1.EventType_EVENT_TYPE_GROUP_DELETED, events.CreateGroupDeletedHandler(slogger, eventClient, configClient))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -106,19 +143,45 @@ func main() {
 			}
 		}
 	})
-	// setup our listeners for interaction events (a user using a slash command)
+	// setup our listeners for interaction events (a user using a slash command, context menu command, modal or button)
+	// Each interaction is checked against the guild's permission policy for the commands it belongs to
+	interactionPermission := handler.InteractionPermission
+	withMiddleware := func(handler slashcommand.SlashCommandHandlerFunc) slashcommand.SlashCommandHandlerFunc {
+		handler = middleware.RequirePermission(handler, configClient, interactionPermission)
+		handler = middleware.GroupSelector(handler)
+		handler = middleware.ResponseTime(handler)
+		handler = middleware.Recovery(handler)
+		handler = middleware.Log(handler)
+		handler = middleware.WithTimeout(handler, time.Second*10)
+		return handler
+	}
 
-	handler := func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	commandHandler := func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
 		if handler, ok := commandHandlers[interaction.ApplicationCommandData().Name]; ok {
 			handler(ctx, session, interaction)
 		}
 	}
-	handler = middleware.RequireManageServer(handler)
-	handler = middleware.ResponseTime(handler)
-	handler = middleware.Recovery(handler)
-	handler = middleware.Log(handler)
-	handler = middleware.WithTimeout(handler, time.Second*10)
-	mainSession.AddHandler(slashcommand.SlashCommandHandlerFunc(handler).Adapt())
+	mainSession.AddHandler(withMiddleware(commandHandler).Adapt())
+
+	modalHandler := func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if handler, ok := modalHandlers[slashcommand.InteractionName(interaction)]; ok {
+			handler(ctx, session, interaction)
+		}
+	}
+	mainSession.AddHandler(withMiddleware(modalHandler).AdaptModalSubmit())
+
+	componentHandler := func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if handler, ok := componentHandlers[slashcommand.InteractionName(interaction)]; ok {
+			handler(ctx, session, interaction)
+		}
+	}
+	mainSession.AddHandler(withMiddleware(componentHandler).AdaptMessageComponent())
+
+	// Record bans so they can be propagated to the rest of the group
+	mainSession.AddHandler(moderation.CreateGuildBanAddHandler(config, httpClient, slogger))
+
+	// Warn servers when a member with reports in the group joins
+	mainSession.AddHandler(moderation.CreateGuildMemberAddHandler(config, httpClient, slogger))
 
 	if err = mainSession.Open(); err != nil {
 		log.Fatalf("Failed to open Discord session: %v", err)
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"flag"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 
 	"snitch/internal/db/dbconfig"
 	"snitch/internal/db/service"
+	"snitch/internal/db/service/interceptor"
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
 	"connectrpc.com/connect"
@@ -24,8 +26,31 @@ func fatal(msg string, args ...any) {
 	os.Exit(1)
 }
 
+// purgeDeletedGroups periodically removes deleted groups once their grace period ends
+func purgeDeletedGroups(ctx context.Context, dbService *service.DatabaseService, slogger *slog.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := dbService.PurgeDeletedGroups(ctx)
+		if err != nil {
+			slogger.Error("Failed to purge deleted groups", "error", err)
+		} else if purged > 0 {
+			slogger.Info("Purged deleted groups", "count", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func main() {
 	port := flag.Int("port", 5200, "port to listen on")
+	gracePeriod := flag.Duration("group-deletion-grace", service.DefaultGroupDeletionGracePeriod, "how long a deleted group can be restored before it is purged")
+	purgeInterval := flag.Duration("purge-interval", time.Hour, "how often to purge deleted groups past their grace period")
 	flag.Parse()
 
 	config, err := dbconfig.FromEnv()
@@ -47,30 +72,47 @@ func main() {
 		}
 	}()
 
+	dbService.GroupDeletionGracePeriod = *gracePeriod
+
 	// Run migrations on all existing tenant databases
 	if err := dbService.RunMigrationsOnAllTenants(ctx); err != nil {
 		slogger.Warn("Failed to migrate some tenant databases", "error", err)
 	}
 
+	go purgeDeletedGroups(ctx, dbService, slogger, *purgeInterval)
+
 	// Load TLS certificate
 	cert, err := tls.LoadX509KeyPair(config.CertFilePath, config.KeyFilePath)
 	if err != nil {
 		fatal("Failed to load TLS certificate", "error", err)
 	}
 
+	// Load the CA that client certificates must be signed by
+	caCert, err := os.ReadFile(config.CaCertFilePath)
+	if err != nil {
+		fatal("Failed to read CA certificate", "error", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		fatal("Failed to parse CA certificate", "path", config.CaCertFilePath)
+	}
+
 	// Setup gRPC handlers
 	mux := http.NewServeMux()
-	mux.Handle(snitchv1connect.NewDatabaseServiceHandler(dbService, connect.WithInterceptors()))
+	identityInterceptor := interceptor.NewIdentityInterceptor(slogger, "snitch-backend", "snitch-admin")
+	mux.Handle(snitchv1connect.NewDatabaseServiceHandler(dbService, connect.WithInterceptors(identityInterceptor)))
 
 	// Configure TLS
 	tlsConfig := &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		NextProtos:   []string{"h2"},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
 	}
 
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%d", *port),
-		Handler:           mux,
+		Handler:           interceptor.WithClientIdentity(mux),
 		TLSConfig:         tlsConfig,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
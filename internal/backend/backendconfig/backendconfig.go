@@ -10,6 +10,8 @@ import (
 
 type BackendConfig struct {
 	CertFilePath, KeyFilePath, CaCertFilePath, DbHost, DbPort string
+	// RedisURL is optional; when set, events are shared with other backend replicas through Redis
+	RedisURL string
 }
 
 func FromEnv() (BackendConfig, error) {
@@ -30,6 +32,7 @@ func FromEnv() (BackendConfig, error) {
 		CaCertFilePath: get("CA_CERT_FILE_PATH"),
 		DbHost:         get("SNITCH_DB_HOST"),
 		DbPort:         get("SNITCH_DB_PORT"),
+		RedisURL:       os.Getenv("SNITCH_REDIS_URL"),
 	}
 
 	if len(missing) > 0 {
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+)
+
+// DeliverFunc hands an event published through a broker to this replica's subscribers
+type DeliverFunc func(ctx context.Context, event *snitchv1.SubscribeResponse)
+
+// Broker fans published events out to every backend replica, each of which delivers them to its own subscribers.
+// Events reach the broker after being stored in the event log, so subscribers catch up on anything a broker loses.
+type Broker interface {
+	// Publish sends an event to every replica subscribed to the broker, including this one
+	Publish(ctx context.Context, event *snitchv1.SubscribeResponse) error
+	// Subscribe passes the events published by any replica to deliver until the context is cancelled.
+	// It returns once the subscription is in place, so no event published afterwards is missed.
+	Subscribe(ctx context.Context, deliver DeliverFunc) error
+}
+
+// MemoryBroker delivers events within a single backend process
+type MemoryBroker struct {
+	mu       sync.RWMutex
+	nextID   int
+	delivers map[int]DeliverFunc
+}
+
+// NewMemoryBroker creates a broker for a backend running as a single replica
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		delivers: make(map[int]DeliverFunc),
+	}
+}
+
+// Publish delivers an event to the subscribers before returning
+func (b *MemoryBroker) Publish(ctx context.Context, event *snitchv1.SubscribeResponse) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, deliver := range b.delivers {
+		deliver(ctx, event)
+	}
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, deliver DeliverFunc) error {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.delivers[id] = deliver
+	b.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		delete(b.delivers, id)
+		b.mu.Unlock()
+	})
+	return nil
+}
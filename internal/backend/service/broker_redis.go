@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultRedisChannel is the Redis pub/sub channel backend replicas share events on
+const DefaultRedisChannel = "snitch:events"
+
+// redisDeliveryBufferSize is how many received events can wait to be delivered to this replica's subscribers
+const redisDeliveryBufferSize = 1024
+
+// RedisBroker shares events between backend replicas over Redis pub/sub. Redis doesn't keep messages for replicas
+// that are reconnecting or falling behind; their subscribers catch up from the event log instead.
+type RedisBroker struct {
+	client  *redis.Client
+	channel string
+	slogger *slog.Logger
+	// dropped counts received events that were dropped because delivery fell behind
+	dropped atomic.Int64
+}
+
+// NewRedisBroker creates a broker on a Redis channel
+func NewRedisBroker(client *redis.Client, channel string, slogger *slog.Logger) *RedisBroker {
+	return &RedisBroker{
+		client:  client,
+		channel: channel,
+		slogger: slogger,
+	}
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, event *snitchv1.SubscribeResponse) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, b.channel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish event to redis: %w", err)
+	}
+	return nil
+}
+
+// Subscribe reads events from Redis on one goroutine and delivers them on another, so slow delivery never holds up
+// reading and makes go-redis drop messages unnoticed. Events that don't fit the delivery buffer are counted as dropped.
+func (b *RedisBroker) Subscribe(ctx context.Context, deliver DeliverFunc) error {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+
+	// Wait for the subscription to be confirmed, so events published after returning aren't missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return fmt.Errorf("failed to subscribe to redis channel %s: %w", b.channel, err)
+	}
+
+	pending := make(chan *snitchv1.SubscribeResponse, redisDeliveryBufferSize)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-pending:
+				deliver(ctx, event)
+			}
+		}
+	}()
+
+	go func() {
+		defer pubsub.Close()
+
+		// The channel reconnects to Redis by itself when the connection drops
+		messages := pubsub.Channel(redis.WithChannelSize(redisDeliveryBufferSize))
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				event := &snitchv1.SubscribeResponse{}
+				if err := proto.Unmarshal([]byte(message.Payload), event); err != nil {
+					b.slogger.Error("Failed to decode event from redis", "channel", b.channel, "error", err)
+					continue
+				}
+
+				select {
+				case pending <- event:
+				default:
+					dropped := b.dropped.Add(1)
+					b.slogger.Warn("Dropping event from redis, delivery is falling behind",
+						"channel", b.channel, "group_id", event.GroupId, "sequence", event.Sequence, "dropped", dropped)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Dropped returns how many received events were dropped because delivery fell behind
+func (b *RedisBroker) Dropped() int64 {
+	return b.dropped.Load()
+}
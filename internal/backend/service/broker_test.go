@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestMemoryBroker_Unsubscribe(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	delivered := make(chan *snitchv1.SubscribeResponse, 1)
+	if err := broker.Subscribe(ctx, func(_ context.Context, event *snitchv1.SubscribeResponse) {
+		delivered <- event
+	}); err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+
+	if err := broker.Publish(t.Context(), &snitchv1.SubscribeResponse{GroupId: TEST_GROUP_ID}); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if len(delivered) != 1 {
+		t.Fatal("Expected the event to be delivered before Publish returned")
+	}
+	<-delivered
+
+	cancel()
+	// The subscription is removed in its own goroutine once the context is cancelled
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		broker.mu.RLock()
+		remaining := len(broker.delivers)
+		broker.mu.RUnlock()
+		if remaining == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Subscription not removed after its context was cancelled")
+		}
+	}
+	if err := broker.Publish(t.Context(), &snitchv1.SubscribeResponse{GroupId: TEST_GROUP_ID}); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if len(delivered) != 0 {
+		t.Error("Expected no delivery after the subscription was cancelled")
+	}
+}
+
+func TestRedisBroker_FansOutAcrossReplicas(t *testing.T) {
+	server := miniredis.RunT(t)
+
+	// Two backend replicas sharing one event log and one Redis
+	dbClient := newEventLogStub()
+	newReplica := func() *EventService {
+		client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+		t.Cleanup(func() { client.Close() })
+
+		broker := NewRedisBroker(client, DefaultRedisChannel, slog.Default())
+		replica, err := NewEventServiceWithBroker(t.Context(), dbClient, broker)
+		if err != nil {
+			t.Fatalf("NewEventServiceWithBroker failed: %v", err)
+		}
+		return replica
+	}
+	publisher := newReplica()
+	receiver := newReplica()
+
+	eventChan := make(chan *snitchv1.SubscribeResponse, 10)
+	receiver.mu.Lock()
+	receiver.subscribers[&subscriber{eventChan: eventChan, groupID: TEST_GROUP_ID}] = true
+	receiver.mu.Unlock()
+
+	event := &snitchv1.SubscribeResponse{
+		Type:     snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+		GroupId:  TEST_GROUP_ID,
+		ServerId: TEST_SERVER_ID,
+	}
+	if err := publisher.PublishEvent(t.Context(), event); err != nil {
+		t.Fatalf("PublishEvent failed: %v", err)
+	}
+
+	select {
+	case receivedEvent := <-eventChan:
+		if receivedEvent.Sequence != 1 || receivedEvent.IdempotencyKey != event.IdempotencyKey {
+			t.Errorf("Expected the published event with sequence 1, got %v", receivedEvent)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Event published on one replica not received by a subscriber of the other")
+	}
+}
+
+func TestRedisBroker_CountsDropsWhenDeliveryFallsBehind(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	broker := NewRedisBroker(client, DefaultRedisChannel, slog.Default())
+
+	// Delivery is stuck, so received events pile up until the buffer is full
+	release := make(chan struct{})
+	defer close(release)
+	if err := broker.Subscribe(t.Context(), func(ctx context.Context, _ *snitchv1.SubscribeResponse) {
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+	}); err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+
+	for range redisDeliveryBufferSize + 10 {
+		if err := broker.Publish(t.Context(), &snitchv1.SubscribeResponse{GroupId: TEST_GROUP_ID}); err != nil {
+			t.Fatalf("Publish failed: %v", err)
+		}
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); broker.Dropped() == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected events to be counted as dropped once the delivery buffer was full")
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+type ConfigServer struct {
+	dbClient snitchv1connect.DatabaseServiceClient
+}
+
+func NewConfigServer(dbClient snitchv1connect.DatabaseServiceClient) *ConfigServer {
+	return &ConfigServer{
+		dbClient: dbClient,
+	}
+}
+
+func (s *ConfigServer) GetServerConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.GetServerConfigRequest],
+) (*connect.Response[snitchv1.GetServerConfigResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	getConfigReq := &snitchv1.DatabaseServiceGetServerConfigRequest{
+		ServerId: serverID,
+	}
+	getConfigResp, err := s.dbClient.GetServerConfig(ctx, connect.NewRequest(getConfigReq))
+	if err != nil {
+		slogger.Error("Failed to get server config", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchv1.GetServerConfigResponse{
+		Config: getConfigResp.Msg.Config,
+	}), nil
+}
+
+func (s *ConfigServer) UpdateServerConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.UpdateServerConfigRequest],
+) (*connect.Response[snitchv1.UpdateServerConfigResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	if req.Msg.BanPolicy != nil && *req.Msg.BanPolicy == snitchv1.BanPolicy_BAN_POLICY_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("ban policy must be specified"))
+	}
+	if req.Msg.WatchlistThreshold != nil && *req.Msg.WatchlistThreshold < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("watchlist threshold must not be negative"))
+	}
+
+	updateConfigReq := &snitchv1.DatabaseServiceUpdateServerConfigRequest{
+		ServerId:           serverID,
+		BanPolicy:          req.Msg.BanPolicy,
+		OutputChannelId:    req.Msg.OutputChannelId,
+		WatchlistThreshold: req.Msg.WatchlistThreshold,
+	}
+	updateConfigResp, err := s.dbClient.UpdateServerConfig(ctx, connect.NewRequest(updateConfigReq))
+	if err != nil {
+		slogger.Error("Failed to update server config", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.Info("Server config updated", "server_id", serverID)
+
+	return connect.NewResponse(&snitchv1.UpdateServerConfigResponse{
+		Config: updateConfigResp.Msg.Config,
+	}), nil
+}
+
+func (s *ConfigServer) GetGroupConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.GetGroupConfigRequest],
+) (*connect.Response[snitchv1.GetGroupConfigResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	serverID := req.Header().Get(ServerIDHeader)
+	groupID, _, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	getConfigResp, err := s.dbClient.GetGroupConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetGroupConfigRequest{
+		GroupId: groupID,
+	}))
+	if err != nil {
+		slogger.Error("Failed to get group config", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchv1.GetGroupConfigResponse{
+		Config: getConfigResp.Msg.Config,
+	}), nil
+}
+
+func (s *ConfigServer) UpdateGroupConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.UpdateGroupConfigRequest],
+) (*connect.Response[snitchv1.UpdateGroupConfigResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	serverID := req.Header().Get(ServerIDHeader)
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchv1.GroupRole_GROUP_ROLE_ADMIN, "change group settings"); err != nil {
+		return nil, err
+	}
+
+	updateConfigResp, err := s.dbClient.UpdateGroupConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdateGroupConfigRequest{
+		GroupId:              groupID,
+		JoinRequiresApproval: req.Msg.JoinRequiresApproval,
+	}))
+	if err != nil {
+		slogger.Error("Failed to update group config", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.Info("Group config updated", "group_id", groupID, "server_id", serverID)
+
+	return connect.NewResponse(&snitchv1.UpdateGroupConfigResponse{
+		Config: updateConfigResp.Msg.Config,
+	}), nil
+}
+
+func (s *ConfigServer) GetPermissionPolicy(
+	ctx context.Context,
+	req *connect.Request[snitchv1.GetPermissionPolicyRequest],
+) (*connect.Response[snitchv1.GetPermissionPolicyResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header; the policy belongs to the guild, so no group is needed
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	policyResp, err := s.dbClient.GetPermissionPolicy(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetPermissionPolicyRequest{
+		ServerId: serverID,
+	}))
+	if err != nil {
+		slogger.Error("Failed to get permission policy", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchv1.GetPermissionPolicyResponse{
+		Policy: policyResp.Msg.Policy,
+	}), nil
+}
+
+func (s *ConfigServer) UpdatePermissionPolicy(
+	ctx context.Context,
+	req *connect.Request[snitchv1.UpdatePermissionPolicyRequest],
+) (*connect.Response[snitchv1.UpdatePermissionPolicyResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	if req.Msg.Permission == snitchv1.BotPermission_BOT_PERMISSION_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("permission must be specified"))
+	}
+
+	policyResp, err := s.dbClient.UpdatePermissionPolicy(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdatePermissionPolicyRequest{
+		ServerId:   serverID,
+		Permission: req.Msg.Permission,
+		RoleIds:    req.Msg.RoleIds,
+	}))
+	if err != nil {
+		slogger.Error("Failed to update permission policy", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.Info("Permission policy updated", "server_id", serverID, "permission", req.Msg.Permission)
+
+	return connect.NewResponse(&snitchv1.UpdatePermissionPolicyResponse{
+		Policy: policyResp.Msg.Policy,
+	}), nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+)
+
+// SlowSubscriberPolicy decides what happens to an event when a subscriber's channel is full
+type SlowSubscriberPolicy string
+
+const (
+	// SlowSubscriberBlock waits up to the block timeout for the subscriber to make room, then drops the event.
+	// Publishing waits too, so one slow subscriber delays every event of the backend.
+	SlowSubscriberBlock SlowSubscriberPolicy = "block"
+	// SlowSubscriberDropOldest drops the subscriber's oldest queued event to make room for the new one
+	SlowSubscriberDropOldest SlowSubscriberPolicy = "drop-oldest"
+	// SlowSubscriberDisconnect ends the subscriber's stream, so it resubscribes and replays from its cursor
+	SlowSubscriberDisconnect SlowSubscriberPolicy = "disconnect"
+)
+
+// DefaultSlowSubscriberPolicy never holds up publishing; subscribers catch up on dropped events from the event log
+const DefaultSlowSubscriberPolicy = SlowSubscriberDropOldest
+
+// DefaultSlowSubscriberTimeout is how long the block policy waits for a subscriber to make room
+const DefaultSlowSubscriberTimeout = time.Second
+
+// subscriberBufferSize is how many events a subscriber can have queued
+const subscriberBufferSize = 256
+
+// errSubscriberTooSlow ends the stream of a subscriber disconnected by the disconnect policy
+var errSubscriberTooSlow = errors.New("subscriber fell behind")
+
+// ParseSlowSubscriberPolicy parses a policy name as given on the command line
+func ParseSlowSubscriberPolicy(name string) (SlowSubscriberPolicy, error) {
+	switch policy := SlowSubscriberPolicy(name); policy {
+	case SlowSubscriberBlock, SlowSubscriberDropOldest, SlowSubscriberDisconnect:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown slow subscriber policy %q, expected %s, %s or %s",
+			name, SlowSubscriberBlock, SlowSubscriberDropOldest, SlowSubscriberDisconnect)
+	}
+}
+
+// deliveryCounters count what happened to the events meant for subscribers
+type deliveryCounters struct {
+	delivered atomic.Int64
+	dropped   atomic.Int64
+	filtered  atomic.Int64
+}
+
+// SetSlowSubscriberPolicy sets how events are delivered to subscribers whose channel is full. The block timeout
+// only applies to the block policy.
+func (s *EventService) SetSlowSubscriberPolicy(policy SlowSubscriberPolicy, blockTimeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.slowPolicy = policy
+	s.blockTimeout = blockTimeout
+}
+
+// broadcast hands an event to the subscribers of its group. Subscribers with a full channel are handled by the
+// slow subscriber policy without holding up the others; events they miss are replayed from the event log.
+func (s *EventService) broadcast(ctx context.Context, event *snitchv1.SubscribeResponse) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+	slogger.Debug("Publishing event", "type", event.Type, "server_id", event.ServerId)
+
+	s.mu.RLock()
+	policy, blockTimeout := s.slowPolicy, s.blockTimeout
+	var targets []*subscriber
+	for sub := range s.subscribers {
+		// Filter by group - only send events to subscribers in the same group
+		if sub.groupID != event.GroupId {
+			s.countFiltered(sub)
+			continue
+		}
+		targets = append(targets, sub)
+	}
+	s.mu.RUnlock()
+
+	if len(targets) == 0 {
+		slogger.Debug("No subscribers available for event", "type", event.Type, "group_id", event.GroupId)
+		return
+	}
+
+	var slow []*subscriber
+	for _, sub := range targets {
+		select {
+		case sub.eventChan <- event:
+			s.countDelivered(sub)
+		default:
+			slow = append(slow, sub)
+		}
+	}
+
+	// Only the subscribers that couldn't take the event are retried
+	var wg sync.WaitGroup
+	for _, sub := range slow {
+		slogger.Warn("Subscriber channel full", "type", event.Type, "group_id", event.GroupId, "server_id", sub.serverID, "policy", policy)
+
+		switch policy {
+		case SlowSubscriberDropOldest:
+			s.deliverDroppingOldest(sub, event)
+		case SlowSubscriberDisconnect:
+			s.countDropped(sub)
+			s.disconnected.Add(1)
+			if sub.disconnect != nil {
+				sub.disconnect(errSubscriberTooSlow)
+			}
+		default:
+			wg.Go(func() {
+				s.deliverBlocking(ctx, sub, event, blockTimeout)
+			})
+		}
+	}
+	wg.Wait()
+
+	slogger.Debug("Event delivered", "type", event.Type, "subscribers", len(targets), "slow", len(slow))
+}
+
+// deliverBlocking waits for a subscriber to make room for an event, dropping it after the timeout
+func (s *EventService) deliverBlocking(ctx context.Context, sub *subscriber, event *snitchv1.SubscribeResponse, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case sub.eventChan <- event:
+		s.countDelivered(sub)
+	case <-sub.done:
+		// Unsubscribed while waiting
+	case <-timer.C:
+		s.countDropped(sub)
+	case <-ctx.Done():
+		s.countDropped(sub)
+	}
+}
+
+// deliverDroppingOldest makes room for an event by dropping the subscriber's oldest queued events
+func (s *EventService) deliverDroppingOldest(sub *subscriber, event *snitchv1.SubscribeResponse) {
+	for {
+		select {
+		case sub.eventChan <- event:
+			s.countDelivered(sub)
+			return
+		default:
+		}
+
+		select {
+		case <-sub.eventChan:
+			s.countDropped(sub)
+		default:
+			// The channel can't hold any event, so the new one is dropped instead
+			s.countDropped(sub)
+			return
+		}
+	}
+}
+
+func (s *EventService) countDelivered(sub *subscriber) {
+	s.counters.delivered.Add(1)
+	sub.counters.delivered.Add(1)
+}
+
+func (s *EventService) countDropped(sub *subscriber) {
+	s.counters.dropped.Add(1)
+	sub.counters.dropped.Add(1)
+}
+
+func (s *EventService) countFiltered(sub *subscriber) {
+	s.counters.filtered.Add(1)
+	sub.counters.filtered.Add(1)
+}
+
+// Metrics returns the delivery counters, in total and per subscriber, for publishing with expvar
+func (s *EventService) Metrics() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subscribers := make([]map[string]any, 0, len(s.subscribers))
+	for sub := range s.subscribers {
+		subscribers = append(subscribers, map[string]any{
+			"group_id":  sub.groupID,
+			"server_id": sub.serverID,
+			"queued":    len(sub.eventChan),
+			"delivered": sub.counters.delivered.Load(),
+			"dropped":   sub.counters.dropped.Load(),
+			"filtered":  sub.counters.filtered.Load(),
+		})
+	}
+
+	metrics := map[string]any{
+		"slow_subscriber_policy": s.slowPolicy,
+		"delivered":              s.counters.delivered.Load(),
+		"dropped":                s.counters.dropped.Load(),
+		"filtered":               s.counters.filtered.Load(),
+		"disconnected":           s.disconnected.Load(),
+		"subscribers":            subscribers,
+	}
+	// Networked brokers can drop events before they reach this replica's subscribers
+	if broker, ok := s.broker.(interface{ Dropped() int64 }); ok {
+		metrics["broker_dropped"] = broker.Dropped()
+	}
+	return metrics
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"snitch/internal/backend/service/interceptor"
@@ -13,27 +16,130 @@ import (
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 )
 
+// replayPageSize is how many stored events a resuming subscriber is sent per database call
+const replayPageSize = 100
+
 type subscriber struct {
 	eventChan chan *snitchv1.SubscribeResponse
 	groupID   string
+	serverID  string
+	// eventTypes are the types the subscriber asked for; when empty it is sent every type
+	eventTypes map[snitchv1.EventType]bool
+	// done is closed once the subscriber's stream ends, and disconnect ends it early
+	done       <-chan struct{}
+	disconnect context.CancelCauseFunc
+	counters   deliveryCounters
+}
+
+// newEventTypeFilter returns the set of event types a subscriber asked for
+func newEventTypeFilter(eventTypes []snitchv1.EventType) (map[snitchv1.EventType]bool, error) {
+	filter := make(map[snitchv1.EventType]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if eventType == snitchv1.EventType_EVENT_TYPE_UNSPECIFIED {
+			return nil, fmt.Errorf("event type must be specified")
+		}
+		filter[eventType] = true
+	}
+	return filter, nil
+}
+
+// wants reports whether the subscriber asked for events of the event's type
+func (sub *subscriber) wants(event *snitchv1.SubscribeResponse) bool {
+	return len(sub.eventTypes) == 0 || sub.eventTypes[event.Type]
 }
 
 type EventService struct {
 	subscribers map[*subscriber]bool
 	mu          sync.RWMutex
-	dbClient    snitchv1connect.DatabaseServiceClient
+	// publishMu keeps events stored and delivered in sequence order
+	publishMu sync.Mutex
+	dbClient  snitchv1connect.DatabaseServiceClient
+	// broker fans events out to the subscribers of every backend replica
+	broker Broker
+	// slowPolicy decides what happens to events for subscribers whose channel is full
+	slowPolicy   SlowSubscriberPolicy
+	blockTimeout time.Duration
+	counters     deliveryCounters
+	disconnected atomic.Int64
+	// outboxWake tells the outbox relay that events were written to a group outbox
+	outboxWake chan struct{}
 }
 
+// NewEventService creates an event service for a backend running as a single replica
 func NewEventService(dbClient snitchv1connect.DatabaseServiceClient) *EventService {
+	s := newEventService(dbClient, NewMemoryBroker())
+	// Subscribing to a memory broker can't fail
+	_ = s.broker.Subscribe(context.Background(), s.broadcast)
+	return s
+}
+
+// NewEventServiceWithBroker creates an event service that shares events with other backend replicas through a broker,
+// delivering them to its subscribers until the context is cancelled
+func NewEventServiceWithBroker(
+	ctx context.Context,
+	dbClient snitchv1connect.DatabaseServiceClient,
+	broker Broker,
+) (*EventService, error) {
+	s := newEventService(dbClient, broker)
+	if err := broker.Subscribe(ctx, s.broadcast); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newEventService(dbClient snitchv1connect.DatabaseServiceClient, broker Broker) *EventService {
 	return &EventService{
-		subscribers: make(map[*subscriber]bool),
-		dbClient:    dbClient,
+		subscribers:  make(map[*subscriber]bool),
+		dbClient:     dbClient,
+		broker:       broker,
+		slowPolicy:   DefaultSlowSubscriberPolicy,
+		blockTimeout: DefaultSlowSubscriberTimeout,
+		outboxWake:   make(chan struct{}, 1),
 	}
 }
 
-// Subscribe implements the streaming RPC for real-time events
+// subscriptionGroup resolves the group a subscriber listens to; servers in several groups open one stream per group.
+// A requested group ID must be one of the server's groups.
+func (s *EventService) subscriptionGroup(ctx context.Context, header http.Header, groupID string) (string, error) {
+	serverID := header.Get(interceptor.ServerIDHeader)
+	if serverID == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID header is required"))
+	}
+
+	if groupID == "" {
+		findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{
+			ServerId:      serverID,
+			GroupSelector: groupSelector(header),
+		}))
+		if err != nil {
+			return "", connect.NewError(connect.CodeOf(err), err)
+		}
+		return findGroupResp.Msg.GroupId, nil
+	}
+
+	listResp, err := s.dbClient.ListServerGroups(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListServerGroupsRequest{
+		ServerId: serverID,
+	}))
+	if err != nil {
+		return "", connect.NewError(connect.CodeOf(err), err)
+	}
+	if len(listResp.Msg.Groups) == 0 {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", serverID))
+	}
+	for _, group := range listResp.Msg.Groups {
+		if group.GroupId == groupID {
+			return groupID, nil
+		}
+	}
+
+	return "", connect.NewError(connect.CodePermissionDenied, fmt.Errorf("server %s is not in group %s", serverID, groupID))
+}
+
+// Subscribe implements the streaming RPC for real-time events. Subscribers that pass the last sequence they saw
+// are first sent the events they missed from the group's event log. Only the requested event types are sent.
 func (s *EventService) Subscribe(
 	ctx context.Context,
 	req *connect.Request[snitchv1.SubscribeRequest],
@@ -44,147 +150,212 @@ func (s *EventService) Subscribe(
 		slogger = slog.Default()
 	}
 
-	// Get server ID from request header and look up group ID
-	serverID := req.Header().Get(interceptor.ServerIDHeader)
-	if serverID == "" {
-		slogger.Error("Missing server ID header in subscription request")
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID header is required"))
+	eventTypes, err := newEventTypeFilter(req.Msg.EventTypes)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	// Find group ID for this server
-	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
-	}
-	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	serverID := req.Header().Get(interceptor.ServerIDHeader)
+	groupID, err := s.subscriptionGroup(ctx, req.Header(), req.Msg.GroupId)
 	if err != nil {
-		slogger.Error("Failed to find group ID for server", "server_id", serverID, "error", err)
-		return connect.NewError(connect.CodeNotFound, err)
+		slogger.Error("Failed to find group ID for server", "server_id", serverID, "group_id", req.Msg.GroupId, "error", err)
+		return err
 	}
-	groupID := findGroupResp.Msg.GroupId
-
-	slogger.Info("Client subscribed to events", "event_types", req.Msg.EventTypes, "group_id", groupID)
 
-	// Create a channel for this subscriber
-	eventChan := make(chan *snitchv1.SubscribeResponse, 256)
+	slogger.Info("Client subscribed to events", "event_types", req.Msg.EventTypes, "group_id", groupID, "last_seen_sequence", req.Msg.LastSeenSequence)
 
+	// The slow subscriber policy can end the stream early, leaving the subscriber to resubscribe from its cursor
+	ctx, disconnect := context.WithCancelCause(ctx)
 	sub := &subscriber{
-		eventChan: eventChan,
-		groupID:   groupID,
+		eventChan:  make(chan *snitchv1.SubscribeResponse, subscriberBufferSize),
+		groupID:    groupID,
+		serverID:   serverID,
+		eventTypes: eventTypes,
+		done:       ctx.Done(),
+		disconnect: disconnect,
 	}
 
-	// Register subscriber
+	// Register subscriber before replaying, so events published meanwhile are buffered rather than missed
 	s.mu.Lock()
 	s.subscribers[sub] = true
+	total := len(s.subscribers)
 	s.mu.Unlock()
 
-	slogger.Info("Subscribers current", "Subscribers", s.subscribers)
-	// Clean up on disconnect
+	slogger.Info("Subscribers current", "total_subscribers", total)
+	// Clean up on disconnect; the channel is left open since publishers may still be sending to it
 	defer func() {
 		s.mu.Lock()
 		delete(s.subscribers, sub)
-		close(eventChan)
+		total = len(s.subscribers)
 		s.mu.Unlock()
-		slogger.Info("Client unsubscribed from events", "total_subscribers", len(s.subscribers))
+		disconnect(nil)
+		slogger.Info("Client unsubscribed from events",
+			"total_subscribers", total,
+			"delivered", sub.counters.delivered.Load(),
+			"dropped", sub.counters.dropped.Load(),
+			"filtered", sub.counters.filtered.Load())
 	}()
 
+	// lastSent is the sequence of the latest event the subscriber has been sent
+	var lastSent int64
+	if req.Msg.LastSeenSequence != nil {
+		latestResp, err := s.dbClient.GetLatestEventSequence(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetLatestEventSequenceRequest{
+			GroupId: groupID,
+		}))
+		if err != nil {
+			slogger.Error("Failed to get latest event sequence", "group_id", groupID, "error", err)
+			return connect.NewError(connect.CodeOf(err), err)
+		}
+
+		lastSent = *req.Msg.LastSeenSequence
+		if lastSent > latestResp.Msg.Sequence {
+			// A cursor ahead of the log can't be resumed from, so the subscriber starts from the latest event
+			slogger.Warn("Subscriber cursor is ahead of the event log", "group_id", groupID, "last_seen_sequence", lastSent, "latest_sequence", latestResp.Msg.Sequence)
+			lastSent = latestResp.Msg.Sequence
+		}
+
+		if lastSent, err = s.replay(ctx, stream, sub, lastSent); err != nil {
+			slogger.Error("Failed to replay events", "group_id", groupID, "error", err)
+			return err
+		}
+	}
+
 	// Send events to client
 	for {
 		select {
 		case <-ctx.Done():
+			if errors.Is(context.Cause(ctx), errSubscriberTooSlow) {
+				slogger.Warn("Disconnecting slow subscriber", "group_id", groupID, "server_id", serverID, "last_sent", lastSent)
+				return connect.NewError(connect.CodeResourceExhausted,
+					fmt.Errorf("%w, resubscribe with last_seen_sequence %d to replay", errSubscriberTooSlow, lastSent))
+			}
 			return ctx.Err()
-		case event := <-eventChan:
-			if err := stream.Send(event); err != nil {
-				slogger.Error("Failed to send event to client", "error", err)
-				return err
+		case event := <-sub.eventChan:
+			switch {
+			case event.Sequence <= lastSent:
+				// Already sent while replaying
+				continue
+			case lastSent > 0 && event.Sequence > lastSent+1:
+				// Events were missed, e.g. dropped while the channel was full, so catch up from the log in order
+				if lastSent, err = s.replay(ctx, stream, sub, lastSent); err != nil {
+					slogger.Error("Failed to replay events", "group_id", groupID, "error", err)
+					return err
+				}
+			case !sub.wants(event):
+				// Filtered events still move the subscriber along, so they don't look like a gap
+				s.countFiltered(sub)
+				lastSent = event.Sequence
+			default:
+				if err := stream.Send(event); err != nil {
+					slogger.Error("Failed to send event to client", "error", err)
+					return err
+				}
+				lastSent = event.Sequence
 			}
 		}
 	}
 }
 
-// PublishEvent broadcasts an event to all subscribers
-func (s *EventService) PublishEvent(ctx context.Context, event *snitchv1.SubscribeResponse) error {
-	return s.PublishEventWithRetry(ctx, event, 3, time.Millisecond*100)
-}
+// replay sends the subscriber's stored events after a sequence and returns the sequence of the last one replayed,
+// including events of types it didn't ask for
+func (s *EventService) replay(
+	ctx context.Context,
+	stream *connect.ServerStream[snitchv1.SubscribeResponse],
+	sub *subscriber,
+	after int64,
+) (int64, error) {
+	groupID := sub.groupID
 
-// PublishEventWithRetry broadcasts an event with retry logic
-func (s *EventService) PublishEventWithRetry(ctx context.Context, event *snitchv1.SubscribeResponse, maxRetries int, retryDelay time.Duration) error {
-	var lastErr error
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			time.Sleep(retryDelay)
-			retryDelay *= 2 // Exponential backoff
+	for {
+		listResp, err := s.dbClient.ListEvents(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListEventsRequest{
+			GroupId:       groupID,
+			AfterSequence: after,
+			Limit:         replayPageSize,
+		}))
+		if err != nil {
+			return after, connect.NewError(connect.CodeOf(err), err)
 		}
 
-		err := s.publishEventOnce(ctx, event)
-		if err == nil {
-			return nil
+		events := listResp.Msg.Events
+		if len(events) > 0 && events[0].Sequence > after+1 {
+			slogger.Warn("Events older than the event log's retention can't be replayed", "group_id", groupID, "from", after+1, "to", events[0].Sequence-1)
 		}
 
-		lastErr = err
-
-		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
-		if !ok {
-			slogger = slog.Default()
+		for _, event := range events {
+			if sub.wants(event) {
+				if err := stream.Send(event); err != nil {
+					return after, err
+				}
+			}
+			after = event.Sequence
 		}
 
-		slogger.Warn("Event publish attempt failed",
-			"attempt", attempt+1,
-			"max_retries", maxRetries,
-			"error", err,
-			"type", event.Type)
+		if len(events) < replayPageSize {
+			slogger.Debug("Replayed events", "group_id", groupID, "last_sequence", after)
+			return after, nil
+		}
 	}
-
-	return fmt.Errorf("event publishing failed after %d attempts: %w", maxRetries+1, lastErr)
 }
 
-// publishEventOnce attempts to publish an event once
-func (s *EventService) publishEventOnce(ctx context.Context, event *snitchv1.SubscribeResponse) error {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
+// GetEventCursor returns the sequence of the latest event of the caller's group, for a subscriber without a cursor
+// to resume from after its first disconnect
+func (s *EventService) GetEventCursor(
+	ctx context.Context,
+	req *connect.Request[snitchv1.GetEventCursorRequest],
+) (*connect.Response[snitchv1.GetEventCursorResponse], error) {
 	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
 	if !ok {
 		slogger = slog.Default()
 	}
-	slogger.Debug("Publishing event", "type", event.Type, "server_id", event.ServerId)
 
-	if len(s.subscribers) == 0 {
-		slogger.Debug("No subscribers available for event", "type", event.Type)
-		return nil // Not an error - bot may not be connected
+	groupID, err := s.subscriptionGroup(ctx, req.Header(), req.Msg.GroupId)
+	if err != nil {
+		slogger.Error("Failed to find group ID for server", "server_id", req.Header().Get(interceptor.ServerIDHeader), "error", err)
+		return nil, err
 	}
 
-	droppedCount := 0
-	deliveredCount := 0
-	filteredCount := 0
+	latestResp, err := s.dbClient.GetLatestEventSequence(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetLatestEventSequenceRequest{
+		GroupId: groupID,
+	}))
+	if err != nil {
+		slogger.Error("Failed to get latest event sequence", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
 
-	for sub := range s.subscribers {
-		slogger.Info("Delivering message to subscriber", "Group ID", sub.groupID)
-		// Filter by group - only send events to subscribers in the same group
-		if sub.groupID != event.GroupId {
-			filteredCount++
-			continue
-		}
+	return connect.NewResponse(&snitchv1.GetEventCursorResponse{Sequence: latestResp.Msg.Sequence}), nil
+}
 
-		select {
-		case sub.eventChan <- event:
-			deliveredCount++
-		default:
-			// Channel full, count dropped events
-			droppedCount++
-			slogger.Error("Subscriber channel full, dropping event", "type", event.Type, "server_id", event.ServerId, "group_id", event.GroupId)
-		}
+// errEventNotStored marks events that failed to publish before reaching the event log, so no subscriber will see them
+var errEventNotStored = errors.New("failed to store event")
+
+// PublishEvent stores an event in its group's event log, then broadcasts it to the subscribers of every replica.
+// The log assigns the event's sequence. An event published again with the same idempotency key keeps its first sequence.
+// Events that fail to store aren't broadcast, since subscribers couldn't replay them.
+// Subscribers that miss a stored event catch up from the log.
+func (s *EventService) PublishEvent(ctx context.Context, event *snitchv1.SubscribeResponse) error {
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+
+	if event.IdempotencyKey == "" {
+		event.IdempotencyKey = uuid.NewString()
 	}
 
-	if droppedCount > 0 {
-		slogger.Error("Event delivery failed to some subscribers",
-			"type", event.Type,
-			"delivered", deliveredCount,
-			"dropped", droppedCount,
-			"filtered", filteredCount)
-		return fmt.Errorf("event delivery failed: %d delivered, %d dropped", deliveredCount, droppedCount)
+	appendResp, err := s.dbClient.AppendEvent(ctx, connect.NewRequest(&snitchv1.DatabaseServiceAppendEventRequest{
+		GroupId: event.GroupId,
+		Event:   event,
+	}))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errEventNotStored, err)
 	}
+	event.Sequence = appendResp.Msg.Sequence
 
-	slogger.Debug("Event delivered successfully", "type", event.Type, "delivered", deliveredCount, "filtered", filteredCount)
+	if err := s.broker.Publish(ctx, event); err != nil {
+		return fmt.Errorf("failed to broadcast event: %w", err)
+	}
 	return nil
 }
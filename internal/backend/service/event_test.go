@@ -1,20 +1,48 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
+	"snitch/internal/backend/service/interceptor"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
+	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const TEST_GROUP_ID = "test-group-id"
 const TEST_SERVER_ID = "test-server-id"
 
+// eventLogStub numbers appended events per group like the database's event log
+type eventLogStub struct {
+	snitchv1connect.UnimplementedDatabaseServiceHandler
+	mu        sync.Mutex
+	sequences map[string]int64
+}
+
+func newEventLogStub() *eventLogStub {
+	return &eventLogStub{sequences: make(map[string]int64)}
+}
+
+func (s *eventLogStub) AppendEvent(
+	_ context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceAppendEventRequest],
+) (*connect.Response[snitchv1.DatabaseServiceAppendEventResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequences[req.Msg.GroupId]++
+	return connect.NewResponse(&snitchv1.DatabaseServiceAppendEventResponse{Sequence: s.sequences[req.Msg.GroupId]}), nil
+}
+
 func TestEventService_PublishEvent(t *testing.T) {
-	// PublishEvent doesn't use dbClient, so we can pass nil
-	service := NewEventService(nil)
+	service := NewEventService(newEventLogStub())
 
 	// Test event publishing to subscribers with group filtering
 	eventChan := make(chan *snitchv1.SubscribeResponse, 10)
@@ -59,8 +87,7 @@ func TestEventService_GroupFiltering(t *testing.T) {
 	group1ID := "group-1"
 	group2ID := "group-2"
 
-	// PublishEvent doesn't use dbClient, so we can pass nil
-	service := NewEventService(nil)
+	service := NewEventService(newEventLogStub())
 
 	// Create subscribers for different groups
 	group1Chan := make(chan *snitchv1.SubscribeResponse, 10)
@@ -114,30 +141,248 @@ func TestEventService_GroupFiltering(t *testing.T) {
 }
 
 func TestEventService_ChannelFullHandling(t *testing.T) {
-	// PublishEvent doesn't use dbClient, so we can pass nil
-	service := NewEventService(nil)
+	for _, policy := range []SlowSubscriberPolicy{SlowSubscriberBlock, SlowSubscriberDropOldest, SlowSubscriberDisconnect} {
+		t.Run(string(policy), func(t *testing.T) {
+			service := NewEventService(newEventLogStub())
+			service.SetSlowSubscriberPolicy(policy, 10*time.Millisecond)
 
-	// Test that full channels don't block publishing
-	testEvent := &snitchv1.SubscribeResponse{
-		Type:      snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
-		GroupId:   TEST_GROUP_ID,
-		Timestamp: timestamppb.Now(),
+			testEvent := &snitchv1.SubscribeResponse{
+				Type:      snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+				GroupId:   TEST_GROUP_ID,
+				Timestamp: timestamppb.Now(),
+			}
+
+			// A full channel, holding an event nobody reads
+			staleEvent := &snitchv1.SubscribeResponse{GroupId: TEST_GROUP_ID}
+			fullChan := make(chan *snitchv1.SubscribeResponse, 1)
+			fullChan <- staleEvent
+			subCtx, disconnect := context.WithCancelCause(t.Context())
+			slow := &subscriber{
+				eventChan:  fullChan,
+				groupID:    TEST_GROUP_ID,
+				done:       subCtx.Done(),
+				disconnect: disconnect,
+			}
+
+			healthyChan := make(chan *snitchv1.SubscribeResponse, 10)
+			healthy := &subscriber{eventChan: healthyChan, groupID: TEST_GROUP_ID}
+
+			service.mu.Lock()
+			service.subscribers[slow] = true
+			service.subscribers[healthy] = true
+			service.mu.Unlock()
+
+			// A slow subscriber must not hold up publishing past the policy's deadline
+			published := make(chan error, 1)
+			go func() {
+				published <- service.PublishEvent(t.Context(), testEvent)
+			}()
+			select {
+			case err := <-published:
+				if err != nil {
+					t.Fatalf("PublishEvent failed: %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("PublishEvent blocked on a full channel")
+			}
+
+			// The healthy subscriber gets the event exactly once, without retries duplicating it
+			if len(healthyChan) != 1 || healthy.counters.delivered.Load() != 1 {
+				t.Errorf("Expected the healthy subscriber to get the event once, got %d queued", len(healthyChan))
+			}
+			if dropped := slow.counters.dropped.Load(); dropped != 1 {
+				t.Errorf("Expected 1 dropped event for the slow subscriber, got %d", dropped)
+			}
+			if dropped := service.counters.dropped.Load(); dropped != 1 {
+				t.Errorf("Expected 1 dropped event in total, got %d", dropped)
+			}
+
+			queued := <-fullChan
+			switch policy {
+			case SlowSubscriberBlock:
+				if queued != staleEvent {
+					t.Error("Block policy should drop the new event after the deadline")
+				}
+			case SlowSubscriberDropOldest:
+				if queued != testEvent || slow.counters.delivered.Load() != 1 {
+					t.Error("Drop-oldest policy should replace the oldest queued event with the new one")
+				}
+			case SlowSubscriberDisconnect:
+				if !errors.Is(context.Cause(subCtx), errSubscriberTooSlow) {
+					t.Errorf("Disconnect policy should end the slow subscriber's stream, got cause %v", context.Cause(subCtx))
+				}
+				if service.disconnected.Load() != 1 {
+					t.Errorf("Expected 1 disconnected subscriber, got %d", service.disconnected.Load())
+				}
+			}
+		})
 	}
+}
 
-	// Create a full channel
-	fullChan := make(chan *snitchv1.SubscribeResponse)
-	sub := &subscriber{
-		eventChan: fullChan,
-		groupID:   TEST_GROUP_ID,
+func TestEventService_DefaultPolicyDoesNotBlock(t *testing.T) {
+	service := NewEventService(newEventLogStub())
+
+	fullChan := make(chan *snitchv1.SubscribeResponse, 1)
+	fullChan <- &snitchv1.SubscribeResponse{GroupId: TEST_GROUP_ID}
+	service.mu.Lock()
+	service.subscribers[&subscriber{eventChan: fullChan, groupID: TEST_GROUP_ID}] = true
+	service.mu.Unlock()
+
+	start := time.Now()
+	event := &snitchv1.SubscribeResponse{Type: snitchv1.EventType_EVENT_TYPE_REPORT_CREATED, GroupId: TEST_GROUP_ID}
+	if err := service.PublishEvent(t.Context(), event); err != nil {
+		t.Fatalf("PublishEvent failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= DefaultSlowSubscriberTimeout {
+		t.Errorf("Publishing waited %s on a slow subscriber under the default policy", elapsed)
+	}
+	if queued := <-fullChan; queued != event {
+		t.Error("Expected the default policy to make room for the new event")
 	}
+}
+
+func TestEventService_BlockPolicyWaitsForRoom(t *testing.T) {
+	service := NewEventService(newEventLogStub())
+	service.SetSlowSubscriberPolicy(SlowSubscriberBlock, time.Second)
 
+	eventChan := make(chan *snitchv1.SubscribeResponse)
+	sub := &subscriber{eventChan: eventChan, groupID: TEST_GROUP_ID}
 	service.mu.Lock()
 	service.subscribers[sub] = true
 	service.mu.Unlock()
 
-	// This should not block and should return an error indicating dropped events
-	err := service.PublishEvent(t.Context(), testEvent)
-	if err == nil {
-		t.Error("Expected error for dropped events")
+	received := make(chan *snitchv1.SubscribeResponse, 1)
+	go func() {
+		received <- <-eventChan
+	}()
+
+	event := &snitchv1.SubscribeResponse{Type: snitchv1.EventType_EVENT_TYPE_USER_BANNED, GroupId: TEST_GROUP_ID}
+	if err := service.PublishEvent(t.Context(), event); err != nil {
+		t.Fatalf("PublishEvent failed: %v", err)
+	}
+
+	select {
+	case receivedEvent := <-received:
+		if receivedEvent != event {
+			t.Error("Expected the published event")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Event not received")
+	}
+
+	metrics := service.Metrics().(map[string]any)
+	if metrics["delivered"] != int64(1) || metrics["dropped"] != int64(0) {
+		t.Errorf("Expected 1 delivered and 0 dropped events, got %v", metrics)
+	}
+}
+
+func TestParseSlowSubscriberPolicy(t *testing.T) {
+	policy, err := ParseSlowSubscriberPolicy("drop-oldest")
+	if err != nil || policy != SlowSubscriberDropOldest {
+		t.Errorf("ParseSlowSubscriberPolicy(\"drop-oldest\") = %q, %v", policy, err)
+	}
+	if _, err := ParseSlowSubscriberPolicy("retry"); err == nil {
+		t.Error("Expected an error for an unknown policy")
+	}
+}
+
+func TestEventService_PublishEventSequence(t *testing.T) {
+	service := NewEventService(newEventLogStub())
+
+	eventChan := make(chan *snitchv1.SubscribeResponse, 10)
+	service.mu.Lock()
+	service.subscribers[&subscriber{eventChan: eventChan, groupID: TEST_GROUP_ID}] = true
+	service.mu.Unlock()
+
+	for _, groupID := range []string{TEST_GROUP_ID, "other-group", TEST_GROUP_ID} {
+		event := &snitchv1.SubscribeResponse{
+			Type:      snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+			GroupId:   groupID,
+			Timestamp: timestamppb.Now(),
+		}
+		if err := service.PublishEvent(t.Context(), event); err != nil {
+			t.Fatalf("PublishEvent failed: %v", err)
+		}
+	}
+
+	// Sequences are numbered per group, so the other group's event doesn't leave a gap
+	for _, want := range []int64{1, 2} {
+		select {
+		case receivedEvent := <-eventChan:
+			if receivedEvent.Sequence != want {
+				t.Errorf("Expected sequence %d, got %d", want, receivedEvent.Sequence)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("Event with sequence %d not received", want)
+		}
+	}
+}
+
+// serverGroupsStub lists the groups of TEST_SERVER_ID
+type serverGroupsStub struct {
+	snitchv1connect.UnimplementedDatabaseServiceHandler
+	groupIDs []string
+}
+
+func (s *serverGroupsStub) ListServerGroups(
+	_ context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListServerGroupsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListServerGroupsResponse], error) {
+	resp := &snitchv1.DatabaseServiceListServerGroupsResponse{}
+	if req.Msg.ServerId == TEST_SERVER_ID {
+		for _, groupID := range s.groupIDs {
+			resp.Groups = append(resp.Groups, &snitchv1.ServerGroup{GroupId: groupID})
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func TestEventService_SubscriptionGroup(t *testing.T) {
+	service := NewEventService(&serverGroupsStub{groupIDs: []string{"group-1", "group-2"}})
+
+	header := http.Header{}
+	header.Set(interceptor.ServerIDHeader, TEST_SERVER_ID)
+
+	groupID, err := service.subscriptionGroup(t.Context(), header, "group-2")
+	if err != nil {
+		t.Fatalf("subscriptionGroup failed: %v", err)
+	}
+	if groupID != "group-2" {
+		t.Errorf("Expected group-2, got %s", groupID)
+	}
+
+	if _, err := service.subscriptionGroup(t.Context(), header, "group-3"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected permission denied for a group the server isn't in, got %v", err)
+	}
+
+	header.Set(interceptor.ServerIDHeader, "unknown-server")
+	if _, err := service.subscriptionGroup(t.Context(), header, "group-1"); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected not found for an unregistered server, got %v", err)
+	}
+}
+
+func TestSubscriber_Wants(t *testing.T) {
+	reportCreated := &snitchv1.SubscribeResponse{Type: snitchv1.EventType_EVENT_TYPE_REPORT_CREATED}
+	userBanned := &snitchv1.SubscribeResponse{Type: snitchv1.EventType_EVENT_TYPE_USER_BANNED}
+
+	all := &subscriber{}
+	if !all.wants(reportCreated) || !all.wants(userBanned) {
+		t.Error("Subscribers without event types should be sent every type")
+	}
+
+	eventTypes, err := newEventTypeFilter([]snitchv1.EventType{snitchv1.EventType_EVENT_TYPE_REPORT_CREATED})
+	if err != nil {
+		t.Fatalf("newEventTypeFilter failed: %v", err)
+	}
+	filtered := &subscriber{eventTypes: eventTypes}
+	if !filtered.wants(reportCreated) {
+		t.Error("Subscriber should be sent the event types it asked for")
+	}
+	if filtered.wants(userBanned) {
+		t.Error("Subscriber should not be sent event types it didn't ask for")
+	}
+
+	if _, err := newEventTypeFilter([]snitchv1.EventType{snitchv1.EventType_EVENT_TYPE_UNSPECIFIED}); err == nil {
+		t.Error("Unspecified event types should be rejected")
 	}
 }
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"snitch/internal/shared/ctxutil"
+	snitchpb "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+func (s *RegisterServer) DeleteGroup(
+	ctx context.Context,
+	req *connect.Request[snitchpb.DeleteGroupRequest],
+) (*connect.Response[snitchpb.DeleteGroupResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.UserId == "" || req.Msg.ConfirmGroupName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user ID and group name confirmation are required"))
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_OWNER, "delete the group"); err != nil {
+		return nil, err
+	}
+	serverID := req.Header().Get(ServerIDHeader)
+
+	// The database checks ownership and the confirmation in the same transaction as the deletion
+	deleteReq := &snitchpb.DatabaseServiceDeleteGroupRequest{
+		GroupId:          groupID,
+		ServerId:         serverID,
+		ConfirmGroupName: req.Msg.ConfirmGroupName,
+	}
+	deleteResp, err := s.dbClient.DeleteGroup(ctx, connect.NewRequest(deleteReq))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed deleting group", "group_id", groupID, "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	// Subscribers stop listening to the group once they have been told
+	event := &snitchpb.SubscribeResponse{
+		Type:     snitchpb.EventType_EVENT_TYPE_GROUP_DELETED,
+		GroupId:  groupID,
+		ServerId: serverID,
+		Data: &snitchpb.SubscribeResponse_GroupDeleted{
+			GroupDeleted: &snitchpb.GroupDeletedEvent{
+				DeletedBy:  req.Msg.UserId,
+				PurgeAfter: deleteResp.Msg.PurgeAfter,
+			},
+		},
+	}
+	if err := s.eventService.PublishEvent(ctx, event); err != nil {
+		slogger.WarnContext(ctx, "Failed to publish event", "error", err)
+	}
+
+	slogger.InfoContext(ctx, "Group deleted",
+		"group_id", groupID,
+		"server_id", serverID,
+		"deleted_by", req.Msg.UserId,
+		"purge_after", deleteResp.Msg.PurgeAfter.AsTime())
+
+	return connect.NewResponse(&snitchpb.DeleteGroupResponse{
+		GroupId:    groupID,
+		PurgeAfter: deleteResp.Msg.PurgeAfter,
+	}), nil
+}
+
+func (s *RegisterServer) RestoreGroup(
+	ctx context.Context,
+	req *connect.Request[snitchpb.RestoreGroupRequest],
+) (*connect.Response[snitchpb.RestoreGroupResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user ID is required"))
+	}
+
+	// A deleted group no longer resolves from its servers, so it is looked up by owner instead
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID header is required"))
+	}
+
+	restoreReq := &snitchpb.DatabaseServiceRestoreGroupRequest{
+		ServerId: serverID,
+	}
+	restoreResp, err := s.dbClient.RestoreGroup(ctx, connect.NewRequest(restoreReq))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed restoring group", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.InfoContext(ctx, "Group restored",
+		"group_id", restoreResp.Msg.GroupId,
+		"server_id", serverID,
+		"restored_by", req.Msg.UserId)
+
+	return connect.NewResponse(&snitchpb.RestoreGroupResponse{
+		GroupId:   restoreResp.Msg.GroupId,
+		GroupName: restoreResp.Msg.GroupName,
+	}), nil
+}
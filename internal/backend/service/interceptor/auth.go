@@ -0,0 +1,133 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"snitch/internal/shared/apikey"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+// authCacheTTL bounds how long a verified key is trusted without asking the database, and so how long a revoked key keeps working
+const authCacheTTL = time.Minute
+
+var errUnauthenticated = errors.New("a valid API key is required")
+
+// Caller identifies the API key a request was authenticated with
+type Caller struct {
+	KeyID string
+	Name  string
+}
+
+type verifiedKey struct {
+	hash      string
+	caller    Caller
+	expiresAt time.Time
+}
+
+type authInterceptor struct {
+	dbClient snitchv1connect.DatabaseServiceClient
+
+	mu       sync.Mutex
+	verified map[string]verifiedKey // key ID -> last successful verification
+}
+
+// NewAuthInterceptor rejects every request, unary or streaming, that doesn't carry a valid, unrevoked API key
+func NewAuthInterceptor(dbClient snitchv1connect.DatabaseServiceClient) connect.Interceptor {
+	return &authInterceptor{
+		dbClient: dbClient,
+		verified: make(map[string]verifiedKey),
+	}
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate verifies the bearer token of a request and records the caller in the context
+func (i *authInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	token, ok := apikey.FromHeader(header)
+	if !ok {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	key, err := apikey.Parse(token)
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	caller, err := i.verify(ctx, key)
+	if err != nil {
+		slogger.Warn("Rejected API key", "key_id", key.ID, "error", err)
+		return ctx, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	ctx = ctxutil.WithValue(ctx, caller)
+	ctx = ctxutil.WithValue(ctx, slogger.With(slog.String("APIKeyID", caller.KeyID)))
+	return ctx, nil
+}
+
+// verify checks a key against the database, reusing recent successful checks
+func (i *authInterceptor) verify(ctx context.Context, key apikey.Key) (Caller, error) {
+	hash := key.Hash()
+	now := time.Now()
+
+	i.mu.Lock()
+	cached, ok := i.verified[key.ID]
+	i.mu.Unlock()
+	if ok && cached.hash == hash && now.Before(cached.expiresAt) {
+		return cached.caller, nil
+	}
+
+	getKeyResp, err := i.dbClient.GetAPIKey(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetAPIKeyRequest{KeyId: key.ID}))
+	if err != nil {
+		return Caller{}, err
+	}
+	if getKeyResp.Msg.Key.RevokedAt != nil {
+		return Caller{}, errors.New("API key is revoked")
+	}
+	if !key.Matches(getKeyResp.Msg.KeyHash) {
+		return Caller{}, errors.New("API key secret doesn't match")
+	}
+
+	caller := Caller{KeyID: key.ID, Name: getKeyResp.Msg.Key.Name}
+
+	i.mu.Lock()
+	i.verified[key.ID] = verifiedKey{hash: hash, caller: caller, expiresAt: now.Add(authCacheTTL)}
+	i.mu.Unlock()
+
+	return caller, nil
+}
@@ -0,0 +1,128 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"snitch/internal/shared/apikey"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+// fakeKeyStore serves GetAPIKey from memory; every other DatabaseServiceClient method is left nil
+type fakeKeyStore struct {
+	snitchv1connect.DatabaseServiceClient
+	keys  map[string]*snitchv1.DatabaseServiceGetAPIKeyResponse
+	calls int
+}
+
+func (f *fakeKeyStore) GetAPIKey(_ context.Context, req *connect.Request[snitchv1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[snitchv1.DatabaseServiceGetAPIKeyResponse], error) {
+	f.calls++
+	key, ok := f.keys[req.Msg.KeyId]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	return connect.NewResponse(key), nil
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	valid, err := apikey.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := apikey.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, err := apikey.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	revokedAt := "2025-01-01 00:00:00"
+
+	store := &fakeKeyStore{keys: map[string]*snitchv1.DatabaseServiceGetAPIKeyResponse{
+		valid.ID:   {Key: &snitchv1.APIKey{KeyId: valid.ID, Name: "bot"}, KeyHash: valid.Hash()},
+		revoked.ID: {Key: &snitchv1.APIKey{KeyId: revoked.ID, Name: "old", RevokedAt: &revokedAt}, KeyHash: revoked.Hash()},
+	}}
+
+	var caller Caller
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		caller, _ = ctxutil.Value[Caller](ctx)
+		return nil, nil
+	})
+	handler := NewAuthInterceptor(store).WrapUnary(next)
+
+	call := func(authorization string) error {
+		req := connect.NewRequest(&snitchv1.GetReportRequest{})
+		if authorization != "" {
+			req.Header().Set(apikey.AuthorizationHeader, authorization)
+		}
+		_, err := handler(t.Context(), req)
+		return err
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantErr       bool
+	}{
+		{"valid key", "Bearer " + valid.String(), false},
+		{"missing header", "", true},
+		{"not a bearer token", valid.String(), true},
+		{"malformed key", "Bearer snitch_nope", true},
+		{"wrong secret", "Bearer " + apikey.Key{ID: valid.ID, Secret: unknown.Secret}.String(), true},
+		{"revoked key", "Bearer " + revoked.String(), true},
+		{"unknown key", "Bearer " + unknown.String(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller = Caller{}
+			err := call(tt.authorization)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Errorf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnauthenticated)
+			}
+			if !tt.wantErr && caller.KeyID != valid.ID {
+				t.Errorf("caller = %+v, want key %s", caller, valid.ID)
+			}
+		})
+	}
+
+	// Verified keys are cached, so repeating a valid call doesn't hit the database again
+	calls := store.calls
+	if err := call("Bearer " + valid.String()); err != nil {
+		t.Fatal(err)
+	}
+	if store.calls != calls {
+		t.Errorf("expected cached verification, got %d more database calls", store.calls-calls)
+	}
+}
+
+func TestAuthInterceptorStreaming(t *testing.T) {
+	handler := NewAuthInterceptor(&fakeKeyStore{}).WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+		t.Fatal("unauthenticated stream should not reach the handler")
+		return nil
+	})
+
+	err := handler(t.Context(), &headerOnlyConn{header: http.Header{}})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnauthenticated)
+	}
+}
+
+// headerOnlyConn is a streaming connection that only exposes request headers
+type headerOnlyConn struct {
+	connect.StreamingHandlerConn
+	header http.Header
+}
+
+func (c *headerOnlyConn) RequestHeader() http.Header {
+	return c.header
+}
@@ -14,6 +14,7 @@ type contextKey string
 
 const (
 	ServerIDHeader     = "X-Server-ID"
+	GroupHeader        = "X-Group"
 	serverIDContextKey = contextKey("server_id")
 	groupIDContextKey  = contextKey("group_id")
 )
@@ -39,9 +40,12 @@ func NewGroupContextInterceptor(dbClient snitchv1connect.DatabaseServiceClient)
 			findGroupReq := &snitchv1.FindGroupByServerRequest{
 				ServerId: serverID,
 			}
+			if selector := req.Header().Get(GroupHeader); selector != "" {
+				findGroupReq.GroupSelector = &selector
+			}
 			findGroupResp, err := dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
 			if err != nil {
-				return nil, connect.NewError(connect.CodeNotFound, err)
+				return nil, connect.NewError(connect.CodeOf(err), err)
 			}
 
 			ctx = context.WithValue(ctx, serverIDContextKey, serverID)
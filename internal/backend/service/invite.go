@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"snitch/internal/shared/ctxutil"
+	snitchpb "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	inviteCodeLength = 10
+	defaultInviteTTL = 7 * 24 * time.Hour
+	maxInviteTTL     = 30 * 24 * time.Hour
+)
+
+// newInviteCode generates a random invite code; base32 has no 0/1 to confuse with O/I
+func newInviteCode() string {
+	return rand.Text()[:inviteCodeLength]
+}
+
+// normalizeInviteCode makes invite codes case-insensitive and forgiving of stray whitespace
+func normalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// inviteFromDatabase converts a database invite into its API representation
+func inviteFromDatabase(dbInvite *snitchpb.DbInvite) *snitchpb.Invite {
+	invite := &snitchpb.Invite{
+		Code:      dbInvite.Code,
+		GroupId:   dbInvite.GroupId,
+		CreatedBy: dbInvite.CreatedBy,
+		MaxUses:   dbInvite.MaxUses,
+		Uses:      dbInvite.Uses,
+		CreatedAt: parseDatabaseTimestamp(dbInvite.CreatedAt),
+	}
+	if dbInvite.ExpiresAt != nil {
+		invite.ExpiresAt = parseDatabaseTimestamp(*dbInvite.ExpiresAt)
+	}
+	if dbInvite.RevokedAt != nil {
+		invite.RevokedAt = parseDatabaseTimestamp(*dbInvite.RevokedAt)
+	}
+	return invite
+}
+
+func (s *RegisterServer) CreateInvite(
+	ctx context.Context,
+	req *connect.Request[snitchpb.CreateInviteRequest],
+) (*connect.Response[snitchpb.CreateInviteResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user ID is required"))
+	}
+	if req.Msg.MaxUses < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max uses must not be negative"))
+	}
+
+	ttl := defaultInviteTTL
+	if req.Msg.ExpiresIn != nil {
+		ttl = req.Msg.ExpiresIn.AsDuration()
+	}
+	if ttl <= 0 || ttl > maxInviteTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invites must expire within %s", maxInviteTTL))
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_ADMIN, "create invites"); err != nil {
+		return nil, err
+	}
+
+	createResp, err := s.dbClient.CreateInvite(ctx, connect.NewRequest(&snitchpb.DatabaseServiceCreateInviteRequest{
+		Code:      newInviteCode(),
+		GroupId:   groupID,
+		CreatedBy: req.Msg.UserId,
+		MaxUses:   req.Msg.MaxUses,
+		ExpiresAt: timestamppb.New(time.Now().Add(ttl)),
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to create invite", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchpb.CreateInviteResponse{
+		Invite: inviteFromDatabase(createResp.Msg.Invite),
+	}), nil
+}
+
+func (s *RegisterServer) ListInvites(
+	ctx context.Context,
+	req *connect.Request[snitchpb.ListInvitesRequest],
+) (*connect.Response[snitchpb.ListInvitesResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	groupID, _, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+
+	listResp, err := s.dbClient.ListInvites(ctx, connect.NewRequest(&snitchpb.DatabaseServiceListInvitesRequest{
+		GroupId: groupID,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to list invites", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	invites := make([]*snitchpb.Invite, 0, len(listResp.Msg.Invites))
+	for _, dbInvite := range listResp.Msg.Invites {
+		invites = append(invites, inviteFromDatabase(dbInvite))
+	}
+
+	return connect.NewResponse(&snitchpb.ListInvitesResponse{Invites: invites}), nil
+}
+
+func (s *RegisterServer) RevokeInvite(
+	ctx context.Context,
+	req *connect.Request[snitchpb.RevokeInviteRequest],
+) (*connect.Response[snitchpb.RevokeInviteResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_ADMIN, "revoke invites"); err != nil {
+		return nil, err
+	}
+
+	revokeResp, err := s.dbClient.RevokeInvite(ctx, connect.NewRequest(&snitchpb.DatabaseServiceRevokeInviteRequest{
+		Code:    normalizeInviteCode(req.Msg.Code),
+		GroupId: groupID,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to revoke invite", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchpb.RevokeInviteResponse{
+		Invite: inviteFromDatabase(revokeResp.Msg.Invite),
+	}), nil
+}
+
+func (s *RegisterServer) DecideJoinRequest(
+	ctx context.Context,
+	req *connect.Request[snitchpb.DecideJoinRequestRequest],
+) (*connect.Response[snitchpb.DecideJoinRequestResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.RequestId == "" || req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request ID and user ID are required"))
+	}
+
+	// Only servers already in the group can decide on its join requests
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_ADMIN, "decide join requests"); err != nil {
+		return nil, err
+	}
+
+	decideResp, err := s.dbClient.DecideJoinRequest(ctx, connect.NewRequest(&snitchpb.DatabaseServiceDecideJoinRequestRequest{
+		RequestId: req.Msg.RequestId,
+		GroupId:   groupID,
+		Approve:   req.Msg.Approve,
+		DecidedBy: req.Msg.UserId,
+		// The server may have joined other groups while the request was pending
+		MaxServerGroups: maxServerGroups,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to decide join request", "request_id", req.Msg.RequestId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.InfoContext(ctx, "Join request decided",
+		"request_id", req.Msg.RequestId,
+		"group_id", groupID,
+		"server_id", decideResp.Msg.ServerId,
+		"approved", req.Msg.Approve)
+
+	return connect.NewResponse(&snitchpb.DecideJoinRequestResponse{
+		ServerId: decideResp.Msg.ServerId,
+		Approved: req.Msg.Approve,
+	}), nil
+}
@@ -0,0 +1,26 @@
+package service
+
+import "testing"
+
+func TestNewInviteCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 100 {
+		code := newInviteCode()
+		if len(code) != inviteCodeLength {
+			t.Fatalf("newInviteCode() = %q, expected %d characters", code, inviteCodeLength)
+		}
+		if code != normalizeInviteCode(code) {
+			t.Fatalf("newInviteCode() = %q is not in normalized form", code)
+		}
+		if seen[code] {
+			t.Fatalf("newInviteCode() repeated %q", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestNormalizeInviteCode(t *testing.T) {
+	if got := normalizeInviteCode("  abcd2345ef \n"); got != "ABCD2345EF" {
+		t.Errorf("normalizeInviteCode() = %q, expected %q", got, "ABCD2345EF")
+	}
+}
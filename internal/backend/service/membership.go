@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"snitch/internal/shared/ctxutil"
+	snitchpb "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// removeServer takes a server out of a group and lets the group's subscribers know
+func (s *RegisterServer) removeServer(ctx context.Context, groupID, serverID, actingServerID, userID string, kicked bool) error {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	removeReq := &snitchpb.RemoveServerFromGroupRequest{
+		ServerId: serverID,
+		GroupId:  groupID,
+	}
+	if _, err := s.dbClient.RemoveServerFromGroup(ctx, connect.NewRequest(removeReq)); err != nil {
+		slogger.ErrorContext(ctx, "Failed removing server from group", "server_id", serverID, "group_id", groupID, "error", err)
+		return connect.NewError(connect.CodeOf(err), err)
+	}
+
+	// Subscribers drop the server and close the group's stream once no servers are left
+	event := &snitchpb.SubscribeResponse{
+		Type:     snitchpb.EventType_EVENT_TYPE_SERVER_REMOVED,
+		GroupId:  groupID,
+		ServerId: actingServerID,
+		Data: &snitchpb.SubscribeResponse_ServerRemoved{
+			ServerRemoved: &snitchpb.ServerRemovedEvent{
+				ServerId:  serverID,
+				RemovedBy: userID,
+				Kicked:    kicked,
+			},
+		},
+	}
+	if err := s.eventService.PublishEvent(ctx, event); err != nil {
+		slogger.WarnContext(ctx, "Failed to publish event", "error", err)
+	}
+
+	slogger.InfoContext(ctx, "Server removed from group",
+		"group_id", groupID,
+		"server_id", serverID,
+		"removed_by", userID,
+		"kicked", kicked)
+
+	return nil
+}
+
+// memberRole looks up the role of any server in a group, not just the one a request was made from. It returns
+// a NotFound error when the server is not in the group.
+func (s *RegisterServer) memberRole(ctx context.Context, serverID, groupID string) (snitchpb.GroupRole, error) {
+	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(&snitchpb.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: &groupID,
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return snitchpb.GroupRole_GROUP_ROLE_UNSPECIFIED, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("server %s is not in group %s", serverID, groupID))
+	}
+	if err != nil {
+		return snitchpb.GroupRole_GROUP_ROLE_UNSPECIFIED, connect.NewError(connect.CodeInternal, err)
+	}
+	return findGroupResp.Msg.Role, nil
+}
+
+func (s *RegisterServer) LeaveGroup(
+	ctx context.Context,
+	req *connect.Request[snitchpb.LeaveGroupRequest],
+) (*connect.Response[snitchpb.LeaveGroupResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user ID is required"))
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	serverID := req.Header().Get(ServerIDHeader)
+
+	// A group must not be left without an owner while other servers remain in it
+	if role == snitchpb.GroupRole_GROUP_ROLE_OWNER {
+		listResp, err := s.dbClient.ListServers(ctx, connect.NewRequest(&snitchpb.ListServersRequest{GroupId: groupID}))
+		if err != nil {
+			slogger.ErrorContext(ctx, "Failed to list servers", "group_id", groupID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if len(listResp.Msg.Servers) > 1 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the group's owner must transfer ownership before leaving"))
+		}
+	}
+
+	if err := s.removeServer(ctx, groupID, serverID, serverID, req.Msg.UserId, false); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&snitchpb.LeaveGroupResponse{
+		ServerId: serverID,
+		GroupId:  groupID,
+	}), nil
+}
+
+func (s *RegisterServer) KickServer(
+	ctx context.Context,
+	req *connect.Request[snitchpb.KickServerRequest],
+) (*connect.Response[snitchpb.KickServerResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.ServerId == "" || req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID and user ID are required"))
+	}
+
+	// Only servers already in the group can kick from it, and only servers in the same group
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_ADMIN, "kick servers"); err != nil {
+		return nil, err
+	}
+	actingServerID := req.Header().Get(ServerIDHeader)
+
+	if req.Msg.ServerId == actingServerID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a server cannot kick itself, leave the group instead"))
+	}
+
+	targetRole, err := s.memberRole(ctx, req.Msg.ServerId, groupID)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find role of server", "server_id", req.Msg.ServerId, "group_id", groupID, "error", err)
+		return nil, err
+	}
+	if targetRole >= role {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot kick a server with the %s role", roleName(targetRole)))
+	}
+
+	if err := s.removeServer(ctx, groupID, req.Msg.ServerId, actingServerID, req.Msg.UserId, true); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&snitchpb.KickServerResponse{
+		ServerId: req.Msg.ServerId,
+		GroupId:  groupID,
+	}), nil
+}
+
+func (s *RegisterServer) ListGroupServers(
+	ctx context.Context,
+	req *connect.Request[snitchpb.ListGroupServersRequest],
+) (*connect.Response[snitchpb.ListGroupServersResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	groupID, _, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+
+	listResp, err := s.dbClient.ListServers(ctx, connect.NewRequest(&snitchpb.ListServersRequest{GroupId: groupID}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to list servers", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	servers := make([]*snitchpb.GroupServer, 0, len(listResp.Msg.Servers))
+	for _, server := range listResp.Msg.Servers {
+		servers = append(servers, &snitchpb.GroupServer{
+			ServerId: server.ServerId,
+			Role:     server.Role,
+		})
+	}
+
+	return connect.NewResponse(&snitchpb.ListGroupServersResponse{
+		GroupId: groupID,
+		Servers: servers,
+	}), nil
+}
+
+func (s *RegisterServer) SetServerRole(
+	ctx context.Context,
+	req *connect.Request[snitchpb.SetServerRoleRequest],
+) (*connect.Response[snitchpb.SetServerRoleResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.ServerId == "" || req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID and user ID are required"))
+	}
+	if req.Msg.Role == snitchpb.GroupRole_GROUP_ROLE_UNSPECIFIED || req.Msg.Role == snitchpb.GroupRole_GROUP_ROLE_OWNER {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role must be admin, member or observer; transfer ownership instead"))
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_ADMIN, "change server roles"); err != nil {
+		return nil, err
+	}
+
+	// Servers only manage roles below their own, so admins cannot appoint or demote other admins
+	previousRole, err := s.memberRole(ctx, req.Msg.ServerId, groupID)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find role of server", "server_id", req.Msg.ServerId, "group_id", groupID, "error", err)
+		return nil, err
+	}
+	if previousRole >= role || req.Msg.Role >= role {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("servers with the %s role can only manage roles below their own", roleName(role)))
+	}
+
+	setResp, err := s.dbClient.SetServerRole(ctx, connect.NewRequest(&snitchpb.DatabaseServiceSetServerRoleRequest{
+		GroupId:  groupID,
+		ServerId: req.Msg.ServerId,
+		Role:     req.Msg.Role,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to set server role", "group_id", groupID, "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.InfoContext(ctx, "Server role changed",
+		"group_id", groupID,
+		"server_id", req.Msg.ServerId,
+		"from", previousRole,
+		"to", setResp.Msg.Role,
+		"changed_by", req.Msg.UserId)
+
+	return connect.NewResponse(&snitchpb.SetServerRoleResponse{
+		ServerId:     req.Msg.ServerId,
+		PreviousRole: previousRole,
+		Role:         setResp.Msg.Role,
+	}), nil
+}
+
+func (s *RegisterServer) TransferOwnership(
+	ctx context.Context,
+	req *connect.Request[snitchpb.TransferOwnershipRequest],
+) (*connect.Response[snitchpb.TransferOwnershipResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if req.Msg.ServerId == "" || req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID and user ID are required"))
+	}
+
+	groupID, role, err := groupForServer(ctx, s.dbClient, req.Header())
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to find group for server", "error", err)
+		return nil, err
+	}
+	if err := requireRole(role, snitchpb.GroupRole_GROUP_ROLE_OWNER, "transfer ownership"); err != nil {
+		return nil, err
+	}
+	serverID := req.Header().Get(ServerIDHeader)
+
+	transferResp, err := s.dbClient.TransferGroupOwnership(ctx, connect.NewRequest(&snitchpb.DatabaseServiceTransferGroupOwnershipRequest{
+		GroupId:      groupID,
+		FromServerId: serverID,
+		ToServerId:   req.Msg.ServerId,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to transfer group ownership", "group_id", groupID, "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.InfoContext(ctx, "Group ownership transferred",
+		"group_id", groupID,
+		"from", serverID,
+		"to", transferResp.Msg.OwnerServerId,
+		"transferred_by", req.Msg.UserId)
+
+	return connect.NewResponse(&snitchpb.TransferOwnershipResponse{
+		GroupId:       groupID,
+		OwnerServerId: transferResp.Msg.OwnerServerId,
+	}), nil
+}
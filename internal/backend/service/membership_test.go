@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+// findGroupErrorStub fails every group lookup with an error
+type findGroupErrorStub struct {
+	snitchv1connect.UnimplementedDatabaseServiceHandler
+	err error
+}
+
+func (s *findGroupErrorStub) FindGroupByServer(
+	_ context.Context,
+	_ *connect.Request[snitchv1.FindGroupByServerRequest],
+) (*connect.Response[snitchv1.FindGroupByServerResponse], error) {
+	return nil, s.err
+}
+
+func TestMemberRole_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected connect.Code
+	}{
+		{connect.NewError(connect.CodeNotFound, errors.New("server not found")), connect.CodeNotFound},
+		{connect.NewError(connect.CodeUnavailable, errors.New("database unavailable")), connect.CodeInternal},
+		{connect.NewError(connect.CodeFailedPrecondition, errors.New("server is in 2 groups")), connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		dbClient := &findGroupErrorStub{err: tt.err}
+		server := NewRegisterServer(dbClient, NewEventService(dbClient))
+
+		_, err := server.memberRole(t.Context(), TEST_SERVER_ID, TEST_GROUP_ID)
+		if connect.CodeOf(err) != tt.expected {
+			t.Errorf("memberRole with %v: expected %v, got %v", tt.err, tt.expected, err)
+		}
+	}
+}
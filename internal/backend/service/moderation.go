@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+type ModerationServer struct {
+	dbClient     snitchv1connect.DatabaseServiceClient
+	eventService *EventService
+}
+
+func NewModerationServer(dbClient snitchv1connect.DatabaseServiceClient, eventService *EventService) *ModerationServer {
+	return &ModerationServer{
+		dbClient:     dbClient,
+		eventService: eventService,
+	}
+}
+
+func (s *ModerationServer) RecordBan(
+	ctx context.Context,
+	req *connect.Request[snitchv1.RecordBanRequest],
+) (*connect.Response[snitchv1.RecordBanResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("user ID is required"))
+	}
+
+	// A ban is recorded in the selected group, or in every group the server can record bans in
+	groups, err := targetGroups(ctx, s.dbClient, serverID, req.Header(), snitchv1.GroupRole_GROUP_ROLE_MEMBER, "record bans")
+	if err != nil {
+		slogger.Error("Failed to find groups for server", "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	resp := &snitchv1.RecordBanResponse{}
+	for _, group := range groups {
+		groupID := group.GroupId
+
+		createBanReq := &snitchv1.DatabaseServiceCreateBanRequest{
+			GroupId:  groupID,
+			UserId:   req.Msg.UserId,
+			ServerId: serverID,
+			Reason:   req.Msg.Reason,
+		}
+		createBanResp, err := s.dbClient.CreateBan(ctx, connect.NewRequest(createBanReq))
+		if err != nil {
+			slogger.Error("Failed to record ban", "group_id", groupID, "error", err)
+			// The groups recorded in already keep their bans, so the caller is told which groups failed instead
+			if len(groups) > 1 {
+				resp.Bans = append(resp.Bans, &snitchv1.GroupBan{GroupId: groupID, Error: err.Error()})
+				continue
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		banID := createBanResp.Msg.BanId
+
+		// Let the other servers in the group decide what to do with the ban
+		event := &snitchv1.SubscribeResponse{
+			Type:     snitchv1.EventType_EVENT_TYPE_USER_BANNED,
+			GroupId:  groupID,
+			ServerId: serverID,
+			Data: &snitchv1.SubscribeResponse_UserBanned{
+				UserBanned: &snitchv1.UserBannedEvent{
+					UserId:   req.Msg.UserId,
+					ServerId: serverID,
+					Reason:   req.Msg.GetReason(),
+					BanId:    banID,
+				},
+			},
+		}
+		if err := s.eventService.PublishEvent(ctx, event); err != nil {
+			slogger.Warn("Failed to publish event", "error", err)
+		}
+
+		slogger.Info("Ban recorded", "ban_id", banID, "group_id", groupID, "user_id", req.Msg.UserId)
+
+		if resp.BanId == 0 {
+			resp.BanId = banID
+		}
+		resp.Bans = append(resp.Bans, &snitchv1.GroupBan{GroupId: groupID, BanId: banID})
+	}
+
+	if resp.BanId == 0 {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record the ban in any of the server's groups"))
+	}
+
+	return connect.NewResponse(resp), nil
+}
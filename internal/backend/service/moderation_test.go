@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// banStub records bans in two groups of TEST_SERVER_ID and fails to record them in the second
+type banStub struct {
+	*eventLogStub
+}
+
+func (s *banStub) ListServerGroups(
+	_ context.Context,
+	_ *connect.Request[snitchv1.DatabaseServiceListServerGroupsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListServerGroupsResponse], error) {
+	return connect.NewResponse(&snitchv1.DatabaseServiceListServerGroupsResponse{
+		Groups: []*snitchv1.ServerGroup{
+			{GroupId: "group-1", Role: snitchv1.GroupRole_GROUP_ROLE_MEMBER},
+			{GroupId: "group-2", Role: snitchv1.GroupRole_GROUP_ROLE_MEMBER},
+		},
+	}), nil
+}
+
+func (s *banStub) CreateBan(
+	_ context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCreateBanRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCreateBanResponse], error) {
+	if req.Msg.GroupId == "group-2" {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("database unavailable"))
+	}
+	return connect.NewResponse(&snitchv1.DatabaseServiceCreateBanResponse{BanId: 7}), nil
+}
+
+func TestRecordBan_ReportsFailedGroups(t *testing.T) {
+	dbClient := &banStub{newEventLogStub()}
+	server := NewModerationServer(dbClient, NewEventService(dbClient))
+
+	req := connect.NewRequest(&snitchv1.RecordBanRequest{UserId: "user-1"})
+	req.Header().Set(ServerIDHeader, TEST_SERVER_ID)
+	req.Header().Set(GroupHeader, allGroupsSelector)
+	resp, err := server.RecordBan(t.Context(), req)
+	if err != nil {
+		t.Fatalf("RecordBan failed: %v", err)
+	}
+
+	if resp.Msg.BanId != 7 {
+		t.Errorf("Expected ban 7, got %d", resp.Msg.BanId)
+	}
+	if len(resp.Msg.Bans) != 2 {
+		t.Fatalf("Expected a result per group, got %v", resp.Msg.Bans)
+	}
+	if resp.Msg.Bans[0].BanId != 7 || resp.Msg.Bans[0].Error != "" {
+		t.Errorf("Expected the ban to be recorded in group-1, got %v", resp.Msg.Bans[0])
+	}
+	if resp.Msg.Bans[1].GroupId != "group-2" || resp.Msg.Bans[1].Error == "" {
+		t.Errorf("Expected group-2 to report its error, got %v", resp.Msg.Bans[1])
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// DefaultOutboxInterval is how often the outbox relay checks for events when it hasn't been notified of any
+const DefaultOutboxInterval = 5 * time.Second
+
+// outboxBatchSize is how many outbox events the relay publishes per database call
+const outboxBatchSize = 100
+
+// NotifyOutbox wakes the outbox relay after a change wrote an event to a group outbox, instead of waiting for its next check
+func (s *EventService) NotifyOutbox() {
+	select {
+	case s.outboxWake <- struct{}{}:
+	default:
+		// A wake-up is already pending and will pick this event up too
+	}
+}
+
+// RunOutboxRelay publishes the events written to group outboxes until the context is cancelled. Events are removed from
+// the outbox only once published, so delivery is at least once; subscribers drop repeats by idempotency key.
+func (s *EventService) RunOutboxRelay(ctx context.Context, interval time.Duration) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.relayOutbox(ctx); err != nil && ctx.Err() == nil {
+			slogger.Error("Failed to relay outbox events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.outboxWake:
+		}
+	}
+}
+
+// relayOutbox publishes outbox events until the outboxes are empty. An event that fails to publish stays in its
+// outbox for the next run.
+func (s *EventService) relayOutbox(ctx context.Context) error {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	for {
+		listResp, err := s.dbClient.ListOutboxEvents(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListOutboxEventsRequest{
+			Limit: outboxBatchSize,
+		}))
+		if err != nil {
+			return err
+		}
+
+		published := 0
+		for _, outboxEvent := range listResp.Msg.Events {
+			if err := s.PublishEvent(ctx, outboxEvent.Event); err != nil {
+				slogger.Warn("Failed to publish outbox event, retrying on the next run",
+					"group_id", outboxEvent.GroupId,
+					"outbox_id", outboxEvent.OutboxId,
+					"error", err)
+				continue
+			}
+
+			// A failed delete only means the event is published again, under the same idempotency key
+			if _, err := s.dbClient.DeleteOutboxEvent(ctx, connect.NewRequest(&snitchv1.DatabaseServiceDeleteOutboxEventRequest{
+				GroupId:  outboxEvent.GroupId,
+				OutboxId: outboxEvent.OutboxId,
+			})); err != nil {
+				slogger.Warn("Failed to delete published outbox event",
+					"group_id", outboxEvent.GroupId,
+					"outbox_id", outboxEvent.OutboxId,
+					"error", err)
+				continue
+			}
+			published++
+		}
+
+		// Stop once the outboxes are drained, or when nothing could be published to avoid spinning on failures
+		if len(listResp.Msg.Events) < outboxBatchSize || published == 0 {
+			return nil
+		}
+	}
+}
@@ -2,26 +2,32 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"snitch/internal/shared/ctxutil"
 	snitchpb "snitch/pkg/proto/gen/snitch/v1"
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 )
 
 type RegisterServer struct {
-	dbClient snitchv1connect.DatabaseServiceClient
+	dbClient     snitchv1connect.DatabaseServiceClient
+	eventService *EventService
 }
 
-func NewRegisterServer(dbClient snitchv1connect.DatabaseServiceClient) *RegisterServer {
-	return &RegisterServer{dbClient: dbClient}
+func NewRegisterServer(dbClient snitchv1connect.DatabaseServiceClient, eventService *EventService) *RegisterServer {
+	return &RegisterServer{dbClient: dbClient, eventService: eventService}
 }
 
 const ServerIDHeader = "X-Server-ID"
 
+// GroupHeader picks one of the groups of a server in several, by group ID or name
+const GroupHeader = "X-Group"
+
 func getServerIDFromHeader(r *connect.Request[snitchpb.RegisterRequest]) (string, error) {
 	serverID := r.Header().Get(ServerIDHeader)
 	if serverID == "" {
@@ -38,13 +44,14 @@ func (s *RegisterServer) GetGroupForServer(ctx context.Context, req *connect.Req
 	}
 
 	findGroupReq := &snitchpb.FindGroupByServerRequest{
-		ServerId: req.Msg.ServerId,
+		ServerId:      req.Msg.ServerId,
+		GroupSelector: groupSelector(req.Header()),
 	}
 
 	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
 	if err != nil {
 		slogger.ErrorContext(ctx, "group not found for server", "server ID", req.Msg.ServerId)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
 
 	return connect.NewResponse(&snitchpb.GetGroupForServerResponse{
@@ -66,35 +73,72 @@ func (s *RegisterServer) Register(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Check if server is already registered
-	findGroupReq := &snitchpb.FindGroupByServerRequest{
+	// Servers can be in several groups; joining the same group twice is rejected when the invite is redeemed
+	listGroupsResp, err := s.dbClient.ListServerGroups(ctx, connect.NewRequest(&snitchpb.DatabaseServiceListServerGroupsRequest{
 		ServerId: serverID,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed listing server groups", "Error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
-	_, err = s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
-	if err == nil {
-		slogger.ErrorContext(ctx, "Server is already registered")
-		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("server already registered"))
+	if len(listGroupsResp.Msg.Groups) >= maxServerGroups {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("a server can be in at most %d groups, leave one first", maxServerGroups))
 	}
 
-	var groupID uuid.UUID
+	var groupID string
 
-	if req.Msg.GroupId != nil {
-		// Join existing group flow
-		groupID, err = uuid.Parse(*req.Msg.GroupId)
-		if err != nil {
-			slogger.ErrorContext(ctx, "Invalid group ID format")
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
-		}
-
-		// Add server to existing group
-		addServerReq := &snitchpb.AddServerToGroupRequest{
+	if req.Msg.InviteCode != nil {
+		// Join existing group flow; the invite is used up and the server added (or its join request filed) in one step
+		redeemReq := &snitchpb.DatabaseServiceRedeemInviteRequest{
+			Code:     normalizeInviteCode(*req.Msg.InviteCode),
 			ServerId: serverID,
-			GroupId:  groupID.String(),
+			UserId:   req.Msg.UserId,
 		}
-		_, err := s.dbClient.AddServerToGroup(ctx, connect.NewRequest(addServerReq))
+		redeemResp, err := s.dbClient.RedeemInvite(ctx, connect.NewRequest(redeemReq))
 		if err != nil {
-			slogger.ErrorContext(ctx, "Failed adding server to group", "Error", err)
-			return nil, connect.NewError(connect.CodeInternal, err)
+			slogger.ErrorContext(ctx, "Failed redeeming invite", "Error", err)
+			return nil, connect.NewError(connect.CodeOf(err), err)
+		}
+		groupID = redeemResp.Msg.GroupId
+
+		if redeemResp.Msg.JoinRequestId != nil {
+			joinRequestID := *redeemResp.Msg.JoinRequestId
+
+			// Member servers approve or deny the request from their output channels
+			event := &snitchpb.SubscribeResponse{
+				Type:     snitchpb.EventType_EVENT_TYPE_JOIN_REQUESTED,
+				GroupId:  groupID,
+				ServerId: serverID,
+				Data: &snitchpb.SubscribeResponse_JoinRequested{
+					JoinRequested: &snitchpb.JoinRequestedEvent{
+						RequestId:   joinRequestID,
+						ServerId:    serverID,
+						RequestedBy: req.Msg.UserId,
+					},
+				},
+			}
+			if err := s.eventService.PublishEvent(ctx, event); err != nil {
+				if !errors.Is(err, errEventNotStored) {
+					// Subscribers catch up on stored events from the event log
+					slogger.WarnContext(ctx, "Failed to publish event", "error", err)
+				} else {
+					// No server would ever see the request, so it is withdrawn to let the server try again
+					slogger.ErrorContext(ctx, "Failed to publish join request, cancelling it", "request_id", joinRequestID, "error", err)
+					return nil, s.cancelJoinRequest(ctx, joinRequestID, groupID, err)
+				}
+			}
+
+			slogger.InfoContext(ctx, "Join request created",
+				"groupID", groupID,
+				"serverID", serverID,
+				"requestID", joinRequestID)
+
+			return connect.NewResponse(&snitchpb.RegisterResponse{
+				ServerId:      serverID,
+				GroupId:       groupID,
+				JoinRequestId: &joinRequestID,
+			}), nil
 		}
 	} else {
 		// Create new group flow
@@ -102,13 +146,18 @@ func (s *RegisterServer) Register(
 			slogger.ErrorContext(ctx, "Group name is required when creating a new group")
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("group name required"))
 		}
+		if strings.EqualFold(strings.TrimSpace(*req.Msg.GroupName), allGroupsSelector) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("%q is reserved for picking all groups", allGroupsSelector))
+		}
 
-		groupID = uuid.New()
+		groupID = uuid.NewString()
 
 		// Create the group
 		createGroupReq := &snitchpb.CreateGroupRequest{
-			GroupId:   groupID.String(),
-			GroupName: *req.Msg.GroupName,
+			GroupId:       groupID,
+			GroupName:     *req.Msg.GroupName,
+			OwnerServerId: serverID,
 		}
 		_, err := s.dbClient.CreateGroup(ctx, connect.NewRequest(createGroupReq))
 		if err != nil {
@@ -118,7 +167,7 @@ func (s *RegisterServer) Register(
 
 		// Create the group database
 		createGroupDbReq := &snitchpb.CreateGroupDatabaseRequest{
-			GroupId: groupID.String(),
+			GroupId: groupID,
 		}
 		_, err = s.dbClient.CreateGroupDatabase(ctx, connect.NewRequest(createGroupDbReq))
 		if err != nil {
@@ -129,7 +178,8 @@ func (s *RegisterServer) Register(
 		// Add server to the new group
 		addServerToNewGroupReq := &snitchpb.AddServerToGroupRequest{
 			ServerId: serverID,
-			GroupId:  groupID.String(),
+			GroupId:  groupID,
+			Role:     snitchpb.GroupRole_GROUP_ROLE_OWNER,
 		}
 		_, err = s.dbClient.AddServerToGroup(ctx, connect.NewRequest(addServerToNewGroupReq))
 		if err != nil {
@@ -139,16 +189,35 @@ func (s *RegisterServer) Register(
 	}
 
 	slogger.InfoContext(ctx, "Registration completed",
-		"groupID", groupID.String(),
+		"groupID", groupID,
 		"serverID", serverID,
-		"isNewGroup", req.Msg.GroupId == nil)
+		"isNewGroup", req.Msg.InviteCode == nil)
 
 	return connect.NewResponse(&snitchpb.RegisterResponse{
 		ServerId: serverID,
-		GroupId:  groupID.String(),
+		GroupId:  groupID,
 	}), nil
 }
 
+// cancelJoinRequest withdraws a join request the group's servers couldn't be told about, returning the error to
+// report for the failed request
+func (s *RegisterServer) cancelJoinRequest(ctx context.Context, requestID, groupID string, publishErr error) error {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if _, err := s.dbClient.CancelJoinRequest(ctx, connect.NewRequest(&snitchpb.DatabaseServiceCancelJoinRequestRequest{
+		RequestId: requestID,
+		GroupId:   groupID,
+	})); err != nil {
+		slogger.ErrorContext(ctx, "Failed to cancel join request", "request_id", requestID, "group_id", groupID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("join request was filed but couldn't be sent to the group: %w", publishErr))
+	}
+
+	return connect.NewError(connect.CodeUnavailable, fmt.Errorf("couldn't send the join request to the group, try again: %w", publishErr))
+}
+
 func (s *RegisterServer) HasGroup(
 	ctx context.Context,
 	req *connect.Request[snitchpb.HasGroupRequest],
@@ -158,20 +227,48 @@ func (s *RegisterServer) HasGroup(
 		slogger = slog.Default()
 	}
 
-	findGroupReq := &snitchpb.FindGroupByServerRequest{
+	listGroupsReq := &snitchpb.DatabaseServiceListServerGroupsRequest{
 		ServerId: req.Msg.ServerId,
 	}
 
 	var hasGroup = false
 
-	_, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	listGroupsResp, err := s.dbClient.ListServerGroups(ctx, connect.NewRequest(listGroupsReq))
 	if err == nil {
-		hasGroup = true
+		hasGroup = len(listGroupsResp.Msg.Groups) > 0
 	} else {
-		slogger.DebugContext(ctx, "logs when running find group by server", "error", err)
+		slogger.DebugContext(ctx, "logs when running list server groups", "error", err)
 	}
 
 	return connect.NewResponse(&snitchpb.HasGroupResponse{
 		HasGroup: hasGroup,
 	}), nil
 }
+
+// ListServerGroups lists the groups the server a request was made from belongs to, and its role in each
+func (s *RegisterServer) ListServerGroups(
+	ctx context.Context,
+	req *connect.Request[snitchpb.ListServerGroupsRequest],
+) (*connect.Response[snitchpb.ListServerGroupsResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID header is required"))
+	}
+
+	listGroupsResp, err := s.dbClient.ListServerGroups(ctx, connect.NewRequest(&snitchpb.DatabaseServiceListServerGroupsRequest{
+		ServerId: serverID,
+	}))
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed listing server groups", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchpb.ListServerGroupsResponse{
+		Groups: listGroupsResp.Msg.Groups,
+	}), nil
+}
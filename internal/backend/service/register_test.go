@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// joinRequestStub files a join request for every invite and fails to store events
+type joinRequestStub struct {
+	snitchv1connect.UnimplementedDatabaseServiceHandler
+	cancelled []string
+}
+
+func (s *joinRequestStub) ListServerGroups(
+	_ context.Context,
+	_ *connect.Request[snitchv1.DatabaseServiceListServerGroupsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListServerGroupsResponse], error) {
+	return connect.NewResponse(&snitchv1.DatabaseServiceListServerGroupsResponse{}), nil
+}
+
+func (s *joinRequestStub) RedeemInvite(
+	_ context.Context,
+	_ *connect.Request[snitchv1.DatabaseServiceRedeemInviteRequest],
+) (*connect.Response[snitchv1.DatabaseServiceRedeemInviteResponse], error) {
+	return connect.NewResponse(&snitchv1.DatabaseServiceRedeemInviteResponse{
+		GroupId:       TEST_GROUP_ID,
+		JoinRequestId: proto.String("request-1"),
+	}), nil
+}
+
+func (s *joinRequestStub) AppendEvent(
+	_ context.Context,
+	_ *connect.Request[snitchv1.DatabaseServiceAppendEventRequest],
+) (*connect.Response[snitchv1.DatabaseServiceAppendEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnavailable, errors.New("database unavailable"))
+}
+
+func (s *joinRequestStub) CancelJoinRequest(
+	_ context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCancelJoinRequestRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCancelJoinRequestResponse], error) {
+	s.cancelled = append(s.cancelled, req.Msg.RequestId)
+	return connect.NewResponse(&snitchv1.DatabaseServiceCancelJoinRequestResponse{}), nil
+}
+
+func TestRegister_CancelsJoinRequestWhenEventNotStored(t *testing.T) {
+	dbClient := &joinRequestStub{}
+	server := NewRegisterServer(dbClient, NewEventService(dbClient))
+
+	req := connect.NewRequest(&snitchv1.RegisterRequest{UserId: "user-1", InviteCode: proto.String("invite")})
+	req.Header().Set(ServerIDHeader, TEST_SERVER_ID)
+	_, err := server.Register(t.Context(), req)
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("Expected Unavailable so the server retries, got %v", err)
+	}
+	if len(dbClient.cancelled) != 1 || dbClient.cancelled[0] != "request-1" {
+		t.Errorf("Expected join request request-1 to be cancelled, got %v", dbClient.cancelled)
+	}
+}
@@ -2,14 +2,22 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"snitch/internal/shared/ctxutil"
+	"snitch/internal/shared/dbtime"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ReportServer struct {
@@ -17,6 +25,111 @@ type ReportServer struct {
 	eventService *EventService
 }
 
+// reportStatusTransitions lists the statuses a report may move to from each status
+var reportStatusTransitions = map[snitchv1.ReportStatus][]snitchv1.ReportStatus{
+	snitchv1.ReportStatus_REPORT_STATUS_OPEN: {
+		snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW,
+		snitchv1.ReportStatus_REPORT_STATUS_RESOLVED,
+		snitchv1.ReportStatus_REPORT_STATUS_DISMISSED,
+	},
+	snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW: {
+		snitchv1.ReportStatus_REPORT_STATUS_OPEN,
+		snitchv1.ReportStatus_REPORT_STATUS_RESOLVED,
+		snitchv1.ReportStatus_REPORT_STATUS_DISMISSED,
+	},
+	snitchv1.ReportStatus_REPORT_STATUS_RESOLVED: {
+		snitchv1.ReportStatus_REPORT_STATUS_OPEN,
+	},
+	snitchv1.ReportStatus_REPORT_STATUS_DISMISSED: {
+		snitchv1.ReportStatus_REPORT_STATUS_OPEN,
+	},
+}
+
+// canTransitionReportStatus reports whether a report in status from may be moved to status to
+func canTransitionReportStatus(from, to snitchv1.ReportStatus) bool {
+	return slices.Contains(reportStatusTransitions[from], to)
+}
+
+// Limits enforced by the reports and report_evidence tables
+const (
+	maxReportTextLength     = 2000
+	maxReportCategoryLength = 100
+	maxEvidenceURLLength    = 500
+)
+
+// validateCreateReport checks a new report against the limits the database schema enforces
+func validateCreateReport(msg *snitchv1.CreateReportRequest) error {
+	if strings.TrimSpace(msg.ReportText) == "" {
+		return errors.New("report text is required")
+	}
+	if utf8.RuneCountInString(msg.ReportText) > maxReportTextLength {
+		return fmt.Errorf("report text must be at most %d characters", maxReportTextLength)
+	}
+	if msg.Category != nil && utf8.RuneCountInString(*msg.Category) > maxReportCategoryLength {
+		return fmt.Errorf("report category must be at most %d characters", maxReportCategoryLength)
+	}
+
+	for _, evidence := range msg.Evidence {
+		if evidence.Url == "" {
+			return errors.New("evidence URL is required")
+		}
+		if utf8.RuneCountInString(evidence.Url) > maxEvidenceURLLength {
+			return fmt.Errorf("evidence URL must be at most %d characters", maxEvidenceURLLength)
+		}
+	}
+
+	return nil
+}
+
+// Page sizes for ListReports; a Discord embed holds at most 25 fields
+const (
+	defaultReportPageSize = 10
+	maxReportPageSize     = 25
+)
+
+// parsePageToken decodes a ListReports page token into the offset of the page
+func parsePageToken(token string) (int32, error) {
+	offset, err := strconv.ParseInt(token, 10, 32)
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return int32(offset), nil
+}
+
+// pageToken encodes the offset of a ListReports page into a page token
+func pageToken(offset int32) *string {
+	token := strconv.FormatInt(int64(offset), 10)
+	return &token
+}
+
+// parseDatabaseTimestamp converts a database timestamp into a protobuf timestamp, or nil if it can't be parsed
+func parseDatabaseTimestamp(value string) *timestamppb.Timestamp {
+	parsed, err := dbtime.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(parsed)
+}
+
+// reportFromDatabase converts a database report into its API representation
+func reportFromDatabase(dbReport *snitchv1.DatabaseServiceGetReportResponse) *snitchv1.Report {
+	report := &snitchv1.Report{
+		Id:             dbReport.Id,
+		ReporterId:     dbReport.ReporterId,
+		ReportedId:     dbReport.UserId,
+		OriginServerId: dbReport.ServerId,
+		ReportText:     dbReport.Reason,
+		Category:       dbReport.Category,
+		Status:         dbReport.Status,
+		CreatedAt:      parseDatabaseTimestamp(dbReport.CreatedAt),
+		Evidence:       dbReport.Evidence,
+	}
+	if dbReport.UpdatedAt != nil {
+		report.UpdatedAt = parseDatabaseTimestamp(*dbReport.UpdatedAt)
+	}
+	return report
+}
+
 func NewReportServer(dbClient snitchv1connect.DatabaseServiceClient, eventService *EventService) *ReportServer {
 	return &ReportServer{
 		dbClient:     dbClient,
@@ -40,56 +153,75 @@ func (s *ReportServer) CreateReport(
 			fmt.Errorf("server ID header is required"))
 	}
 
-	// Find group ID for this server
-	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
-	}
-	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
-	if err != nil {
-		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	if err := validateCreateReport(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	groupID := findGroupResp.Msg.GroupId
 
-	// Create the report
-	createReportReq := &snitchv1.DatabaseServiceCreateReportRequest{
-		GroupId:    groupID,
-		UserId:     req.Msg.ReportedId,
-		ReporterId: req.Msg.ReporterId,
-		ServerId:   serverID,
-		Reason:     req.Msg.ReportText,
-	}
-	createReportResp, err := s.dbClient.CreateReport(ctx, connect.NewRequest(createReportReq))
+	// A report goes to the selected group, or to every group the server can report in
+	groups, err := targetGroups(ctx, s.dbClient, serverID, req.Header(), snitchv1.GroupRole_GROUP_ROLE_MEMBER, "create reports")
 	if err != nil {
-		slogger.Error("Failed to create report", "group_id", groupID, "error", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		slogger.Error("Failed to find groups for server", "server_id", serverID, "error", err)
+		return nil, err
 	}
 
-	reportID := createReportResp.Msg.ReportId
+	resp := &snitchv1.CreateReportResponse{}
+	for _, group := range groups {
+		groupID := group.GroupId
 
-	// Emit event
-	event := &snitchv1.SubscribeResponse{
-		Type:     snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
-		GroupId:  groupID,
-		ServerId: serverID,
-		Data: &snitchv1.SubscribeResponse_ReportCreated{
-			ReportCreated: &snitchv1.ReportCreatedEvent{
-				ReportId:   reportID,
-				ReportedId: req.Msg.ReportedId,
-				ReporterId: req.Msg.ReporterId,
-				ReportText: req.Msg.ReportText,
+		// The event is written to the group outbox with the report, so it is published even if the backend stops
+		// before it could be; the database fills in the report ID
+		event := &snitchv1.SubscribeResponse{
+			Type:           snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+			GroupId:        groupID,
+			ServerId:       serverID,
+			IdempotencyKey: uuid.NewString(),
+			Data: &snitchv1.SubscribeResponse_ReportCreated{
+				ReportCreated: &snitchv1.ReportCreatedEvent{
+					ReportedId: req.Msg.ReportedId,
+					ReporterId: req.Msg.ReporterId,
+					ReportText: req.Msg.ReportText,
+				},
 			},
-		},
-	}
-	if err := s.eventService.PublishEvent(ctx, event); err != nil {
-		slogger.Warn("Failed to publish event", "error", err)
+		}
+
+		// Create the report
+		createReportReq := &snitchv1.DatabaseServiceCreateReportRequest{
+			GroupId:    groupID,
+			UserId:     req.Msg.ReportedId,
+			ReporterId: req.Msg.ReporterId,
+			ServerId:   serverID,
+			Reason:     req.Msg.ReportText,
+			Evidence:   req.Msg.Evidence,
+			Category:   req.Msg.Category,
+			Event:      event,
+		}
+		createReportResp, err := s.dbClient.CreateReport(ctx, connect.NewRequest(createReportReq))
+		if err != nil {
+			slogger.Error("Failed to create report", "group_id", groupID, "error", err)
+			// The groups filed to already keep their reports, so the caller is told which groups failed instead
+			if len(groups) > 1 {
+				resp.Reports = append(resp.Reports, &snitchv1.GroupReport{GroupId: groupID, GroupName: group.GroupName, Error: err.Error()})
+				continue
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		reportID := createReportResp.Msg.ReportId
+		s.eventService.NotifyOutbox()
+
+		slogger.Info("Report created", "report_id", reportID, "group_id", groupID)
+
+		if resp.ReportId == 0 {
+			resp.ReportId = reportID
+		}
+		resp.Reports = append(resp.Reports, &snitchv1.GroupReport{GroupId: groupID, GroupName: group.GroupName, ReportId: reportID})
 	}
 
-	slogger.Info("Report created", "report_id", reportID, "group_id", groupID)
+	if resp.ReportId == 0 {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create the report in any of the server's groups"))
+	}
 
-	return connect.NewResponse(&snitchv1.CreateReportResponse{
-		ReportId: reportID,
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ReportServer) ListReports(
@@ -110,21 +242,53 @@ func (s *ReportServer) ListReports(
 
 	// Find group ID for this server
 	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
 	}
 	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
 	if err != nil {
 		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
 	groupID := findGroupResp.Msg.GroupId
 
+	pageSize := int32(defaultReportPageSize)
+	if req.Msg.PageSize != nil {
+		if *req.Msg.PageSize < 1 || *req.Msg.PageSize > maxReportPageSize {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("page size must be between 1 and %d", maxReportPageSize))
+		}
+		pageSize = *req.Msg.PageSize
+	}
+
+	var offset int32
+	if req.Msg.PageToken != nil {
+		offset, err = parsePageToken(*req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if req.Msg.CreatedAfter != nil && req.Msg.CreatedBefore != nil &&
+		!req.Msg.CreatedAfter.AsTime().Before(req.Msg.CreatedBefore.AsTime()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("created_after must be before created_before"))
+	}
+
+	// Fetch one extra report to find out whether there is a next page
+	limit := pageSize + 1
+
 	// List reports - convert from old protobuf format to new format for now
 	listReportsReq := &snitchv1.DatabaseServiceListReportsRequest{
-		GroupId: groupID,
-		UserId:  req.Msg.ReportedId,
-		Limit:   nil,
-		Offset:  nil,
+		GroupId:        groupID,
+		UserId:         req.Msg.ReportedId,
+		ReporterId:     req.Msg.ReporterId,
+		OriginServerId: req.Msg.OriginServerId,
+		Status:         req.Msg.Status,
+		CreatedAfter:   req.Msg.CreatedAfter,
+		CreatedBefore:  req.Msg.CreatedBefore,
+		Limit:          &limit,
+		Offset:         &offset,
 	}
 	listReportsResp, err := s.dbClient.ListReports(ctx, connect.NewRequest(listReportsReq))
 	if err != nil {
@@ -132,18 +296,68 @@ func (s *ReportServer) ListReports(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	response := &snitchv1.ListReportsResponse{GroupId: groupID}
+
+	dbReports := listReportsResp.Msg.Reports
+	if len(dbReports) > int(pageSize) {
+		dbReports = dbReports[:pageSize]
+		response.NextPageToken = pageToken(offset + pageSize)
+	}
+	if offset > 0 {
+		response.PreviousPageToken = pageToken(max(offset-pageSize, 0))
+	}
+
 	// Convert from database format to API format
-	var reports []*snitchv1.CreateReportRequest
-	for _, dbReport := range listReportsResp.Msg.Reports {
-		reports = append(reports, &snitchv1.CreateReportRequest{
-			ReportText: dbReport.Reason,
-			ReporterId: dbReport.ReporterId,
-			ReportedId: dbReport.UserId,
-		})
+	var reports []*snitchv1.Report
+	for _, dbReport := range dbReports {
+		reports = append(reports, reportFromDatabase(dbReport))
+	}
+
+	response.Reports = reports
+
+	return connect.NewResponse(response), nil
+}
+
+func (s *ReportServer) GetReport(
+	ctx context.Context,
+	req *connect.Request[snitchv1.GetReportRequest],
+) (*connect.Response[snitchv1.GetReportResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
 	}
 
-	return connect.NewResponse(&snitchv1.ListReportsResponse{
-		Reports: reports,
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	// Find group ID for this server
+	findGroupReq := &snitchv1.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
+	}
+	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	groupID := findGroupResp.Msg.GroupId
+
+	getReportReq := &snitchv1.DatabaseServiceGetReportRequest{
+		GroupId:  groupID,
+		ReportId: req.Msg.ReportId,
+	}
+	getReportResp, err := s.dbClient.GetReport(ctx, connect.NewRequest(getReportReq))
+	if err != nil {
+		slogger.Error("Failed to get report", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return connect.NewResponse(&snitchv1.GetReportResponse{
+		Report: reportFromDatabase(getReportResp.Msg),
 	}), nil
 }
 
@@ -165,40 +379,60 @@ func (s *ReportServer) DeleteReport(
 
 	// Find group ID for this server
 	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
 	}
 	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
 	if err != nil {
 		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
 	groupID := findGroupResp.Msg.GroupId
+	if err := requireRole(findGroupResp.Msg.Role, snitchv1.GroupRole_GROUP_ROLE_MEMBER, "delete reports"); err != nil {
+		return nil, err
+	}
 
-	// Delete the report
-	deleteReportReq := &snitchv1.DatabaseServiceDeleteReportRequest{
+	// Only the origin server or an admin may delete a report
+	getReportResp, err := s.dbClient.GetReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetReportRequest{
 		GroupId:  groupID,
 		ReportId: req.Msg.ReportId,
-	}
-	_, err = s.dbClient.DeleteReport(ctx, connect.NewRequest(deleteReportReq))
+	}))
 	if err != nil {
-		slogger.Error("Failed to delete report", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		slogger.Error("Failed to get report", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	if err := requireReportAccess(findGroupResp.Msg.Role, serverID, getReportResp.Msg.ServerId, "delete"); err != nil {
+		slogger.Warn("Rejected report deletion", "group_id", groupID, "report_id", req.Msg.ReportId, "server_id", serverID, "user_id", req.Msg.UserId)
+		return nil, err
 	}
 
-	// Emit event
+	// The event is written to the group outbox with the deletion, so it is published once the report is gone
 	event := &snitchv1.SubscribeResponse{
-		Type:     snitchv1.EventType_EVENT_TYPE_REPORT_DELETED,
-		GroupId:  groupID,
-		ServerId: serverID,
+		Type:           snitchv1.EventType_EVENT_TYPE_REPORT_DELETED,
+		GroupId:        groupID,
+		ServerId:       serverID,
+		IdempotencyKey: uuid.NewString(),
 		Data: &snitchv1.SubscribeResponse_ReportDeleted{
 			ReportDeleted: &snitchv1.ReportDeletedEvent{
 				ReportId: req.Msg.ReportId,
 			},
 		},
 	}
-	if err := s.eventService.PublishEvent(ctx, event); err != nil {
-		slogger.Warn("Failed to publish event", "error", err)
+
+	// Delete the report, recording who deleted it in the audit log
+	deleteReportReq := &snitchv1.DatabaseServiceDeleteReportRequest{
+		GroupId:  groupID,
+		ReportId: req.Msg.ReportId,
+		ServerId: serverID,
+		UserId:   req.Msg.UserId,
+		Event:    event,
+	}
+	_, err = s.dbClient.DeleteReport(ctx, connect.NewRequest(deleteReportReq))
+	if err != nil {
+		slogger.Error("Failed to delete report", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
+	s.eventService.NotifyOutbox()
 
 	slogger.Info("Report deleted", "report_id", req.Msg.ReportId, "group_id", groupID)
 
@@ -206,3 +440,237 @@ func (s *ReportServer) DeleteReport(
 		ReportId: req.Msg.ReportId,
 	}), nil
 }
+
+func (s *ReportServer) UpdateReportStatus(
+	ctx context.Context,
+	req *connect.Request[snitchv1.UpdateReportStatusRequest],
+) (*connect.Response[snitchv1.UpdateReportStatusResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	if _, ok := reportStatusTransitions[req.Msg.Status]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid report status: %s", req.Msg.Status))
+	}
+
+	// Find group ID for this server
+	findGroupReq := &snitchv1.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
+	}
+	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	groupID := findGroupResp.Msg.GroupId
+	if err := requireRole(findGroupResp.Msg.Role, snitchv1.GroupRole_GROUP_ROLE_MEMBER, "update reports"); err != nil {
+		return nil, err
+	}
+
+	// Look up the current status so the transition can be validated
+	getReportReq := &snitchv1.DatabaseServiceGetReportRequest{
+		GroupId:  groupID,
+		ReportId: req.Msg.ReportId,
+	}
+	getReportResp, err := s.dbClient.GetReport(ctx, connect.NewRequest(getReportReq))
+	if err != nil {
+		slogger.Error("Failed to get report", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	previousStatus := getReportResp.Msg.Status
+
+	// Only the origin server or an admin may change a report's status
+	if err := requireReportAccess(findGroupResp.Msg.Role, serverID, getReportResp.Msg.ServerId, "update"); err != nil {
+		slogger.Warn("Rejected report status update", "group_id", groupID, "report_id", req.Msg.ReportId, "server_id", serverID, "user_id", req.Msg.UserId)
+		return nil, err
+	}
+
+	if !canTransitionReportStatus(previousStatus, req.Msg.Status) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("cannot move report %d from %s to %s", req.Msg.ReportId, previousStatus, req.Msg.Status))
+	}
+
+	// Update the report status, recording who changed it in the audit log. The database refuses the update
+	// if another change got in since the status was read, so the transition can't be bypassed.
+	updateStatusReq := &snitchv1.DatabaseServiceUpdateReportStatusRequest{
+		GroupId:        groupID,
+		ReportId:       req.Msg.ReportId,
+		Status:         req.Msg.Status,
+		ServerId:       serverID,
+		UserId:         req.Msg.UserId,
+		ExpectedStatus: previousStatus,
+	}
+	_, err = s.dbClient.UpdateReportStatus(ctx, connect.NewRequest(updateStatusReq))
+	if err != nil {
+		slogger.Error("Failed to update report status", "group_id", groupID, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	slogger.Info("Report status updated", "report_id", req.Msg.ReportId, "group_id", groupID, "from", previousStatus, "to", req.Msg.Status)
+
+	return connect.NewResponse(&snitchv1.UpdateReportStatusResponse{
+		ReportId:       req.Msg.ReportId,
+		PreviousStatus: previousStatus,
+		Status:         req.Msg.Status,
+	}), nil
+}
+
+// defaultAuditLogLimit is how many audit entries ListReportAuditLog returns when no limit is given
+const defaultAuditLogLimit = 10
+
+func (s *ReportServer) ListReportAuditLog(
+	ctx context.Context,
+	req *connect.Request[snitchv1.ListReportAuditLogRequest],
+) (*connect.Response[snitchv1.ListReportAuditLogResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	limit := int32(defaultAuditLogLimit)
+	if req.Msg.Limit != nil {
+		if *req.Msg.Limit < 1 || *req.Msg.Limit > maxReportPageSize {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("limit must be between 1 and %d", maxReportPageSize))
+		}
+		limit = *req.Msg.Limit
+	}
+
+	// Find group ID for this server
+	findGroupReq := &snitchv1.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
+	}
+	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	groupID := findGroupResp.Msg.GroupId
+	// The audit log shows who changed reports, so it needs the same role as changing them
+	if err := requireRole(findGroupResp.Msg.Role, snitchv1.GroupRole_GROUP_ROLE_MEMBER, "view the report audit log"); err != nil {
+		return nil, err
+	}
+
+	auditResp, err := s.dbClient.ListReportAuditLog(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListReportAuditLogRequest{
+		GroupId: groupID,
+		Limit:   &limit,
+	}))
+	if err != nil {
+		slogger.Error("Failed to list report audit log", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	entries := make([]*snitchv1.ReportAuditEntry, 0, len(auditResp.Msg.Entries))
+	for _, entry := range auditResp.Msg.Entries {
+		entries = append(entries, &snitchv1.ReportAuditEntry{
+			AuditId:        entry.AuditId,
+			ReportId:       entry.ReportId,
+			Action:         entry.Action,
+			ActorServerId:  entry.ActorServerId,
+			ActorUserId:    entry.ActorUserId,
+			OriginServerId: entry.OriginServerId,
+			ReportedUserId: entry.ReportedUserId,
+			Details:        entry.Details,
+			CreatedAt:      parseDatabaseTimestamp(entry.CreatedAt),
+		})
+	}
+
+	return connect.NewResponse(&snitchv1.ListReportAuditLogResponse{Entries: entries}), nil
+}
+
+// lookupRecentReportCount is how many of a user's latest reports LookupUser returns
+const lookupRecentReportCount = 3
+
+func (s *ReportServer) LookupUser(
+	ctx context.Context,
+	req *connect.Request[snitchv1.LookupUserRequest],
+) (*connect.Response[snitchv1.LookupUserResponse], error) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	// Get server ID from header
+	serverID := req.Header().Get(ServerIDHeader)
+	if serverID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("user ID is required"))
+	}
+
+	// Find group ID for this server
+	findGroupReq := &snitchv1.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
+	}
+	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	if err != nil {
+		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	groupID := findGroupResp.Msg.GroupId
+
+	summaryReq := &snitchv1.DatabaseServiceGetUserReportSummaryRequest{
+		GroupId: groupID,
+		UserId:  req.Msg.UserId,
+	}
+	summaryResp, err := s.dbClient.GetUserReportSummary(ctx, connect.NewRequest(summaryReq))
+	if err != nil {
+		slogger.Error("Failed to get user report summary", "group_id", groupID, "user_id", req.Msg.UserId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	summary := summaryResp.Msg
+
+	resp := &snitchv1.LookupUserResponse{
+		UserId:          req.Msg.UserId,
+		ReportCount:     summary.ReportCount,
+		OpenReportCount: summary.OpenReportCount,
+		ServerCount:     summary.ServerCount,
+		BanCount:        summary.BanCount,
+	}
+	if summary.LastReportedAt != nil {
+		resp.LastReportedAt = parseDatabaseTimestamp(*summary.LastReportedAt)
+	}
+
+	if summary.ReportCount > 0 {
+		limit := int32(lookupRecentReportCount)
+		listReportsReq := &snitchv1.DatabaseServiceListReportsRequest{
+			GroupId: groupID,
+			UserId:  &req.Msg.UserId,
+			Limit:   &limit,
+		}
+		listReportsResp, err := s.dbClient.ListReports(ctx, connect.NewRequest(listReportsReq))
+		if err != nil {
+			slogger.Error("Failed to list recent reports", "group_id", groupID, "user_id", req.Msg.UserId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		for _, dbReport := range listReportsResp.Msg.Reports {
+			resp.RecentReports = append(resp.RecentReports, reportFromDatabase(dbReport))
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+func TestCanTransitionReportStatus(t *testing.T) {
+	tests := []struct {
+		from, to snitchv1.ReportStatus
+		allowed  bool
+	}{
+		{snitchv1.ReportStatus_REPORT_STATUS_OPEN, snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW, true},
+		{snitchv1.ReportStatus_REPORT_STATUS_OPEN, snitchv1.ReportStatus_REPORT_STATUS_RESOLVED, true},
+		{snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW, snitchv1.ReportStatus_REPORT_STATUS_DISMISSED, true},
+		{snitchv1.ReportStatus_REPORT_STATUS_RESOLVED, snitchv1.ReportStatus_REPORT_STATUS_OPEN, true},
+		{snitchv1.ReportStatus_REPORT_STATUS_OPEN, snitchv1.ReportStatus_REPORT_STATUS_OPEN, false},
+		{snitchv1.ReportStatus_REPORT_STATUS_RESOLVED, snitchv1.ReportStatus_REPORT_STATUS_DISMISSED, false},
+		{snitchv1.ReportStatus_REPORT_STATUS_DISMISSED, snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW, false},
+		{snitchv1.ReportStatus_REPORT_STATUS_OPEN, snitchv1.ReportStatus_REPORT_STATUS_UNSPECIFIED, false},
+	}
+
+	for _, test := range tests {
+		if got := canTransitionReportStatus(test.from, test.to); got != test.allowed {
+			t.Errorf("canTransitionReportStatus(%s, %s) = %v, expected %v", test.from, test.to, got, test.allowed)
+		}
+	}
+}
+
+func TestValidateCreateReport(t *testing.T) {
+	category := "spam"
+	longCategory := strings.Repeat("c", maxReportCategoryLength+1)
+
+	tests := []struct {
+		name  string
+		msg   *snitchv1.CreateReportRequest
+		valid bool
+	}{
+		{"valid", &snitchv1.CreateReportRequest{ReportText: "spamming invites", Category: &category}, true},
+		{"max length text", &snitchv1.CreateReportRequest{ReportText: strings.Repeat("é", maxReportTextLength)}, true},
+		{"empty text", &snitchv1.CreateReportRequest{ReportText: ""}, false},
+		{"blank text", &snitchv1.CreateReportRequest{ReportText: "  \n "}, false},
+		{"long text", &snitchv1.CreateReportRequest{ReportText: strings.Repeat("a", maxReportTextLength+1)}, false},
+		{"long category", &snitchv1.CreateReportRequest{ReportText: "spam", Category: &longCategory}, false},
+		{"empty evidence URL", &snitchv1.CreateReportRequest{ReportText: "spam", Evidence: []*snitchv1.ReportEvidence{{Url: ""}}}, false},
+		{"long evidence URL", &snitchv1.CreateReportRequest{ReportText: "spam", Evidence: []*snitchv1.ReportEvidence{{Url: strings.Repeat("u", maxEvidenceURLLength+1)}}}, false},
+	}
+
+	for _, test := range tests {
+		if err := validateCreateReport(test.msg); (err == nil) != test.valid {
+			t.Errorf("%s: validateCreateReport() error = %v, expected valid = %v", test.name, err, test.valid)
+		}
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	for _, offset := range []int32{0, 10, 250} {
+		got, err := parsePageToken(*pageToken(offset))
+		if err != nil || got != offset {
+			t.Errorf("parsePageToken(pageToken(%d)) = %d, %v", offset, got, err)
+		}
+	}
+
+	for _, token := range []string{"", "abc", "-10", "99999999999"} {
+		if _, err := parsePageToken(token); err == nil {
+			t.Errorf("parsePageToken(%q) expected an error", token)
+		}
+	}
+}
+
+func TestParseDatabaseTimestamp(t *testing.T) {
+	got := parseDatabaseTimestamp("2025-03-04 05:06:07")
+	if got == nil || got.AsTime().Format(time.RFC3339) != "2025-03-04T05:06:07Z" {
+		t.Errorf("parseDatabaseTimestamp() = %v, expected 2025-03-04T05:06:07Z", got)
+	}
+
+	got = parseDatabaseTimestamp("2025-03-04T05:06:07Z")
+	if got == nil || got.AsTime().Format(time.RFC3339) != "2025-03-04T05:06:07Z" {
+		t.Errorf("parseDatabaseTimestamp() = %v, expected 2025-03-04T05:06:07Z", got)
+	}
+
+	if got := parseDatabaseTimestamp(""); got != nil {
+		t.Errorf("parseDatabaseTimestamp(\"\") = %v, expected nil", got)
+	}
+}
+
+// auditLogStub finds TEST_SERVER_ID in TEST_GROUP_ID with a role and lists one audit entry
+type auditLogStub struct {
+	snitchv1connect.UnimplementedDatabaseServiceHandler
+	role snitchv1.GroupRole
+}
+
+func (s *auditLogStub) FindGroupByServer(
+	_ context.Context,
+	_ *connect.Request[snitchv1.FindGroupByServerRequest],
+) (*connect.Response[snitchv1.FindGroupByServerResponse], error) {
+	return connect.NewResponse(&snitchv1.FindGroupByServerResponse{GroupId: TEST_GROUP_ID, Role: s.role}), nil
+}
+
+func (s *auditLogStub) ListReportAuditLog(
+	_ context.Context,
+	_ *connect.Request[snitchv1.DatabaseServiceListReportAuditLogRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListReportAuditLogResponse], error) {
+	return connect.NewResponse(&snitchv1.DatabaseServiceListReportAuditLogResponse{
+		Entries: []*snitchv1.DatabaseServiceReportAuditEntry{{AuditId: 1, ReportId: 1, Action: snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_DELETED}},
+	}), nil
+}
+
+func TestListReportAuditLog_RequiresMemberRole(t *testing.T) {
+	tests := []struct {
+		role    snitchv1.GroupRole
+		allowed bool
+	}{
+		{snitchv1.GroupRole_GROUP_ROLE_OBSERVER, false},
+		{snitchv1.GroupRole_GROUP_ROLE_MEMBER, true},
+		{snitchv1.GroupRole_GROUP_ROLE_ADMIN, true},
+	}
+
+	for _, tt := range tests {
+		dbClient := &auditLogStub{role: tt.role}
+		server := NewReportServer(dbClient, NewEventService(dbClient))
+
+		req := connect.NewRequest(&snitchv1.ListReportAuditLogRequest{})
+		req.Header().Set(ServerIDHeader, TEST_SERVER_ID)
+		resp, err := server.ListReportAuditLog(t.Context(), req)
+		if !tt.allowed {
+			if connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Errorf("ListReportAuditLog with the %s role: expected permission denied, got %v", tt.role, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ListReportAuditLog with the %s role failed: %v", tt.role, err)
+			continue
+		}
+		if len(resp.Msg.Entries) != 1 {
+			t.Errorf("ListReportAuditLog with the %s role: expected 1 entry, got %d", tt.role, len(resp.Msg.Entries))
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	snitchpb "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// roleName is the lowercase name of a role, e.g. "admin"
+func roleName(role snitchpb.GroupRole) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "GROUP_ROLE_"))
+}
+
+// requireRole returns a PermissionDenied error unless the role is at least the required one
+func requireRole(role, required snitchpb.GroupRole, action string) error {
+	if role >= required {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied,
+		fmt.Errorf("servers with the %s role cannot %s, it requires %s", roleName(role), action, roleName(required)))
+}
+
+// requireReportAccess returns a PermissionDenied error unless the server created the report or is a group admin
+func requireReportAccess(role snitchpb.GroupRole, serverID, originServerID, action string) error {
+	if serverID == originServerID || role >= snitchpb.GroupRole_GROUP_ROLE_ADMIN {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied,
+		fmt.Errorf("only the server that created a report or a group admin can %s it", action))
+}
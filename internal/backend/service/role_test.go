@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+
+	snitchpb "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		role     snitchpb.GroupRole
+		required snitchpb.GroupRole
+		allowed  bool
+	}{
+		{snitchpb.GroupRole_GROUP_ROLE_OBSERVER, snitchpb.GroupRole_GROUP_ROLE_OBSERVER, true},
+		{snitchpb.GroupRole_GROUP_ROLE_OBSERVER, snitchpb.GroupRole_GROUP_ROLE_MEMBER, false},
+		{snitchpb.GroupRole_GROUP_ROLE_MEMBER, snitchpb.GroupRole_GROUP_ROLE_ADMIN, false},
+		{snitchpb.GroupRole_GROUP_ROLE_ADMIN, snitchpb.GroupRole_GROUP_ROLE_MEMBER, true},
+		{snitchpb.GroupRole_GROUP_ROLE_OWNER, snitchpb.GroupRole_GROUP_ROLE_ADMIN, true},
+		{snitchpb.GroupRole_GROUP_ROLE_UNSPECIFIED, snitchpb.GroupRole_GROUP_ROLE_OBSERVER, false},
+	}
+
+	for _, tt := range tests {
+		err := requireRole(tt.role, tt.required, "test")
+		if tt.allowed && err != nil {
+			t.Errorf("requireRole(%s, %s) = %v, expected nil", tt.role, tt.required, err)
+		}
+		if !tt.allowed && connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("requireRole(%s, %s) = %v, expected permission denied", tt.role, tt.required, err)
+		}
+	}
+}
+
+func TestRoleName(t *testing.T) {
+	if got := roleName(snitchpb.GroupRole_GROUP_ROLE_ADMIN); got != "admin" {
+		t.Errorf("roleName(GROUP_ROLE_ADMIN) = %q, expected \"admin\"", got)
+	}
+}
+
+func TestRequireReportAccess(t *testing.T) {
+	tests := []struct {
+		role     snitchpb.GroupRole
+		serverID string
+		allowed  bool
+	}{
+		{snitchpb.GroupRole_GROUP_ROLE_MEMBER, "origin", true},
+		{snitchpb.GroupRole_GROUP_ROLE_MEMBER, "other", false},
+		{snitchpb.GroupRole_GROUP_ROLE_ADMIN, "other", true},
+		{snitchpb.GroupRole_GROUP_ROLE_OWNER, "other", true},
+	}
+
+	for _, tt := range tests {
+		err := requireReportAccess(tt.role, tt.serverID, "origin", "delete")
+		if tt.allowed && err != nil {
+			t.Errorf("requireReportAccess(%s, %q) = %v, expected nil", tt.role, tt.serverID, err)
+		}
+		if !tt.allowed && connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("requireReportAccess(%s, %q) = %v, expected permission denied", tt.role, tt.serverID, err)
+		}
+	}
+}
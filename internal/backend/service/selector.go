@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+)
+
+// allGroupsSelector sends reports and bans to every group of the server instead of a single one
+const allGroupsSelector = "all"
+
+// maxServerGroups caps the groups a single server can be in
+const maxServerGroups = 10
+
+// groupSelector returns the group ID or name a request picked with the group header, or nil when it picked none
+func groupSelector(header http.Header) *string {
+	selector := strings.TrimSpace(header.Get(GroupHeader))
+	if selector == "" {
+		return nil
+	}
+	return &selector
+}
+
+// groupForServer looks up the group a request picked from the groups of the server it was made from, and the server's role in it
+func groupForServer(
+	ctx context.Context,
+	dbClient snitchv1connect.DatabaseServiceClient,
+	header http.Header,
+) (string, snitchv1.GroupRole, error) {
+	serverID := header.Get(ServerIDHeader)
+	if serverID == "" {
+		return "", snitchv1.GroupRole_GROUP_ROLE_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("server ID header is required"))
+	}
+
+	findGroupResp, err := dbClient.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{
+		ServerId:      serverID,
+		GroupSelector: groupSelector(header),
+	}))
+	if err != nil {
+		return "", snitchv1.GroupRole_GROUP_ROLE_UNSPECIFIED, connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return findGroupResp.Msg.GroupId, findGroupResp.Msg.Role, nil
+}
+
+// targetGroups resolves the groups a request writes to. With the all groups selector that is every group in
+// which the server has at least the required role; otherwise it is the single selected group.
+func targetGroups(
+	ctx context.Context,
+	dbClient snitchv1connect.DatabaseServiceClient,
+	serverID string,
+	header http.Header,
+	required snitchv1.GroupRole,
+	action string,
+) ([]*snitchv1.ServerGroup, error) {
+	selector := groupSelector(header)
+
+	if selector == nil || !strings.EqualFold(*selector, allGroupsSelector) {
+		findGroupResp, err := dbClient.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{
+			ServerId:      serverID,
+			GroupSelector: selector,
+		}))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeOf(err), err)
+		}
+		if err := requireRole(findGroupResp.Msg.Role, required, action); err != nil {
+			return nil, err
+		}
+		return []*snitchv1.ServerGroup{{
+			GroupId:   findGroupResp.Msg.GroupId,
+			GroupName: findGroupResp.Msg.GroupName,
+			Role:      findGroupResp.Msg.Role,
+		}}, nil
+	}
+
+	listResp, err := dbClient.ListServerGroups(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListServerGroupsRequest{
+		ServerId: serverID,
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeOf(err), err)
+	}
+	if len(listResp.Msg.Groups) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", serverID))
+	}
+
+	var groups []*snitchv1.ServerGroup
+	for _, group := range listResp.Msg.Groups {
+		if group.Role >= required {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("servers need the %s role in at least one group to %s", roleName(required), action))
+	}
+	return groups, nil
+}
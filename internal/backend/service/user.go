@@ -38,34 +38,34 @@ func (s *UserServer) CreateUserHistory(
 			fmt.Errorf("server ID header is required"))
 	}
 
-	// Find group ID for this server
-	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
-	}
-	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
+	// History follows reports, which can go to every group of the server
+	groups, err := targetGroups(ctx, s.dbClient, serverID, req.Header(), snitchv1.GroupRole_GROUP_ROLE_MEMBER, "record user history")
 	if err != nil {
-		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		slogger.Error("Failed to find groups for server", "server_id", serverID, "error", err)
+		return nil, err
 	}
-	groupID := findGroupResp.Msg.GroupId
 
-	// Create user history entry
-	createHistoryReq := &snitchv1.DatabaseServiceCreateUserHistoryRequest{
-		GroupId:     groupID,
-		UserId:      req.Msg.UserId,
-		ServerId:    serverID,
-		Action:      "username_change",
-		Reason:      &req.Msg.Username,
-		EvidenceUrl: nil,
-	}
-	createHistoryResp, err := s.dbClient.CreateUserHistory(ctx, connect.NewRequest(createHistoryReq))
-	if err != nil {
-		slogger.Error("Failed to create user history", "group_id", groupID, "error", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
+	for _, group := range groups {
+		groupID := group.GroupId
+
+		// Create user history entry
+		createHistoryReq := &snitchv1.DatabaseServiceCreateUserHistoryRequest{
+			GroupId:     groupID,
+			UserId:      req.Msg.UserId,
+			ServerId:    serverID,
+			Action:      "username_change",
+			Reason:      &req.Msg.Username,
+			EvidenceUrl: nil,
+		}
+		createHistoryResp, err := s.dbClient.CreateUserHistory(ctx, connect.NewRequest(createHistoryReq))
+		if err != nil {
+			slogger.Error("Failed to create user history", "group_id", groupID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 
-	historyID := createHistoryResp.Msg.HistoryId
-	slogger.Info("User history created", "history_id", historyID, "group_id", groupID, "user_id", req.Msg.UserId)
+		historyID := createHistoryResp.Msg.HistoryId
+		slogger.Info("User history created", "history_id", historyID, "group_id", groupID, "user_id", req.Msg.UserId)
+	}
 
 	return connect.NewResponse(&snitchv1.CreateUserHistoryResponse{
 		UserId: req.Msg.UserId,
@@ -90,12 +90,13 @@ func (s *UserServer) ListUserHistory(
 
 	// Find group ID for this server
 	findGroupReq := &snitchv1.FindGroupByServerRequest{
-		ServerId: serverID,
+		ServerId:      serverID,
+		GroupSelector: groupSelector(req.Header()),
 	}
 	findGroupResp, err := s.dbClient.FindGroupByServer(ctx, connect.NewRequest(findGroupReq))
 	if err != nil {
 		slogger.Error("Failed to find group for server", "server_id", serverID, "error", err)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
 	groupID := findGroupResp.Msg.GroupId
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
 	"sync"
@@ -21,18 +22,30 @@ type Client struct {
 	session        *discordgo.Session
 	handlers       map[snitchv1.EventType]EventHandler
 
-	// Group-based subscriptions for efficiency
+	// ctx is the context passed to Start, which group subscriptions run under
+	ctx context.Context
+
+	// Group-based subscriptions for efficiency; a server can be in several groups
 	groupSubscriptions map[string]context.CancelFunc // groupID -> cancel function
-	serverToGroup      map[string]string             // serverID -> groupID
+	serverGroups       map[string][]string           // serverID -> groupIDs
+	cursors            map[string]int64              // groupID -> sequence of the last event received
 	mu                 sync.RWMutex
+
+	// Idempotency keys of recently handled events, oldest first, since events can be delivered more than once
+	recentKeys     map[string]struct{}
+	recentKeyOrder []string
+	recentKeysMu   sync.Mutex
 }
 
+// maxRecentEventKeys caps how many idempotency keys are remembered to drop redelivered events
+const maxRecentEventKeys = 1000
+
 type EventHandler func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error
 
 func NewClient(backendURL string, session *discordgo.Session, slogger *slog.Logger, httpClient *http.Client) *Client {
 	streamingClient := &http.Client{
 		Timeout:   0,                    // No timeout for streaming connections
-		Transport: httpClient.Transport, // Use same TLS config
+		Transport: httpClient.Transport, // Use same TLS config and API key
 	}
 
 	eventClient := snitchv1connect.NewEventServiceClient(streamingClient, backendURL)
@@ -45,7 +58,9 @@ func NewClient(backendURL string, session *discordgo.Session, slogger *slog.Logg
 		session:            session,
 		handlers:           make(map[snitchv1.EventType]EventHandler),
 		groupSubscriptions: make(map[string]context.CancelFunc),
-		serverToGroup:      make(map[string]string),
+		serverGroups:       make(map[string][]string),
+		cursors:            make(map[string]int64),
+		recentKeys:         make(map[string]struct{}),
 	}
 }
 
@@ -54,70 +69,99 @@ func (c *Client) RegisterHandler(eventType snitchv1.EventType, handler EventHand
 }
 
 func (c *Client) Start(ctx context.Context) {
+	c.mu.Lock()
+	c.ctx = ctx
+	c.mu.Unlock()
+
 	c.slogger.DebugContext(ctx, "Event client started")
 }
 
-// AddServer adds a server to the event subscription (group-based)
+// AddServer subscribes to the groups a server is in (group-based). Calling it again for a tracked server
+// picks up groups it joined or restored since. Subscriptions run until the client is stopped, even when ctx
+// belongs to a shorter lived call such as an interaction.
 func (c *Client) AddServer(ctx context.Context, serverID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Check if server is already tracked
-	if groupID, exists := c.serverToGroup[serverID]; exists {
-		c.slogger.Debug("Server already subscribed", "server_id", serverID, "group_id", groupID)
-		return nil
+	subscriptionCtx := c.ctx
+	if subscriptionCtx == nil {
+		subscriptionCtx = ctx
 	}
 
-	hasGroup, err := c.serverHasGroup(ctx, serverID)
+	groups, err := c.listServerGroups(ctx, serverID)
 	if err != nil {
-		return fmt.Errorf("failed to check if server %s has group", serverID)
+		return fmt.Errorf("failed to list groups of server %s: %w", serverID, err)
 	}
 
-	if !hasGroup {
+	if len(groups) == 0 {
 		c.slogger.WarnContext(ctx, "server doesn't have a group, moving on", "server id", serverID)
 		return nil
 	}
 
-	groupID, err := c.getGroupIDForServer(ctx, serverID)
-	if err != nil {
-		return fmt.Errorf("failed to get group ID for server %s: %w", serverID, err)
-	}
-
-	// Add server to group mapping
-	c.serverToGroup[serverID] = groupID
+	for _, group := range groups {
+		groupID := group.GroupId
+		if slices.Contains(c.serverGroups[serverID], groupID) {
+			c.slogger.Debug("Server already subscribed", "server_id", serverID, "group_id", groupID)
+			continue
+		}
 
-	// Start group subscription if this is the first server in the group
-	if c.countServersInGroup(groupID) == 1 {
-		subCtx, cancel := context.WithCancel(ctx)
-		c.groupSubscriptions[groupID] = cancel
-		go c.maintainGroupConnection(subCtx, groupID, serverID)
-		c.slogger.Info("Started group subscription", "group_id", groupID, "server_id", serverID)
-	} else {
-		c.slogger.Info("Added server to existing group subscription", "server_id", serverID, "group_id", groupID)
+		// Add server to group mapping
+		c.serverGroups[serverID] = append(c.serverGroups[serverID], groupID)
+
+		// Start group subscription if this is the first server in the group
+		if c.countServersInGroup(groupID) == 1 {
+			subCtx, cancel := context.WithCancel(subscriptionCtx)
+			c.groupSubscriptions[groupID] = cancel
+			go c.maintainGroupConnection(subCtx, groupID, serverID)
+			c.slogger.Info("Started group subscription", "group_id", groupID, "server_id", serverID)
+		} else {
+			c.slogger.Info("Added server to existing group subscription", "server_id", serverID, "group_id", groupID)
+		}
 	}
 
 	return nil
 }
 
-// RemoveServer removes a server from the event subscription (group-based)
+// RemoveServer removes a server from the event subscriptions of every group it is in (group-based)
 func (c *Client) RemoveServer(serverID string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	groupID, exists := c.serverToGroup[serverID]
+	groupIDs, exists := c.serverGroups[serverID]
 	if !exists {
 		c.slogger.Debug("Server not found in subscriptions", "server_id", serverID)
 		return
 	}
 
-	// Remove server from group mapping
-	delete(c.serverToGroup, serverID)
+	for _, groupID := range slices.Clone(groupIDs) {
+		c.removeServerFromGroup(serverID, groupID)
+	}
+}
+
+// RemoveServerFromGroup stops tracking a server in one group, keeping its other groups subscribed
+func (c *Client) RemoveServerFromGroup(serverID, groupID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeServerFromGroup(serverID, groupID)
+}
+
+// removeServerFromGroup stops the group subscription once its last server is removed
+// Note: this method assumes the mutex is already held by the caller
+func (c *Client) removeServerFromGroup(serverID, groupID string) {
+	groupIDs := slices.DeleteFunc(c.serverGroups[serverID], func(id string) bool { return id == groupID })
+	if len(groupIDs) == 0 {
+		delete(c.serverGroups, serverID)
+	} else {
+		c.serverGroups[serverID] = groupIDs
+	}
 
 	// If this was the last server in the group, stop the group subscription
 	if c.countServersInGroup(groupID) == 0 {
 		if cancel, exists := c.groupSubscriptions[groupID]; exists {
 			cancel()
 			delete(c.groupSubscriptions, groupID)
+			delete(c.cursors, groupID)
 			c.slogger.Info("Stopped group subscription", "group_id", groupID, "server_id", serverID)
 		}
 	} else {
@@ -125,54 +169,136 @@ func (c *Client) RemoveServer(serverID string) {
 	}
 }
 
+// RemoveGroup stops the group's subscription and forgets every server in it, keeping their other groups
+func (c *Client) RemoveGroup(groupID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for serverID, groupIDs := range c.serverGroups {
+		groupIDs = slices.DeleteFunc(groupIDs, func(id string) bool { return id == groupID })
+		if len(groupIDs) == 0 {
+			delete(c.serverGroups, serverID)
+		} else {
+			c.serverGroups[serverID] = groupIDs
+		}
+	}
+
+	if cancel, exists := c.groupSubscriptions[groupID]; exists {
+		cancel()
+		delete(c.groupSubscriptions, groupID)
+		c.slogger.Info("Stopped group subscription", "group_id", groupID)
+	}
+	delete(c.cursors, groupID)
+}
+
+// subscribedEventTypes returns the event types the client handles. Servers leaving and groups being deleted are
+// always subscribed to, since they change which groups the client follows.
+func (c *Client) subscribedEventTypes() []snitchv1.EventType {
+	eventTypes := []snitchv1.EventType{
+		snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED,
+		snitchv1.EventType_EVENT_TYPE_GROUP_DELETED,
+	}
+	for eventType := range c.handlers {
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	slices.Sort(eventTypes)
+	return eventTypes
+}
+
+// cursor returns the sequence of the last event received from a group, if any was
+func (c *Client) cursor(groupID string) (int64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	sequence, ok := c.cursors[groupID]
+	return sequence, ok
+}
+
+// advanceCursor records that a group's events up to a sequence were received, ignoring groups no longer subscribed to
+func (c *Client) advanceCursor(groupID string, sequence int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, subscribed := c.groupSubscriptions[groupID]; !subscribed {
+		return
+	}
+	if current, ok := c.cursors[groupID]; !ok || sequence > current {
+		c.cursors[groupID] = sequence
+	}
+}
+
+// markHandled records an event's idempotency key, returning false when an event with the same key was already handled
+func (c *Client) markHandled(idempotencyKey string) bool {
+	if idempotencyKey == "" {
+		return true
+	}
+
+	c.recentKeysMu.Lock()
+	defer c.recentKeysMu.Unlock()
+
+	if _, seen := c.recentKeys[idempotencyKey]; seen {
+		return false
+	}
+
+	if len(c.recentKeyOrder) >= maxRecentEventKeys {
+		delete(c.recentKeys, c.recentKeyOrder[0])
+		c.recentKeyOrder = c.recentKeyOrder[1:]
+	}
+	c.recentKeys[idempotencyKey] = struct{}{}
+	c.recentKeyOrder = append(c.recentKeyOrder, idempotencyKey)
+	return true
+}
+
 // GetSubscribedServers returns the list of currently subscribed server IDs
 func (c *Client) GetSubscribedServers() []string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	servers := make([]string, 0, len(c.serverToGroup))
-	for serverID := range c.serverToGroup {
+	servers := make([]string, 0, len(c.serverGroups))
+	for serverID := range c.serverGroups {
 		servers = append(servers, serverID)
 	}
 	return servers
 }
 
+// ServersInGroup returns the IDs of the subscribed servers that belong to a group
+func (c *Client) ServersInGroup(groupID string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var servers []string
+	for serverID, groupIDs := range c.serverGroups {
+		if slices.Contains(groupIDs, groupID) {
+			servers = append(servers, serverID)
+		}
+	}
+	return servers
+}
+
 // countServersInGroup returns the number of servers currently in a group
 // Note: this method assumes the mutex is already held by the caller
 func (c *Client) countServersInGroup(groupID string) int {
 	count := 0
-	for _, serverGroupID := range c.serverToGroup {
-		if serverGroupID == groupID {
+	for _, groupIDs := range c.serverGroups {
+		if slices.Contains(groupIDs, groupID) {
 			count++
 		}
 	}
 	return count
 }
 
-func (c *Client) getGroupIDForServer(ctx context.Context, serverID string) (string, error) {
-	req := connect.NewRequest(&snitchv1.GetGroupForServerRequest{
-		ServerId: serverID,
-	})
-
-	getGroupIdResponse, err := c.registerClient.GetGroupForServer(ctx, req)
-	if err != nil {
-		return "", fmt.Errorf("failed to get group id for server %s: %w", serverID, err)
-	}
-
-	return getGroupIdResponse.Msg.GroupId, nil
-}
-
-func (c *Client) serverHasGroup(ctx context.Context, serverID string) (bool, error) {
-	req := connect.NewRequest(&snitchv1.HasGroupRequest{
-		ServerId: serverID,
-	})
+func (c *Client) listServerGroups(ctx context.Context, serverID string) ([]*snitchv1.ServerGroup, error) {
+	req := connect.NewRequest(&snitchv1.ListServerGroupsRequest{})
+	req.Header().Add("X-Server-ID", serverID)
 
-	hasGroupResponse, err := c.registerClient.HasGroup(ctx, req)
+	listGroupsResponse, err := c.registerClient.ListServerGroups(ctx, req)
 	if err != nil {
-		return false, fmt.Errorf("failed to check if server %s is in a group. message: %w", serverID, err)
+		return nil, fmt.Errorf("failed to list groups for server %s: %w", serverID, err)
 	}
 
-	return hasGroupResponse.Msg.HasGroup, nil
+	return listGroupsResponse.Msg.Groups, nil
 }
 
 func (c *Client) maintainGroupConnection(ctx context.Context, groupID, serverID string) {
@@ -184,6 +310,11 @@ func (c *Client) maintainGroupConnection(ctx context.Context, groupID, serverID
 		case <-ctx.Done():
 			return
 		default:
+			// The server the subscription was started for may have left the group since
+			if servers := c.ServersInGroup(groupID); len(servers) > 0 && !slices.Contains(servers, serverID) {
+				serverID = servers[0]
+			}
+
 			if err := c.connectAndListenForGroup(ctx, groupID, serverID); err != nil {
 				if ctx.Err() != nil {
 					return // Context cancelled, exit gracefully
@@ -205,14 +336,25 @@ func (c *Client) maintainGroupConnection(ctx context.Context, groupID, serverID
 }
 
 func (c *Client) connectAndListenForGroup(ctx context.Context, groupID, serverID string) error {
-	// Subscribe to all event types for the specific group
+	// Resume from the last event received so events published while disconnected are replayed.
+	// Without one, start from the group's latest event rather than only the events after connecting.
+	cursor, ok := c.cursor(groupID)
+	if !ok {
+		cursorReq := connect.NewRequest(&snitchv1.GetEventCursorRequest{GroupId: groupID})
+		cursorReq.Header().Add("X-Server-ID", serverID)
+		cursorResp, err := c.eventClient.GetEventCursor(ctx, cursorReq)
+		if err != nil {
+			return fmt.Errorf("failed to get event cursor for group %s: %w", groupID, err)
+		}
+		cursor = cursorResp.Msg.Sequence
+		c.advanceCursor(groupID, cursor)
+	}
+
+	// Subscribe only to the event types handled for the specific group
 	req := connect.NewRequest(&snitchv1.SubscribeRequest{
-		EventTypes: []snitchv1.EventType{
-			snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
-			snitchv1.EventType_EVENT_TYPE_REPORT_DELETED,
-			snitchv1.EventType_EVENT_TYPE_USER_BANNED,
-		},
-		GroupId: groupID,
+		EventTypes:       c.subscribedEventTypes(),
+		GroupId:          groupID,
+		LastSeenSequence: &cursor,
 	})
 
 	// Add server ID header so backend can validate access to this group
@@ -223,10 +365,12 @@ func (c *Client) connectAndListenForGroup(ctx context.Context, groupID, serverID
 		return fmt.Errorf("failed to subscribe to events for server %s: %w", serverID, err)
 	}
 
-	c.slogger.Info("Connected to event stream", "group_id", groupID, "server_id", serverID)
+	c.slogger.Info("Connected to event stream", "group_id", groupID, "server_id", serverID, "last_seen_sequence", cursor)
 
 	for stream.Receive() {
 		event := stream.Msg()
+		// The cursor moves first, so a deleted group's cursor is dropped along with the group
+		c.advanceCursor(groupID, event.Sequence)
 		c.handleEvent(event)
 	}
 
@@ -250,13 +394,30 @@ func (c *Client) Stop() {
 
 	// Clear all maps
 	c.groupSubscriptions = make(map[string]context.CancelFunc)
-	c.serverToGroup = make(map[string]string)
+	c.serverGroups = make(map[string][]string)
+	c.cursors = make(map[string]int64)
 	c.slogger.Info("Event client stopped")
 }
 
 func (c *Client) handleEvent(event *snitchv1.SubscribeResponse) {
 	c.slogger.Debug("Received event", "type", event.Type, "server_id", event.ServerId)
 
+	if !c.markHandled(event.IdempotencyKey) {
+		c.slogger.Debug("Dropping redelivered event", "type", event.Type, "idempotency_key", event.IdempotencyKey)
+		return
+	}
+
+	// Stop tracking servers that left the group, so handlers only notify the servers still in it.
+	// Their other groups stay subscribed.
+	if serverRemoved := event.GetServerRemoved(); serverRemoved != nil {
+		c.RemoveServerFromGroup(serverRemoved.ServerId, event.GroupId)
+	}
+
+	// Handlers still notify every server of a deleted group, so it is only dropped afterwards
+	if event.GetGroupDeleted() != nil {
+		defer c.RemoveGroup(event.GroupId)
+	}
+
 	handler, exists := c.handlers[event.Type]
 	if !exists {
 		c.slogger.Debug("No handler registered for event type", "type", event.Type)
@@ -2,8 +2,10 @@ package events
 
 import (
 	"crypto/tls"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"testing"
 
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
@@ -52,8 +54,12 @@ func TestClient_Creation(t *testing.T) {
 		t.Error("Group subscriptions map should be initialized")
 	}
 
-	if client.serverToGroup == nil {
-		t.Error("Server to group map should be initialized")
+	if client.serverGroups == nil {
+		t.Error("Server groups map should be initialized")
+	}
+
+	if client.cursors == nil {
+		t.Error("Cursors map should be initialized")
 	}
 }
 
@@ -155,4 +161,243 @@ func TestClient_MultipleHandlers(t *testing.T) {
 	}
 }
 
+func TestClient_ServersInGroup(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	client.serverGroups["server-1"] = []string{"group-1"}
+	client.serverGroups["server-2"] = []string{"group-1", "group-2"}
+	client.serverGroups["server-3"] = []string{"group-2"}
+
+	servers := client.ServersInGroup("group-1")
+	slices.Sort(servers)
+
+	if !slices.Equal(servers, []string{"server-1", "server-2"}) {
+		t.Errorf("Expected servers [server-1 server-2], got %v", servers)
+	}
+
+	servers = client.ServersInGroup("group-2")
+	slices.Sort(servers)
+
+	if !slices.Equal(servers, []string{"server-2", "server-3"}) {
+		t.Errorf("Expected servers [server-2 server-3], got %v", servers)
+	}
+
+	if servers := client.ServersInGroup("group-3"); len(servers) != 0 {
+		t.Errorf("Expected no servers for unknown group, got %v", servers)
+	}
+}
+
+func TestClient_ServerRemovedEvent(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	cancelled := false
+	client.serverGroups["server-1"] = []string{"group-1"}
+	client.serverGroups["server-2"] = []string{"group-1"}
+	client.groupSubscriptions["group-1"] = func() { cancelled = true }
+
+	var notified []string
+	client.RegisterHandler(snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED, func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		notified = client.ServersInGroup(event.GroupId)
+		return nil
+	})
+
+	removed := func(serverID string) *snitchv1.SubscribeResponse {
+		return &snitchv1.SubscribeResponse{
+			Type:    snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED,
+			GroupId: "group-1",
+			Data: &snitchv1.SubscribeResponse_ServerRemoved{
+				ServerRemoved: &snitchv1.ServerRemovedEvent{ServerId: serverID},
+			},
+		}
+	}
+
+	client.handleEvent(removed("server-1"))
+	if !slices.Equal(notified, []string{"server-2"}) {
+		t.Errorf("Expected handler to see [server-2], got %v", notified)
+	}
+	if cancelled {
+		t.Error("Subscription should stay open while servers remain in the group")
+	}
+
+	client.handleEvent(removed("server-2"))
+	if !cancelled {
+		t.Error("Subscription should be cancelled once the last server is removed")
+	}
+	if _, exists := client.groupSubscriptions["group-1"]; exists {
+		t.Error("Group subscription should be removed")
+	}
+}
+
+func TestClient_GroupDeletedEvent(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	cancelled := false
+	client.serverGroups["server-1"] = []string{"group-1"}
+	client.serverGroups["server-2"] = []string{"group-1"}
+	client.serverGroups["server-3"] = []string{"group-2"}
+	client.groupSubscriptions["group-1"] = func() { cancelled = true }
+
+	var notified []string
+	client.RegisterHandler(snitchv1.EventType_EVENT_TYPE_GROUP_DELETED, func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		notified = client.ServersInGroup(event.GroupId)
+		return nil
+	})
+
+	client.handleEvent(&snitchv1.SubscribeResponse{
+		Type:    snitchv1.EventType_EVENT_TYPE_GROUP_DELETED,
+		GroupId: "group-1",
+		Data: &snitchv1.SubscribeResponse_GroupDeleted{
+			GroupDeleted: &snitchv1.GroupDeletedEvent{DeletedBy: "user-1"},
+		},
+	})
+
+	slices.Sort(notified)
+	if !slices.Equal(notified, []string{"server-1", "server-2"}) {
+		t.Errorf("Expected handler to see [server-1 server-2], got %v", notified)
+	}
+	if !cancelled {
+		t.Error("Subscription should be cancelled once the group is deleted")
+	}
+	if servers := client.GetSubscribedServers(); !slices.Equal(servers, []string{"server-3"}) {
+		t.Errorf("Expected only servers of other groups to remain, got %v", servers)
+	}
+}
+
+func TestClient_ServerRemovedFromOneGroup(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	group1Cancelled, group2Cancelled := false, false
+	client.serverGroups["server-1"] = []string{"group-1", "group-2"}
+	client.groupSubscriptions["group-1"] = func() { group1Cancelled = true }
+	client.groupSubscriptions["group-2"] = func() { group2Cancelled = true }
+
+	client.handleEvent(&snitchv1.SubscribeResponse{
+		Type:    snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED,
+		GroupId: "group-1",
+		Data: &snitchv1.SubscribeResponse_ServerRemoved{
+			ServerRemoved: &snitchv1.ServerRemovedEvent{ServerId: "server-1"},
+		},
+	})
+
+	if !group1Cancelled {
+		t.Error("Subscription of the group the server left should be cancelled")
+	}
+	if group2Cancelled {
+		t.Error("Subscription of the server's other group should stay open")
+	}
+	if servers := client.ServersInGroup("group-2"); !slices.Equal(servers, []string{"server-1"}) {
+		t.Errorf("Expected server-1 to remain in group-2, got %v", servers)
+	}
+
+	client.RemoveServer("server-1")
+	if !group2Cancelled {
+		t.Error("Subscription should be cancelled once the bot leaves the server")
+	}
+	if servers := client.GetSubscribedServers(); len(servers) != 0 {
+		t.Errorf("Expected no subscribed servers, got %v", servers)
+	}
+}
+
+func TestClient_Cursor(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	client.serverGroups["server-1"] = []string{"group-1"}
+	client.groupSubscriptions["group-1"] = func() {}
+
+	if _, ok := client.cursor("group-1"); ok {
+		t.Error("Group should have no cursor before any event is received")
+	}
+
+	client.advanceCursor("group-1", 5)
+	client.advanceCursor("group-1", 3)
+	if cursor, _ := client.cursor("group-1"); cursor != 5 {
+		t.Errorf("Expected cursor 5, got %d", cursor)
+	}
+
+	client.advanceCursor("group-2", 7)
+	if _, ok := client.cursor("group-2"); ok {
+		t.Error("Groups that aren't subscribed to should get no cursor")
+	}
+
+	client.RemoveGroup("group-1")
+	if _, ok := client.cursor("group-1"); ok {
+		t.Error("Cursor should be dropped along with the group")
+	}
+}
+
+func TestClient_DropsRedeliveredEvents(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	handled := 0
+	client.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_CREATED, func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		handled++
+		return nil
+	})
+
+	event := &snitchv1.SubscribeResponse{
+		Type:           snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+		GroupId:        "group-1",
+		IdempotencyKey: "key-1",
+	}
+	client.handleEvent(event)
+	client.handleEvent(event)
+	if handled != 1 {
+		t.Errorf("Expected a redelivered event to be handled once, got %d", handled)
+	}
+
+	// Only the latest keys are remembered
+	for i := range maxRecentEventKeys {
+		client.handleEvent(&snitchv1.SubscribeResponse{
+			Type:           snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+			IdempotencyKey: fmt.Sprintf("other-%d", i),
+		})
+	}
+	client.handleEvent(event)
+	if handled != maxRecentEventKeys+2 {
+		t.Errorf("Expected a forgotten key to be handled again, got %d handled events", handled)
+	}
+	if len(client.recentKeys) != maxRecentEventKeys {
+		t.Errorf("Expected %d remembered keys, got %d", maxRecentEventKeys, len(client.recentKeys))
+	}
+}
+
+func TestClient_SubscribedEventTypes(t *testing.T) {
+	session := &discordgo.Session{}
+	slogger := slog.Default()
+	httpClient := createTestHTTPClient()
+	client := NewClient("https://localhost:4200", session, slogger, httpClient)
+
+	noop := func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error { return nil }
+	client.RegisterHandler(snitchv1.EventType_EVENT_TYPE_REPORT_CREATED, noop)
+	client.RegisterHandler(snitchv1.EventType_EVENT_TYPE_GROUP_DELETED, noop)
+
+	expected := []snitchv1.EventType{
+		snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+		snitchv1.EventType_EVENT_TYPE_SERVER_REMOVED,
+		snitchv1.EventType_EVENT_TYPE_GROUP_DELETED,
+	}
+	slices.Sort(expected)
+	if eventTypes := client.subscribedEventTypes(); !slices.Equal(eventTypes, expected) {
+		t.Errorf("Expected event types %v, got %v", expected, eventTypes)
+	}
+}
+
 // TODO: create new multi-server test
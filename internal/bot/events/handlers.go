@@ -1,14 +1,69 @@
 package events
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/moderation"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/bwmarrin/discordgo"
 )
 
-func CreateReportCreatedHandler(logger *slog.Logger) EventHandler {
+// serverConfigTimeout bounds the config lookup made for every server an event is posted to
+const serverConfigTimeout = 10 * time.Second
+
+// serverNotification builds the message posted to a server for an event, or nil to post nothing
+type serverNotification func(session *discordgo.Session, serverID string, config *snitchv1.ServerConfig) (*discordgo.MessageSend, error)
+
+func getServerConfig(configClient snitchv1connect.ConfigServiceClient, serverID string) (*snitchv1.ServerConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), serverConfigTimeout)
+	defer cancel()
+
+	configRequest := connect.NewRequest(&snitchv1.GetServerConfigRequest{})
+	configRequest.Header().Add("X-Server-ID", serverID)
+	configResponse, err := configClient.GetServerConfig(ctx, configRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config for server %s: %w", serverID, err)
+	}
+
+	return configResponse.Msg.Config, nil
+}
+
+// notifyGroup posts the notification built for every server the bot is in within a group to that server's output channel
+func notifyGroup(logger *slog.Logger, session *discordgo.Session, eventClient *Client, configClient snitchv1connect.ConfigServiceClient, groupID string, notify serverNotification) {
+	for _, serverID := range eventClient.ServersInGroup(groupID) {
+		config, err := getServerConfig(configClient, serverID)
+		if err != nil {
+			logger.Error("Failed to get server config", "server_id", serverID, "error", err)
+			continue
+		}
+
+		message, err := notify(session, serverID, config)
+		if err != nil {
+			logger.Error("Failed to handle event for server", "server_id", serverID, "error", err)
+			continue
+		}
+		if message == nil {
+			continue
+		}
+
+		if config.OutputChannelId == nil {
+			logger.Debug("Server has no output channel, not posting event", "server_id", serverID)
+			continue
+		}
+
+		if _, err := session.ChannelMessageSendComplex(*config.OutputChannelId, message); err != nil {
+			logger.Error("Failed to post event to output channel", "server_id", serverID, "channel_id", *config.OutputChannelId, "error", err)
+		}
+	}
+}
+
+func CreateReportCreatedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
 	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
 		reportCreated := event.GetReportCreated()
 		if reportCreated == nil {
@@ -22,11 +77,23 @@ func CreateReportCreatedHandler(logger *slog.Logger) EventHandler {
 			"server_id", event.ServerId,
 		)
 
+		embed := messageutil.NewEmbed().
+			SetTitle(fmt.Sprintf("New Report #%d", reportCreated.ReportId)).
+			SetDescription(reportCreated.ReportText).
+			AddField("Reported", fmt.Sprintf("<@%s> (%s)", reportCreated.ReportedId, reportCreated.ReportedId)).
+			AddField("Reporter", fmt.Sprintf("<@%s> (%s)", reportCreated.ReporterId, reportCreated.ReporterId)).
+			AddField("Origin server", event.ServerId).
+			MessageEmbed
+
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(*discordgo.Session, string, *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{embed}}, nil
+		})
+
 		return nil
 	}
 }
 
-func CreateReportDeletedHandler(logger *slog.Logger) EventHandler {
+func CreateReportDeletedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
 	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
 		reportDeleted := event.GetReportDeleted()
 		if reportDeleted == nil {
@@ -38,11 +105,59 @@ func CreateReportDeletedHandler(logger *slog.Logger) EventHandler {
 			"server_id", event.ServerId,
 		)
 
+		embed := messageutil.NewEmbed().
+			SetTitle(fmt.Sprintf("Report #%d Deleted", reportDeleted.ReportId)).
+			AddField("Deleted from server", event.ServerId).
+			MessageEmbed
+
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(*discordgo.Session, string, *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{embed}}, nil
+		})
+
 		return nil
 	}
 }
 
-func CreateUserBannedHandler(logger *slog.Logger) EventHandler {
+// banEmbed describes a ban made in a server of the group
+func banEmbed(userBanned *snitchv1.UserBannedEvent, outcome string) *discordgo.MessageEmbed {
+	reason := userBanned.Reason
+	if reason == "" {
+		reason = "No reason given"
+	}
+
+	return messageutil.NewEmbed().
+		SetTitle(fmt.Sprintf("Ban #%d in group", userBanned.BanId)).
+		AddField("User", fmt.Sprintf("<@%s> (%s)", userBanned.UserId, userBanned.UserId)).
+		AddField("Origin server", userBanned.ServerId).
+		AddField("Reason", reason).
+		AddField("Outcome", outcome).
+		MessageEmbed
+}
+
+// propagateBan applies the ban policy of a server to a ban made in its group
+func propagateBan(session *discordgo.Session, serverID string, config *snitchv1.ServerConfig, userBanned *snitchv1.UserBannedEvent) (*discordgo.MessageSend, error) {
+	if serverID == userBanned.ServerId {
+		return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{banEmbed(userBanned, "Banned in this server")}}, nil
+	}
+
+	switch config.GetBanPolicy() {
+	case snitchv1.BanPolicy_BAN_POLICY_AUTO:
+		reason := moderation.PropagatedBanReason(userBanned.ServerId, userBanned.Reason)
+		if err := session.GuildBanCreateWithReason(serverID, userBanned.UserId, reason, 0); err != nil {
+			return nil, fmt.Errorf("failed to ban user %s in server %s: %w", userBanned.UserId, serverID, err)
+		}
+		return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{banEmbed(userBanned, "Banned automatically")}}, nil
+	case snitchv1.BanPolicy_BAN_POLICY_APPROVE:
+		return &discordgo.MessageSend{
+			Embeds:     []*discordgo.MessageEmbed{banEmbed(userBanned, "Awaiting approval")},
+			Components: moderation.BanApprovalComponents(userBanned.UserId, userBanned.ServerId),
+		}, nil
+	default:
+		return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{banEmbed(userBanned, "Not applied in this server")}}, nil
+	}
+}
+
+func CreateUserBannedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
 	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
 		userBanned := event.GetUserBanned()
 		if userBanned == nil {
@@ -53,8 +168,121 @@ func CreateUserBannedHandler(logger *slog.Logger) EventHandler {
 			"user_id", userBanned.UserId,
 			"server_id", userBanned.ServerId,
 			"reason", userBanned.Reason,
+			"ban_id", userBanned.BanId,
 		)
 
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(session *discordgo.Session, serverID string, config *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return propagateBan(session, serverID, config, userBanned)
+		})
+
+		return nil
+	}
+}
+
+// joinRequestEmbed describes a server asking to join the group
+func joinRequestEmbed(session *discordgo.Session, joinRequested *snitchv1.JoinRequestedEvent) *discordgo.MessageEmbed {
+	server := joinRequested.ServerId
+	if guild, err := session.State.Guild(joinRequested.ServerId); err == nil {
+		server = fmt.Sprintf("%s (%s)", guild.Name, guild.ID)
+	}
+
+	return messageutil.NewEmbed().
+		SetTitle("Join Request").
+		SetDescription("A server used an invite to join the group.").
+		AddField("Server", server).
+		AddField("Requested by", fmt.Sprintf("<@%s> (%s)", joinRequested.RequestedBy, joinRequested.RequestedBy)).
+		AddField("Outcome", "Awaiting approval").
+		MessageEmbed
+}
+
+func CreateJoinRequestedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
+	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		joinRequested := event.GetJoinRequested()
+		if joinRequested == nil {
+			return fmt.Errorf("expected join requested event data")
+		}
+
+		logger.Info("Join requested event received",
+			"request_id", joinRequested.RequestId,
+			"server_id", joinRequested.ServerId,
+			"requested_by", joinRequested.RequestedBy,
+		)
+
+		embed := joinRequestEmbed(session, joinRequested)
+
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(*discordgo.Session, string, *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return &discordgo.MessageSend{
+				Embeds:     []*discordgo.MessageEmbed{embed},
+				Components: moderation.JoinRequestComponents(joinRequested.RequestId, event.GroupId),
+			}, nil
+		})
+
+		return nil
+	}
+}
+
+func CreateServerRemovedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
+	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		serverRemoved := event.GetServerRemoved()
+		if serverRemoved == nil {
+			return fmt.Errorf("expected server removed event data")
+		}
+
+		logger.Info("Server removed event received",
+			"server_id", serverRemoved.ServerId,
+			"removed_by", serverRemoved.RemovedBy,
+			"kicked", serverRemoved.Kicked,
+		)
+
+		server := serverRemoved.ServerId
+		if guild, err := session.State.Guild(serverRemoved.ServerId); err == nil {
+			server = fmt.Sprintf("%s (%s)", guild.Name, guild.ID)
+		}
+
+		title := "Server Left Group"
+		if serverRemoved.Kicked {
+			title = "Server Kicked From Group"
+		}
+
+		embed := messageutil.NewEmbed().
+			SetTitle(title).
+			AddField("Server", server).
+			AddField("By", fmt.Sprintf("<@%s> in server %s", serverRemoved.RemovedBy, event.ServerId)).
+			MessageEmbed
+
+		// The client has already stopped tracking the removed server, so only the rest of the group is notified
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(*discordgo.Session, string, *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{embed}}, nil
+		})
+
+		return nil
+	}
+}
+
+func CreateGroupDeletedHandler(logger *slog.Logger, eventClient *Client, configClient snitchv1connect.ConfigServiceClient) EventHandler {
+	return func(session *discordgo.Session, event *snitchv1.SubscribeResponse) error {
+		groupDeleted := event.GetGroupDeleted()
+		if groupDeleted == nil {
+			return fmt.Errorf("expected group deleted event data")
+		}
+
+		logger.Info("Group deleted event received",
+			"group_id", event.GroupId,
+			"server_id", event.ServerId,
+			"deleted_by", groupDeleted.DeletedBy,
+		)
+
+		embed := messageutil.NewEmbed().
+			SetTitle("Group Deleted").
+			SetDescription("This server no longer shares reports with the group.").
+			AddField("By", fmt.Sprintf("<@%s> in server %s", groupDeleted.DeletedBy, event.ServerId)).
+			AddField("Restorable until", fmt.Sprintf("<t:%d:f>", groupDeleted.PurgeAfter.AsTime().Unix())).
+			MessageEmbed
+
+		notifyGroup(logger, session, eventClient, configClient, event.GroupId, func(*discordgo.Session, string, *snitchv1.ServerConfig) (*discordgo.MessageSend, error) {
+			return &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{embed}}, nil
+		})
+
 		return nil
 	}
 }
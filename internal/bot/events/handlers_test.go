@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordStub answers Discord API requests, recording the channels messages are posted to
+type discordStub struct {
+	mu       sync.Mutex
+	channels []string
+}
+
+func (d *discordStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/v"+discordgo.APIVersion+"/")
+	parts := strings.Split(path, "/")
+	if req.Method == http.MethodPost && len(parts) == 3 && parts[0] == "channels" && parts[2] == "messages" {
+		d.mu.Lock()
+		d.channels = append(d.channels, parts[1])
+		d.mu.Unlock()
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id": "message-id"}`)),
+		Request:    req,
+	}, nil
+}
+
+// newTestSession creates a Discord session whose API requests are answered by a discordStub
+func newTestSession(t *testing.T) (*discordgo.Session, *discordStub) {
+	t.Helper()
+
+	session, err := discordgo.New("Bot test-token")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	discord := &discordStub{}
+	session.Client = &http.Client{Transport: discord}
+	return session, discord
+}
+
+// configStub returns the configured server configs
+type configStub struct {
+	snitchv1connect.UnimplementedConfigServiceHandler
+	configs map[string]*snitchv1.ServerConfig
+}
+
+func (s *configStub) GetServerConfig(
+	_ context.Context,
+	req *connect.Request[snitchv1.GetServerConfigRequest],
+) (*connect.Response[snitchv1.GetServerConfigResponse], error) {
+	return connect.NewResponse(&snitchv1.GetServerConfigResponse{Config: s.configs[req.Header().Get("X-Server-ID")]}), nil
+}
+
+func TestNotifyGroup_PostsToOutputChannels(t *testing.T) {
+	session, discord := newTestSession(t)
+	outputChannel := "output-channel"
+	configClient := &configStub{configs: map[string]*snitchv1.ServerConfig{
+		"server-1": {OutputChannelId: &outputChannel},
+		"server-2": {},
+		"server-3": {OutputChannelId: &outputChannel},
+	}}
+
+	client := NewClient("https://localhost:4200", session, slog.Default(), createTestHTTPClient())
+	client.serverGroups["server-1"] = []string{"group-1"}
+	client.serverGroups["server-2"] = []string{"group-1"}
+	client.serverGroups["server-3"] = []string{"group-2"}
+
+	handler := CreateReportCreatedHandler(slog.Default(), client, configClient)
+	err := handler(session, &snitchv1.SubscribeResponse{
+		Type:    snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+		GroupId: "group-1",
+		Data: &snitchv1.SubscribeResponse_ReportCreated{
+			ReportCreated: &snitchv1.ReportCreatedEvent{ReportId: 1, ReportedId: "user-1", ReporterId: "user-2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+
+	// Only server-1 is in the group and has an output channel
+	if !slices.Equal(discord.channels, []string{outputChannel}) {
+		t.Errorf("Expected one message in %s, got posts to %v", outputChannel, discord.channels)
+	}
+}
+
+func TestPropagateBan_FollowsBanPolicy(t *testing.T) {
+	userBanned := &snitchv1.UserBannedEvent{UserId: "user-1", ServerId: "server-1", BanId: 1}
+
+	tests := []struct {
+		serverID   string
+		policy     snitchv1.BanPolicy
+		outcome    string
+		components bool
+	}{
+		{"server-1", snitchv1.BanPolicy_BAN_POLICY_APPROVE, "Banned in this server", false},
+		{"server-2", snitchv1.BanPolicy_BAN_POLICY_APPROVE, "Awaiting approval", true},
+		{"server-2", snitchv1.BanPolicy_BAN_POLICY_UNSPECIFIED, "Not applied in this server", false},
+	}
+
+	session, _ := newTestSession(t)
+	for _, tt := range tests {
+		message, err := propagateBan(session, tt.serverID, &snitchv1.ServerConfig{BanPolicy: tt.policy}, userBanned)
+		if err != nil {
+			t.Fatalf("propagateBan failed: %v", err)
+		}
+
+		fields := message.Embeds[0].Fields
+		if outcome := fields[len(fields)-1].Value; outcome != tt.outcome {
+			t.Errorf("propagateBan to %s with %s: expected outcome %q, got %q", tt.serverID, tt.policy, tt.outcome, outcome)
+		}
+		if (len(message.Components) > 0) != tt.components {
+			t.Errorf("propagateBan to %s with %s: expected approval buttons %v, got %v", tt.serverID, tt.policy, tt.components, message.Components)
+		}
+	}
+}
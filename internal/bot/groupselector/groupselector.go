@@ -0,0 +1,57 @@
+// Package groupselector carries the group a command picked, for servers in several groups, through to backend requests
+package groupselector
+
+import (
+	"context"
+	"net/http"
+
+	"snitch/internal/shared/ctxutil"
+)
+
+// Header picks one of the groups of a server in several, by group ID or name
+const Header = "X-Group"
+
+// All sends reports and bans to every group of the server
+const All = "all"
+
+// OptionName is the command option and report form input a group is picked with
+const OptionName = "group"
+
+// Selector is a group ID or name, or All
+type Selector string
+
+// WithSelector returns a context whose backend requests target the selected group; an empty selector is ignored
+func WithSelector(ctx context.Context, selector string) context.Context {
+	if selector == "" {
+		return ctx
+	}
+	return ctxutil.WithValue(ctx, Selector(selector))
+}
+
+// FromContext returns the group selected for a context, or "" when none was
+func FromContext(ctx context.Context) string {
+	selector, _ := ctxutil.Value[Selector](ctx)
+	return string(selector)
+}
+
+// Transport sets the group header on requests whose context carries a selector
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	selector := FromContext(req.Context())
+	if selector == "" || req.Header.Get(Header) != "" {
+		return base.RoundTrip(req)
+	}
+
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, selector)
+	return base.RoundTrip(req)
+}
@@ -16,6 +16,8 @@ const (
 	EmbedLimitField       = 25
 	EmbedLimitFooter      = 2048
 	EmbedLimit            = 4000
+	// EmbedLimitTotal caps the title, description, field names and values and footer of an embed together
+	EmbedLimitTotal = 6000
 )
 
 // NewEmbed returns a new embed object
@@ -39,3 +39,38 @@ func EmbedRespondContext(ctx context.Context, session *discordgo.Session, intera
 		slogger.ErrorContext(ctx, "Failed to respond", "Error", err)
 	}
 }
+
+func EmbedComponentsRespondContext(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, embeds []*discordgo.MessageEmbed, components []discordgo.MessageComponent) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     embeds,
+			Components: components,
+		},
+	}); err != nil {
+		slogger.ErrorContext(ctx, "Failed to respond", "Error", err)
+	}
+}
+
+// EmbedComponentsUpdateContext replaces the message a component belongs to
+func EmbedComponentsUpdateContext(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, embeds []*discordgo.MessageEmbed, components []discordgo.MessageComponent) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     embeds,
+			Components: components,
+		},
+	}); err != nil {
+		slogger.ErrorContext(ctx, "Failed to update message", "Error", err)
+	}
+}
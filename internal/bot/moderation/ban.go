@@ -0,0 +1,130 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/slashcommand"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// BanReasonPrefix marks bans the bot applied on behalf of another server so they aren't propagated again
+const BanReasonPrefix = "[snitch]"
+
+// BanApprovalButton is the custom ID name the ban approval buttons are routed by
+const BanApprovalButton = "ban-approval"
+
+// Actions encoded in ban approval button custom IDs
+const (
+	BanApprovalApprove = "approve"
+	BanApprovalDismiss = "dismiss"
+)
+
+// maxBanReasonLength is the longest audit log reason Discord accepts
+const maxBanReasonLength = 512
+
+// recordBanTimeout bounds the backend call made for every ban the bot sees
+const recordBanTimeout = 10 * time.Second
+
+// IsPropagatedBan reports whether a ban reason was written by PropagatedBanReason
+func IsPropagatedBan(reason string) bool {
+	return strings.HasPrefix(reason, BanReasonPrefix)
+}
+
+// PropagatedBanReason builds the audit log reason for a ban applied on behalf of another server
+func PropagatedBanReason(originServerID, detail string) string {
+	reason := fmt.Sprintf("%s Banned in server %s", BanReasonPrefix, originServerID)
+	if detail != "" {
+		reason = fmt.Sprintf("%s: %s", reason, detail)
+	}
+
+	if utf8.RuneCountInString(reason) > maxBanReasonLength {
+		reason = string([]rune(reason)[:maxBanReasonLength])
+	}
+	return reason
+}
+
+// BanApprovalComponents returns the buttons moderators use to apply or dismiss a ban from another server
+func BanApprovalComponents(userID, originServerID string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Ban",
+					Style:    discordgo.DangerButton,
+					CustomID: slashcommand.CustomID(BanApprovalButton, BanApprovalApprove, userID, originServerID),
+				},
+				discordgo.Button{
+					Label:    "Dismiss",
+					Style:    discordgo.SecondaryButton,
+					CustomID: slashcommand.CustomID(BanApprovalButton, BanApprovalDismiss, userID, originServerID),
+				},
+			},
+		},
+	}
+}
+
+// CreateGuildBanAddHandler records every ban made in a server so it can be propagated to the rest of its group
+func CreateGuildBanAddHandler(botconfig botconfig.BotConfig, httpClient http.Client, logger *slog.Logger) func(*discordgo.Session, *discordgo.GuildBanAdd) {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+	moderationServiceClient := snitchv1connect.NewModerationServiceClient(&httpClient, backendURL.String())
+
+	return func(session *discordgo.Session, ban *discordgo.GuildBanAdd) {
+		if ban.User == nil {
+			return
+		}
+
+		// The gateway event doesn't carry the reason, so look the ban up
+		var reason *string
+		guildBan, err := session.GuildBan(ban.GuildID, ban.User.ID)
+		if err != nil {
+			logger.Warn("Failed to fetch ban reason", "guild_id", ban.GuildID, "user_id", ban.User.ID, "error", err)
+		} else if guildBan.Reason != "" {
+			if IsPropagatedBan(guildBan.Reason) {
+				logger.Debug("Ignoring ban propagated from another server", "guild_id", ban.GuildID, "user_id", ban.User.ID)
+				return
+			}
+			reason = &guildBan.Reason
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), recordBanTimeout)
+		defer cancel()
+
+		recordBanRequest := connect.NewRequest(&snitchv1.RecordBanRequest{
+			UserId: ban.User.ID,
+			Reason: reason,
+		})
+		recordBanRequest.Header().Add("X-Server-ID", ban.GuildID)
+		// Bans are shared with every group the server is in
+		recordBanResponse, err := moderationServiceClient.RecordBan(groupselector.WithSelector(ctx, groupselector.All), recordBanRequest)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				logger.Debug("Server isn't in a group, not recording ban", "guild_id", ban.GuildID)
+				return
+			}
+			logger.Error("Failed to record ban", "guild_id", ban.GuildID, "user_id", ban.User.ID, "error", err)
+			return
+		}
+
+		for _, groupBan := range recordBanResponse.Msg.Bans {
+			if groupBan.Error != "" {
+				logger.Error("Failed to record ban in group", "guild_id", ban.GuildID, "user_id", ban.User.ID, "group_id", groupBan.GroupId, "error", groupBan.Error)
+			}
+		}
+		logger.Info("Recorded ban", "guild_id", ban.GuildID, "user_id", ban.User.ID, "ban_id", recordBanResponse.Msg.BanId, "group_count", len(recordBanResponse.Msg.Bans))
+	}
+}
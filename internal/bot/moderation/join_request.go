@@ -0,0 +1,37 @@
+package moderation
+
+import (
+	"snitch/internal/bot/slashcommand"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// JoinRequestButton is the custom ID name the join request buttons are routed by
+const JoinRequestButton = "join-request"
+
+// Actions encoded in join request button custom IDs
+const (
+	JoinRequestApprove = "approve"
+	JoinRequestDeny    = "deny"
+)
+
+// JoinRequestComponents returns the buttons moderators use to approve or deny a server joining their group.
+// The group is carried along so servers in several groups decide in the right one.
+func JoinRequestComponents(requestID, groupID string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Approve",
+					Style:    discordgo.SuccessButton,
+					CustomID: slashcommand.CustomID(JoinRequestButton, JoinRequestApprove, requestID, groupID),
+				},
+				discordgo.Button{
+					Label:    "Deny",
+					Style:    discordgo.DangerButton,
+					CustomID: slashcommand.CustomID(JoinRequestButton, JoinRequestDeny, requestID, groupID),
+				},
+			},
+		},
+	}
+}
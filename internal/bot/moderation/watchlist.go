@@ -0,0 +1,121 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/messageutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// watchlistTimeout bounds the backend calls made for every member that joins
+const watchlistTimeout = 10 * time.Second
+
+// maxWatchlistReportText is how much of each recent report a watchlist alert quotes
+const maxWatchlistReportText = 200
+
+// watchlistEmbed describes the reports against a member who just joined, within one group
+func watchlistEmbed(member *discordgo.Member, groupName string, lookup *snitchv1.LookupUserResponse) *discordgo.MessageEmbed {
+	embed := messageutil.NewEmbed().
+		SetTitle("Watchlist Alert").
+		SetDescription(fmt.Sprintf("<@%s> (%s) joined and has been reported in the group %s", member.User.ID, member.User.ID, groupName)).
+		AddField("Reports", fmt.Sprintf("%d (%d open)", lookup.ReportCount, lookup.OpenReportCount)).
+		AddField("Reporting servers", fmt.Sprintf("%d", lookup.ServerCount)).
+		AddField("Bans", fmt.Sprintf("%d", lookup.BanCount))
+
+	if lookup.LastReportedAt != nil {
+		embed.AddField("Last reported", fmt.Sprintf("<t:%d:R>", lookup.LastReportedAt.AsTime().Unix()))
+	}
+
+	if len(lookup.RecentReports) > 0 {
+		lines := make([]string, 0, len(lookup.RecentReports))
+		for _, report := range lookup.RecentReports {
+			text := report.ReportText
+			if utf8.RuneCountInString(text) > maxWatchlistReportText {
+				text = string([]rune(text)[:maxWatchlistReportText]) + "…"
+			}
+			lines = append(lines, fmt.Sprintf("**#%d** from server %s: %s", report.Id, report.OriginServerId, text))
+		}
+		embed.AddField("Recent reports", strings.Join(lines, "\n"))
+	}
+
+	return embed.MessageEmbed
+}
+
+// CreateGuildMemberAddHandler posts a watchlist alert when a member with enough reports in one of the server's groups joins it
+func CreateGuildMemberAddHandler(botconfig botconfig.BotConfig, httpClient http.Client, logger *slog.Logger) func(*discordgo.Session, *discordgo.GuildMemberAdd) {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+	configServiceClient := snitchv1connect.NewConfigServiceClient(&httpClient, backendURL.String())
+	reportServiceClient := snitchv1connect.NewReportServiceClient(&httpClient, backendURL.String())
+	registrarServiceClient := snitchv1connect.NewRegistrarServiceClient(&httpClient, backendURL.String())
+
+	return func(session *discordgo.Session, memberAdd *discordgo.GuildMemberAdd) {
+		if memberAdd.Member == nil || memberAdd.User == nil || memberAdd.User.Bot {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), watchlistTimeout)
+		defer cancel()
+
+		configRequest := connect.NewRequest(&snitchv1.GetServerConfigRequest{})
+		configRequest.Header().Add("X-Server-ID", memberAdd.GuildID)
+		configResponse, err := configServiceClient.GetServerConfig(ctx, configRequest)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				logger.Debug("Server isn't in a group, not checking watchlist", "guild_id", memberAdd.GuildID)
+				return
+			}
+			logger.Error("Failed to get server config", "guild_id", memberAdd.GuildID, "error", err)
+			return
+		}
+
+		config := configResponse.Msg.Config
+		if config.WatchlistThreshold == 0 || config.OutputChannelId == nil {
+			return
+		}
+
+		groupsRequest := connect.NewRequest(&snitchv1.ListServerGroupsRequest{})
+		groupsRequest.Header().Add("X-Server-ID", memberAdd.GuildID)
+		groupsResponse, err := registrarServiceClient.ListServerGroups(ctx, groupsRequest)
+		if err != nil {
+			logger.Error("Failed to list server groups", "guild_id", memberAdd.GuildID, "error", err)
+			return
+		}
+
+		// Reports are counted per group, so each group is checked against the threshold on its own
+		for _, group := range groupsResponse.Msg.Groups {
+			lookupRequest := connect.NewRequest(&snitchv1.LookupUserRequest{UserId: memberAdd.User.ID})
+			lookupRequest.Header().Add("X-Server-ID", memberAdd.GuildID)
+			lookupResponse, err := reportServiceClient.LookupUser(groupselector.WithSelector(ctx, group.GroupId), lookupRequest)
+			if err != nil {
+				logger.Error("Failed to look up joining member", "guild_id", memberAdd.GuildID, "group_id", group.GroupId, "user_id", memberAdd.User.ID, "error", err)
+				continue
+			}
+
+			if lookupResponse.Msg.ReportCount < int64(config.WatchlistThreshold) {
+				continue
+			}
+
+			if _, err := session.ChannelMessageSendEmbed(*config.OutputChannelId, watchlistEmbed(memberAdd.Member, group.GroupName, lookupResponse.Msg)); err != nil {
+				logger.Error("Failed to post watchlist alert", "guild_id", memberAdd.GuildID, "group_id", group.GroupId, "user_id", memberAdd.User.ID, "error", err)
+				continue
+			}
+
+			logger.Info("Posted watchlist alert", "guild_id", memberAdd.GuildID, "group_id", group.GroupId, "user_id", memberAdd.User.ID, "report_count", lookupResponse.Msg.ReportCount)
+		}
+	}
+}
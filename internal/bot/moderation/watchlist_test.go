@@ -0,0 +1,65 @@
+package moderation
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWatchlistEmbed(t *testing.T) {
+	member := &discordgo.Member{User: &discordgo.User{ID: "user-1"}}
+	lookup := &snitchv1.LookupUserResponse{
+		UserId:          "user-1",
+		ReportCount:     3,
+		OpenReportCount: 2,
+		ServerCount:     2,
+		BanCount:        1,
+		LastReportedAt:  timestamppb.Now(),
+		RecentReports: []*snitchv1.Report{
+			{Id: 7, OriginServerId: "server-2", ReportText: strings.Repeat("a", maxWatchlistReportText+50)},
+		},
+	}
+
+	embed := watchlistEmbed(member, "Regional", lookup)
+	if !strings.Contains(embed.Description, "Regional") {
+		t.Errorf("Expected the alert to name the group, got %q", embed.Description)
+	}
+
+	fields := make(map[string]string)
+	for _, field := range embed.Fields {
+		fields[field.Name] = field.Value
+	}
+	if fields["Reports"] != "3 (2 open)" {
+		t.Errorf("Expected 3 (2 open) reports, got %q", fields["Reports"])
+	}
+	if fields["Bans"] != "1" {
+		t.Errorf("Expected 1 ban, got %q", fields["Bans"])
+	}
+	if _, ok := fields["Last reported"]; !ok {
+		t.Error("Expected the time of the last report")
+	}
+
+	recent := fields["Recent reports"]
+	if !strings.HasPrefix(recent, "**#7** from server server-2: ") {
+		t.Errorf("Expected report #7 to be quoted, got %q", recent)
+	}
+	if quoted := strings.TrimPrefix(recent, "**#7** from server server-2: "); utf8.RuneCountInString(quoted) != maxWatchlistReportText+1 {
+		t.Errorf("Expected the report text to be cut to %d characters and an ellipsis, got %d", maxWatchlistReportText, utf8.RuneCountInString(quoted))
+	}
+}
+
+func TestWatchlistEmbedWithoutRecentReports(t *testing.T) {
+	member := &discordgo.Member{User: &discordgo.User{ID: "user-1"}}
+	embed := watchlistEmbed(member, "Regional", &snitchv1.LookupUserResponse{UserId: "user-1"})
+
+	for _, field := range embed.Fields {
+		if field.Name == "Recent reports" || field.Name == "Last reported" {
+			t.Errorf("Expected no %q field without reports", field.Name)
+		}
+	}
+}
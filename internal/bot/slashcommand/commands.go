@@ -1,6 +1,64 @@
 package slashcommand
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"fmt"
+
+	"snitch/internal/bot/groupselector"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var reportStatusChoices = []*discordgo.ApplicationCommandOptionChoice{
+	{Name: "Open", Value: snitchv1.ReportStatus_REPORT_STATUS_OPEN.String()},
+	{Name: "Under review", Value: snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW.String()},
+	{Name: "Resolved", Value: snitchv1.ReportStatus_REPORT_STATUS_RESOLVED.String()},
+	{Name: "Dismissed", Value: snitchv1.ReportStatus_REPORT_STATUS_DISMISSED.String()},
+}
+
+var banPolicyChoices = []*discordgo.ApplicationCommandOptionChoice{
+	{Name: "Announce", Value: snitchv1.BanPolicy_BAN_POLICY_ANNOUNCE.String()},
+	{Name: "Approve", Value: snitchv1.BanPolicy_BAN_POLICY_APPROVE.String()},
+	{Name: "Auto", Value: snitchv1.BanPolicy_BAN_POLICY_AUTO.String()},
+}
+
+// groupRoleChoices leaves out the owner, which only changes by transferring ownership
+var groupRoleChoices = []*discordgo.ApplicationCommandOptionChoice{
+	{Name: "Admin", Value: snitchv1.GroupRole_GROUP_ROLE_ADMIN.String()},
+	{Name: "Member", Value: snitchv1.GroupRole_GROUP_ROLE_MEMBER.String()},
+	{Name: "Observer (read-only)", Value: snitchv1.GroupRole_GROUP_ROLE_OBSERVER.String()},
+}
+
+var botPermissionChoices = []*discordgo.ApplicationCommandOptionChoice{
+	{Name: "Create reports", Value: snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS.String()},
+	{Name: "List reports", Value: snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS.String()},
+	{Name: "Delete reports", Value: snitchv1.BotPermission_BOT_PERMISSION_DELETE_REPORTS.String()},
+	{Name: "Configure group", Value: snitchv1.BotPermission_BOT_PERMISSION_CONFIGURE_GROUP.String()},
+}
+
+var watchlistThresholdMin float64 = 0
+
+// groupOption picks the group a command acts on when the server is in more than one
+func groupOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        groupselector.OptionName,
+		Type:        discordgo.ApplicationCommandOptionString,
+		Description: "Group name or ID, needed when this server is in more than one group",
+	}
+}
+
+// reportGroupOption is like groupOption but can also send the report to every group
+func reportGroupOption() *discordgo.ApplicationCommandOption {
+	option := groupOption()
+	option.Description = fmt.Sprintf("Group name or ID, or %q to report to every group this server is in", groupselector.All)
+	return option
+}
+
+var (
+	inviteExpiryMinHours float64 = 1
+	inviteExpiryMaxHours float64 = 720
+	inviteMaxUsesMin     float64 = 1
+)
 
 func InitializeCommands() []*discordgo.ApplicationCommand {
 	return []*discordgo.ApplicationCommand{
@@ -13,6 +71,11 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 					Description: "Group related functionality",
 					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
 					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "list",
+							Description: "Lists the groups this server is in and its role in each",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
 						{
 							Name:        "create",
 							Description: "Creates a new group",
@@ -34,11 +97,132 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 								{
 									Name:        "join-code",
 									Type:        discordgo.ApplicationCommandOptionString,
-									Description: "Group join code",
+									Description: "Invite code from a server in the group",
 									Required:    true,
 								},
 							},
 						},
+						{
+							Name:        "invite",
+							Description: "Creates an invite code for another server to join this group",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "expires-in-hours",
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Description: "Hours until the invite expires (default 168)",
+									MinValue:    &inviteExpiryMinHours,
+									MaxValue:    inviteExpiryMaxHours,
+								},
+								{
+									Name:        "max-uses",
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Description: "How many servers can join with the invite (default unlimited)",
+									MinValue:    &inviteMaxUsesMin,
+								},
+								groupOption(),
+							},
+						},
+						{
+							Name:        "invites",
+							Description: "Lists this group's invite codes",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options:     []*discordgo.ApplicationCommandOption{groupOption()},
+						},
+						{
+							Name:        "revoke-invite",
+							Description: "Revokes an invite code",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "code",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "Invite code",
+									Required:    true,
+								},
+								groupOption(),
+							},
+						},
+						{
+							Name:        "leave",
+							Description: "Removes this server from one of its groups",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options:     []*discordgo.ApplicationCommandOption{groupOption()},
+						},
+						{
+							Name:        "kick",
+							Description: "Removes another server from the group",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "server-id",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "ID of the server to kick",
+									Required:    true,
+								},
+								groupOption(),
+							},
+						},
+						{
+							Name:        "delete",
+							Description: "Deletes the group this server created",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "confirm-name",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "Name of the group, to confirm the deletion",
+									Required:    true,
+								},
+								groupOption(),
+							},
+						},
+						{
+							Name:        "restore",
+							Description: "Restores the group this server deleted, while still in its grace period",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "servers",
+							Description: "Lists the servers in the group and their roles",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options:     []*discordgo.ApplicationCommandOption{groupOption()},
+						},
+						{
+							Name:        "set-role",
+							Description: "Promotes or demotes another server in the group",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "server-id",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "ID of the server",
+									Required:    true,
+								},
+								{
+									Name:        "role",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "The server's new role",
+									Required:    true,
+									Choices:     groupRoleChoices,
+								},
+								groupOption(),
+							},
+						},
+						{
+							Name:        "transfer-ownership",
+							Description: "Makes another server the group's owner",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "server-id",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "ID of the new owner",
+									Required:    true,
+								},
+								groupOption(),
+							},
+						},
 					},
 				},
 			},
@@ -58,6 +242,7 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 							Description: "User ID",
 							Required:    true,
 						},
+						groupOption(),
 					},
 				},
 			},
@@ -78,11 +263,24 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 							Required:    true,
 						},
 						{
-							Name:        "report-reason",
-							Type:        discordgo.ApplicationCommandOptionString,
-							Description: "Report reason",
+							Name:        "evidence",
+							Type:        discordgo.ApplicationCommandOptionAttachment,
+							Description: "Screenshot or file supporting the report",
+							Required:    false,
+						},
+						{
+							Name:        "evidence-2",
+							Type:        discordgo.ApplicationCommandOptionAttachment,
+							Description: "Additional evidence",
 							Required:    false,
 						},
+						{
+							Name:        "evidence-3",
+							Type:        discordgo.ApplicationCommandOptionAttachment,
+							Description: "Additional evidence",
+							Required:    false,
+						},
+						reportGroupOption(),
 					},
 				},
 				{
@@ -102,6 +300,67 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 							Description: "The user who created the reports",
 							Required:    false,
 						},
+						{
+							Name:        "status",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "Only show reports with this status",
+							Required:    false,
+							Choices:     reportStatusChoices,
+						},
+						{
+							Name:        "origin-server",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "Only show reports filed from this server ID",
+							Required:    false,
+						},
+						{
+							Name:        "since",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "Only show reports filed on or after this date (YYYY-MM-DD)",
+							Required:    false,
+						},
+						{
+							Name:        "until",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "Only show reports filed on or before this date (YYYY-MM-DD)",
+							Required:    false,
+						},
+						groupOption(),
+					},
+				},
+				{
+					Name:        "view",
+					Description: "Shows a single report",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "report-id",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Description: "Report ID",
+							Required:    true,
+						},
+						groupOption(),
+					},
+				},
+				{
+					Name:        "status",
+					Description: "Changes the status of a report",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "report-id",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Description: "Report ID",
+							Required:    true,
+						},
+						{
+							Name:        "status",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "New report status",
+							Required:    true,
+							Choices:     reportStatusChoices,
+						},
+						groupOption(),
 					},
 				},
 				{
@@ -111,13 +370,162 @@ func InitializeCommands() []*discordgo.ApplicationCommand {
 					Options: []*discordgo.ApplicationCommandOption{
 						{
 							Name:        "report-id",
-							Type:        discordgo.ApplicationCommandOptionString,
+							Type:        discordgo.ApplicationCommandOptionInteger,
 							Description: "Report ID",
 							Required:    true,
 						},
+						groupOption(),
+					},
+				},
+				{
+					Name:        "audit",
+					Description: "Shows who recently deleted reports or changed their status",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options:     []*discordgo.ApplicationCommandOption{groupOption()},
+				},
+			},
+		},
+		{
+			Name:        "config",
+			Description: "Server settings",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "show",
+					Description: "Shows the settings of this server",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options:     []*discordgo.ApplicationCommandOption{groupOption()},
+				},
+				{
+					Name:        "ban-policy",
+					Description: "Sets what happens to bans made in other servers of the group",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "policy",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Description: "Announce bans, queue them for approval or apply them automatically",
+							Required:    true,
+							Choices:     banPolicyChoices,
+						},
+					},
+				},
+				{
+					Name:        "watchlist-threshold",
+					Description: "Sets how many reports a joining member needs to trigger a watchlist alert",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "reports",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Description: "Number of reports in the group, or 0 to turn alerts off",
+							Required:    true,
+							MinValue:    &watchlistThresholdMin,
+						},
+					},
+				},
+				{
+					Name:        "output-channel",
+					Description: "Sets the channel group events are posted to",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:         "channel",
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Description:  "Channel for reports and bans from the group",
+							Required:     true,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+					},
+				},
+				{
+					Name:        "join-approval",
+					Description: "Sets whether servers joining the group need approval from a member server",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "required",
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Description: "Queue join requests in the output channels of the group's servers",
+							Required:    true,
+						},
+						groupOption(),
+					},
+				},
+				{
+					Name:        "permissions",
+					Description: "Which Discord roles can use the bot's commands",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "show",
+							Description: "Shows the roles allowed to use each set of commands",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "allow",
+							Description: "Lets a role use a set of commands",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "permission",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "Commands to restrict",
+									Required:    true,
+									Choices:     botPermissionChoices,
+								},
+								{
+									Name:        "role",
+									Type:        discordgo.ApplicationCommandOptionRole,
+									Description: "Discord role",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "remove",
+							Description: "Stops a role from using a set of commands",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "permission",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "Commands to restrict",
+									Required:    true,
+									Choices:     botPermissionChoices,
+								},
+								{
+									Name:        "role",
+									Type:        discordgo.ApplicationCommandOptionRole,
+									Description: "Discord role",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "reset",
+							Description: "Lets members with Manage Server use a set of commands again",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Name:        "permission",
+									Type:        discordgo.ApplicationCommandOptionString,
+									Description: "Commands to restrict",
+									Required:    true,
+									Choices:     botPermissionChoices,
+								},
+							},
+						},
 					},
 				},
 			},
 		},
+		{
+			Name: "Report user",
+			Type: discordgo.UserApplicationCommand,
+		},
+		{
+			Name: "Report message",
+			Type: discordgo.MessageApplicationCommand,
+		},
 	}
 }
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/moderation"
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// withOutcome copies the embeds of a ban or join request notification with its outcome field replaced
+func withOutcome(embeds []*discordgo.MessageEmbed, outcome string) []*discordgo.MessageEmbed {
+	updated := make([]*discordgo.MessageEmbed, 0, len(embeds))
+	for _, embed := range embeds {
+		embedCopy := *embed
+		embedCopy.Fields = make([]*discordgo.MessageEmbedField, 0, len(embed.Fields))
+		for _, field := range embed.Fields {
+			fieldCopy := *field
+			if fieldCopy.Name == "Outcome" {
+				fieldCopy.Value = outcome
+			}
+			embedCopy.Fields = append(embedCopy.Fields, &fieldCopy)
+		}
+		updated = append(updated, &embedCopy)
+	}
+	return updated
+}
+
+func CreateBanApprovalHandler() slashcommand.SlashCommandHandlerFunc {
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+		if !ok {
+			slogger = slog.Default()
+		}
+
+		_, args := slashcommand.ParseCustomID(interaction.MessageComponentData().CustomID)
+		if len(args) != 3 {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid ban approval")
+			return
+		}
+		action, userID, originServerID := args[0], args[1], args[2]
+
+		var outcome string
+		switch action {
+		case moderation.BanApprovalApprove:
+			reason := moderation.PropagatedBanReason(originServerID, fmt.Sprintf("approved by %s", interaction.Member.User.Username))
+			if err := session.GuildBanCreateWithReason(interaction.GuildID, userID, reason, 0); err != nil {
+				slogger.ErrorContext(ctx, "Failed to apply ban", "User ID", userID, "Error", err)
+				messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't ban user, error: %s", err.Error()))
+				return
+			}
+			outcome = fmt.Sprintf("Banned by <@%s>", interaction.Member.User.ID)
+		case moderation.BanApprovalDismiss:
+			outcome = fmt.Sprintf("Dismissed by <@%s>", interaction.Member.User.ID)
+		default:
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid ban approval")
+			return
+		}
+
+		messageutil.EmbedComponentsUpdateContext(ctx, session, interaction, withOutcome(interaction.Message.Embeds, outcome), []discordgo.MessageComponent{})
+	}
+}
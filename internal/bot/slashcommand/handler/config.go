@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"math"
+	"net/http"
+	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// banPolicyNames describes each ban policy the way /config shows it
+var banPolicyNames = map[snitchv1.BanPolicy]string{
+	snitchv1.BanPolicy_BAN_POLICY_ANNOUNCE: "Announce bans from the group",
+	snitchv1.BanPolicy_BAN_POLICY_APPROVE:  "Queue bans from the group for approval",
+	snitchv1.BanPolicy_BAN_POLICY_AUTO:     "Apply bans from the group automatically",
+}
+
+// serverConfigEmbed renders the settings of a server
+func serverConfigEmbed(config *snitchv1.ServerConfig) *discordgo.MessageEmbed {
+	watchlistThreshold := "Alerts off"
+	if config.WatchlistThreshold > 0 {
+		watchlistThreshold = fmt.Sprintf("Alert when a member with %d or more reports joins", config.WatchlistThreshold)
+	}
+
+	outputChannel := "Not set, use /config output-channel"
+	if config.OutputChannelId != nil {
+		outputChannel = fmt.Sprintf("<#%s>", *config.OutputChannelId)
+	}
+
+	return messageutil.NewEmbed().
+		SetTitle("Server Config").
+		AddField("Ban policy", banPolicyNames[config.GetBanPolicy()]).
+		AddField("Output channel", outputChannel).
+		AddField("Watchlist threshold", watchlistThreshold).
+		MessageEmbed
+}
+
+// groupConfigEmbed renders the settings shared by the servers of a group
+func groupConfigEmbed(config *snitchv1.GroupConfig) *discordgo.MessageEmbed {
+	joinApproval := "Servers with an invite join immediately"
+	if config.JoinRequiresApproval {
+		joinApproval = "Servers with an invite wait for a member server to approve them"
+	}
+
+	return messageutil.NewEmbed().
+		SetTitle("Group Config").
+		AddField("Join approval", joinApproval).
+		MessageEmbed
+}
+
+// updateServerConfig applies a config change to the server an interaction came from and responds with the result
+func updateServerConfig(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient, update *snitchv1.UpdateServerConfigRequest) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	updateRequest := connect.NewRequest(update)
+	updateRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	updateResponse, err := client.UpdateServerConfig(ctx, updateRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't update server config, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{serverConfigEmbed(updateResponse.Msg.Config)})
+}
+
+func handleShowConfig(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	configRequest := connect.NewRequest(&snitchv1.GetServerConfigRequest{})
+	configRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	configResponse, err := client.GetServerConfig(ctx, configRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't get server config, error: %s", err.Error()))
+		return
+	}
+
+	embeds := []*discordgo.MessageEmbed{serverConfigEmbed(configResponse.Msg.Config)}
+
+	groupConfigRequest := connect.NewRequest(&snitchv1.GetGroupConfigRequest{})
+	groupConfigRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	groupConfigResponse, err := client.GetGroupConfig(ctx, groupConfigRequest)
+	if err != nil {
+		slogger.WarnContext(ctx, "Couldn't get group config", "Error", err)
+	} else {
+		embeds = append(embeds, groupConfigEmbed(groupConfigResponse.Msg.Config))
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, embeds)
+}
+
+func handleSetBanPolicy(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	policyOption, ok := optionMap["policy"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing policy option")
+		return
+	}
+
+	policyValue, ok := snitchv1.BanPolicy_value[policyOption.StringValue()]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid ban policy")
+		return
+	}
+	policy := snitchv1.BanPolicy(policyValue)
+
+	updateServerConfig(ctx, session, interaction, client, &snitchv1.UpdateServerConfigRequest{BanPolicy: &policy})
+}
+
+func handleSetWatchlistThreshold(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	reportsOption, ok := optionMap["reports"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing reports option")
+		return
+	}
+
+	reports := reportsOption.IntValue()
+	if reports < 0 || reports > math.MaxInt32 {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid number of reports")
+		return
+	}
+	threshold := int32(reports)
+
+	updateServerConfig(ctx, session, interaction, client, &snitchv1.UpdateServerConfigRequest{WatchlistThreshold: &threshold})
+}
+
+func handleSetOutputChannel(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	channelOption, ok := optionMap["channel"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing channel option")
+		return
+	}
+
+	channelID, ok := channelOption.Value.(string)
+	if !ok || channelID == "" {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid channel")
+		return
+	}
+
+	updateServerConfig(ctx, session, interaction, client, &snitchv1.UpdateServerConfigRequest{OutputChannelId: &channelID})
+}
+
+func handleSetJoinApproval(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	requiredOption, ok := optionMap["required"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing required option")
+		return
+	}
+	required := requiredOption.BoolValue()
+
+	updateRequest := connect.NewRequest(&snitchv1.UpdateGroupConfigRequest{JoinRequiresApproval: &required})
+	updateRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	updateResponse, err := client.UpdateGroupConfig(ctx, updateRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't update group config, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{groupConfigEmbed(updateResponse.Msg.Config)})
+}
+
+func CreateConfigCommandHandler(botconfig botconfig.BotConfig, httpClient http.Client) slashcommand.SlashCommandHandlerFunc {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+	configServiceClient := snitchv1connect.NewConfigServiceClient(&httpClient, backendURL.String())
+
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+		if !ok {
+			slogger = slog.Default()
+		}
+
+		options := interaction.ApplicationCommandData().Options
+
+		switch options[0].Name {
+		case "show":
+			handleShowConfig(ctx, session, interaction, configServiceClient)
+		case "ban-policy":
+			handleSetBanPolicy(ctx, session, interaction, configServiceClient)
+		case "watchlist-threshold":
+			handleSetWatchlistThreshold(ctx, session, interaction, configServiceClient)
+		case "output-channel":
+			handleSetOutputChannel(ctx, session, interaction, configServiceClient)
+		case "join-approval":
+			handleSetJoinApproval(ctx, session, interaction, configServiceClient)
+		case "permissions":
+			if options[0].Options[0].Name == "show" {
+				handleShowPermissions(ctx, session, interaction, configServiceClient)
+			} else {
+				handleUpdatePermission(ctx, session, interaction, configServiceClient)
+			}
+		default:
+			slogger.ErrorContext(ctx, "Invalid subcommand", "Subcommand Name", options[0].Name)
+		}
+	}
+}
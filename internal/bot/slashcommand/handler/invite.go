@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// inviteState describes whether an invite can still be used
+func inviteState(invite *snitchv1.Invite) string {
+	switch {
+	case invite.RevokedAt != nil:
+		return "revoked"
+	case invite.ExpiresAt != nil && invite.ExpiresAt.AsTime().Before(time.Now()):
+		return "expired"
+	case invite.MaxUses > 0 && invite.Uses >= invite.MaxUses:
+		return "used up"
+	default:
+		return "active"
+	}
+}
+
+// inviteUses formats how often an invite has been used out of its limit
+func inviteUses(invite *snitchv1.Invite) string {
+	if invite.MaxUses == 0 {
+		return fmt.Sprintf("%d uses", invite.Uses)
+	}
+	return fmt.Sprintf("%d/%d uses", invite.Uses, invite.MaxUses)
+}
+
+func handleCreateInvite(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	createRequest := &snitchv1.CreateInviteRequest{UserId: interaction.Member.User.ID}
+	for _, option := range interaction.ApplicationCommandData().Options[0].Options[0].Options {
+		switch option.Name {
+		case "expires-in-hours":
+			createRequest.ExpiresIn = durationpb.New(time.Duration(option.IntValue()) * time.Hour)
+		case "max-uses":
+			createRequest.MaxUses = option.IntValue()
+		}
+	}
+
+	inviteRequest := connect.NewRequest(createRequest)
+	inviteRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	inviteResponse, err := client.CreateInvite(ctx, inviteRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't create invite, error: %s", err.Error()))
+		return
+	}
+
+	invite := inviteResponse.Msg.Invite
+	limit := "unlimited uses"
+	if invite.MaxUses > 0 {
+		limit = fmt.Sprintf("%d uses", invite.MaxUses)
+	}
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf(
+		"Invite code `%s` (%s, expires <t:%d:R>). Other servers can join with `/register group join`.",
+		invite.Code, limit, invite.ExpiresAt.GetSeconds()))
+}
+
+func handleListInvites(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	listRequest := connect.NewRequest(&snitchv1.ListInvitesRequest{})
+	listRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	listResponse, err := client.ListInvites(ctx, listRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't list invites, error: %s", err.Error()))
+		return
+	}
+
+	if len(listResponse.Msg.Invites) == 0 {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "This group has no invites.")
+		return
+	}
+
+	embed := messageutil.NewEmbed().SetTitle("Group Invites")
+	for _, invite := range listResponse.Msg.Invites {
+		lines := []string{
+			fmt.Sprintf("Status: %s", inviteState(invite)),
+			inviteUses(invite),
+			fmt.Sprintf("Created by <@%s>", invite.CreatedBy),
+		}
+		if invite.ExpiresAt != nil {
+			lines = append(lines, fmt.Sprintf("Expires: <t:%d:R>", invite.ExpiresAt.Seconds))
+		}
+		embed.AddField(invite.Code, strings.Join(lines, "\n"))
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{embed.Truncate().MessageEmbed})
+}
+
+func handleRevokeInvite(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	code := interaction.ApplicationCommandData().Options[0].Options[0].Options[0].StringValue()
+
+	revokeRequest := connect.NewRequest(&snitchv1.RevokeInviteRequest{Code: code})
+	revokeRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	revokeResponse, err := client.RevokeInvite(ctx, revokeRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't revoke invite, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Revoked invite `%s`.", revokeResponse.Msg.Invite.Code))
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/moderation"
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+func CreateJoinRequestHandler(botconfig botconfig.BotConfig, httpClient http.Client, subscriber ServerSubscriber) slashcommand.SlashCommandHandlerFunc {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+
+	registrarServiceClient := snitchv1connect.NewRegistrarServiceClient(&httpClient, backendURL.String())
+
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+		if !ok {
+			slogger = slog.Default()
+		}
+
+		_, args := slashcommand.ParseCustomID(interaction.MessageComponentData().CustomID)
+		// Buttons posted before servers could be in several groups carry no group
+		if (len(args) != 2 && len(args) != 3) || (args[0] != moderation.JoinRequestApprove && args[0] != moderation.JoinRequestDeny) {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid join request")
+			return
+		}
+		approve, requestID := args[0] == moderation.JoinRequestApprove, args[1]
+		if len(args) == 3 {
+			ctx = groupselector.WithSelector(ctx, args[2])
+		}
+
+		decideRequest := connect.NewRequest(&snitchv1.DecideJoinRequestRequest{
+			RequestId: requestID,
+			Approve:   approve,
+			UserId:    interaction.Member.User.ID,
+		})
+		decideRequest.Header().Add("X-Server-ID", interaction.GuildID)
+		decideResponse, err := registrarServiceClient.DecideJoinRequest(ctx, decideRequest)
+
+		var outcome string
+		switch {
+		case connect.CodeOf(err) == connect.CodeFailedPrecondition || connect.CodeOf(err) == connect.CodeAlreadyExists:
+			// Decided from another server first, or the server joined the group through another invite meanwhile
+			var connectErr *connect.Error
+			errors.As(err, &connectErr)
+			outcome = fmt.Sprintf("Not applied: %s", connectErr.Message())
+		case err != nil:
+			slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+			messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't decide join request, error: %s", err.Error()))
+			return
+		case approve:
+			outcome = fmt.Sprintf("Approved by <@%s>", interaction.Member.User.ID)
+			// The joining server may be served by this bot too
+			if _, err := session.State.Guild(decideResponse.Msg.ServerId); err == nil {
+				subscribeServer(ctx, subscriber, decideResponse.Msg.ServerId)
+			}
+		default:
+			outcome = fmt.Sprintf("Denied by <@%s>", interaction.Member.User.ID)
+		}
+
+		messageutil.EmbedComponentsUpdateContext(ctx, session, interaction, withOutcome(interaction.Message.Embeds, outcome), []discordgo.MessageComponent{})
+	}
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// botPermissionLabels describes the commands each bot permission covers
+var botPermissionLabels = map[snitchv1.BotPermission]string{
+	snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS:  "Create reports",
+	snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS:    "List and view reports and user history",
+	snitchv1.BotPermission_BOT_PERMISSION_DELETE_REPORTS:  "Delete reports and change their status",
+	snitchv1.BotPermission_BOT_PERMISSION_CONFIGURE_GROUP: "Configure the server and group",
+}
+
+// reportSubcommandPermissions maps /report subcommands to the permission they need
+var reportSubcommandPermissions = map[string]snitchv1.BotPermission{
+	"new":    snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS,
+	"list":   snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS,
+	"view":   snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS,
+	"audit":  snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS,
+	"status": snitchv1.BotPermission_BOT_PERMISSION_DELETE_REPORTS,
+	"delete": snitchv1.BotPermission_BOT_PERMISSION_DELETE_REPORTS,
+}
+
+// InteractionPermission returns the bot permission needed to use a command, modal or button.
+// Anything not listed, including /register, /config and the ban and join request buttons, needs the configure permission.
+func InteractionPermission(interaction *discordgo.InteractionCreate) snitchv1.BotPermission {
+	switch slashcommand.InteractionName(interaction) {
+	case "report":
+		if options := interaction.ApplicationCommandData().Options; len(options) > 0 {
+			if permission, ok := reportSubcommandPermissions[options[0].Name]; ok {
+				return permission
+			}
+		}
+	case "user", ReportsPageButton:
+		return snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS
+	case "Report user", "Report message", ReportFormModal:
+		return snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS
+	}
+	return snitchv1.BotPermission_BOT_PERMISSION_CONFIGURE_GROUP
+}
+
+// permissionPolicyEmbed renders the roles allowed to use each bot permission
+func permissionPolicyEmbed(policy *snitchv1.PermissionPolicy) *discordgo.MessageEmbed {
+	embed := messageutil.NewEmbed().
+		SetTitle("Permissions").
+		SetDescription("Administrators can always use every command")
+	for _, rule := range policy.GetRules() {
+		roles := "Members with Manage Server"
+		if len(rule.RoleIds) > 0 {
+			mentions := make([]string, 0, len(rule.RoleIds))
+			for _, roleID := range rule.RoleIds {
+				mentions = append(mentions, fmt.Sprintf("<@&%s>", roleID))
+			}
+			roles = strings.Join(mentions, ", ")
+		}
+		embed.AddField(botPermissionLabels[rule.Permission], roles)
+	}
+	return embed.MessageEmbed
+}
+
+// getPermissionPolicy fetches the permission policy of the server an interaction came from
+func getPermissionPolicy(ctx context.Context, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) (*snitchv1.PermissionPolicy, error) {
+	policyRequest := connect.NewRequest(&snitchv1.GetPermissionPolicyRequest{})
+	policyRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	policyResponse, err := client.GetPermissionPolicy(ctx, policyRequest)
+	if err != nil {
+		return nil, err
+	}
+	return policyResponse.Msg.Policy, nil
+}
+
+func handleShowPermissions(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	policy, err := getPermissionPolicy(ctx, interaction, client)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't get permissions, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{permissionPolicyEmbed(policy)})
+}
+
+// handleUpdatePermission grants a role a permission, revokes it, or resets the permission to its default
+func handleUpdatePermission(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ConfigServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	subcommand := interaction.ApplicationCommandData().Options[0].Options[0]
+	var permission snitchv1.BotPermission
+	var roleID string
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "permission":
+			permission = snitchv1.BotPermission(snitchv1.BotPermission_value[option.StringValue()])
+		case "role":
+			roleID = option.RoleValue(nil, "").ID
+		}
+	}
+	if permission == snitchv1.BotPermission_BOT_PERMISSION_UNSPECIFIED {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid permission")
+		return
+	}
+
+	policy, err := getPermissionPolicy(ctx, interaction, client)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't get permissions, error: %s", err.Error()))
+		return
+	}
+
+	var roleIDs []string
+	for _, rule := range policy.GetRules() {
+		if rule.Permission == permission {
+			roleIDs = rule.RoleIds
+		}
+	}
+
+	switch subcommand.Name {
+	case "allow":
+		if !slices.Contains(roleIDs, roleID) {
+			roleIDs = append(roleIDs, roleID)
+		}
+	case "remove":
+		roleIDs = slices.DeleteFunc(roleIDs, func(id string) bool { return id == roleID })
+	case "reset":
+		roleIDs = nil
+	}
+
+	updateRequest := connect.NewRequest(&snitchv1.UpdatePermissionPolicyRequest{Permission: permission, RoleIds: roleIDs})
+	updateRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	updateResponse, err := client.UpdatePermissionPolicy(ctx, updateRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't update permissions, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{permissionPolicyEmbed(updateResponse.Msg.Policy)})
+}
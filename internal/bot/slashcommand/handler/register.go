@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
 	"snitch/internal/bot/messageutil"
 	"snitch/internal/bot/slashcommand"
 	"snitch/internal/shared/ctxutil"
@@ -15,10 +16,51 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
 )
 
-func handleCreateGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+// ServerSubscriber subscribes the bot to the events of the groups a server is in
+type ServerSubscriber interface {
+	AddServer(ctx context.Context, serverID string) error
+}
+
+// subscribeServer subscribes to a group a server just got into, since the bot otherwise only subscribes when it starts
+// or joins a server
+func subscribeServer(ctx context.Context, subscriber ServerSubscriber, serverID string) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	if err := subscriber.AddServer(ctx, serverID); err != nil {
+		slogger.ErrorContext(ctx, "Failed to subscribe to the server's groups", "server_id", serverID, "error", err)
+	}
+}
+
+// subscribeGroupServers subscribes the servers of a restored group that the bot is in, since the bot dropped the
+// group's subscription for all of them when it was deleted
+func subscribeGroupServers(ctx context.Context, session *discordgo.Session, client snitchv1connect.RegistrarServiceClient, subscriber ServerSubscriber, serverID, groupID string) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	listRequest := connect.NewRequest(&snitchv1.ListGroupServersRequest{})
+	listRequest.Header().Add("X-Server-ID", serverID)
+	listResponse, err := client.ListGroupServers(groupselector.WithSelector(ctx, groupID), listRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Failed to list the servers of the restored group", "group_id", groupID, "error", err)
+		return
+	}
+
+	for _, server := range listResponse.Msg.Servers {
+		if _, err := session.State.Guild(server.ServerId); err != nil {
+			continue
+		}
+		subscribeServer(ctx, subscriber, server.ServerId)
+	}
+}
+
+func handleCreateGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient, subscriber ServerSubscriber) {
 	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
 	if !ok {
 		slogger = slog.Default()
@@ -39,11 +81,12 @@ func handleCreateGroup(ctx context.Context, session *discordgo.Session, interact
 		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't register group, error: %s", err.Error()))
 		return
 	}
+	subscribeServer(ctx, subscriber, interaction.GuildID)
 
-	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Created group %s for this server.", registerResponse.Msg.GroupId))
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Created group %s (%s) for this server.", groupName, registerResponse.Msg.GroupId))
 }
 
-func handleJoinGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+func handleJoinGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient, subscriber ServerSubscriber) {
 	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
 	if !ok {
 		slogger = slog.Default()
@@ -52,16 +95,9 @@ func handleJoinGroup(ctx context.Context, session *discordgo.Session, interactio
 	options := interaction.ApplicationCommandData().Options[0].Options[0].Options
 
 	userID := interaction.Member.User.ID
-	groupId := options[0].StringValue()
-	err := uuid.Validate(groupId)
-
-	if err != nil {
-		slogger.ErrorContext(ctx, "invalid group id", "error", err)
-		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Invalid group ID: %s", groupId))
-		return
-	}
+	inviteCode := options[0].StringValue()
 
-	registerRequest := connect.NewRequest(&snitchv1.RegisterRequest{UserId: userID, GroupId: &groupId})
+	registerRequest := connect.NewRequest(&snitchv1.RegisterRequest{UserId: userID, InviteCode: &inviteCode})
 	registerRequest.Header().Add("X-Server-ID", interaction.GuildID)
 	registerResponse, err := client.Register(ctx, registerRequest)
 
@@ -71,10 +107,93 @@ func handleJoinGroup(ctx context.Context, session *discordgo.Session, interactio
 		return
 	}
 
+	if registerResponse.Msg.JoinRequestId != nil {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "This group requires approval to join. Your request was sent to its servers; this server joins once one of them approves it.")
+		return
+	}
+	subscribeServer(ctx, subscriber, interaction.GuildID)
+
 	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Joined group %s", registerResponse.Msg.GroupId))
 }
 
-func handleGroupCommands(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+func handleLeaveGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	leaveRequest := connect.NewRequest(&snitchv1.LeaveGroupRequest{UserId: interaction.Member.User.ID})
+	leaveRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	leaveResponse, err := client.LeaveGroup(ctx, leaveRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't leave group, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Left group %s. This server's group settings were removed.", leaveResponse.Msg.GroupId))
+}
+
+func handleKickServer(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	serverID := interaction.ApplicationCommandData().Options[0].Options[0].Options[0].StringValue()
+
+	kickRequest := connect.NewRequest(&snitchv1.KickServerRequest{ServerId: serverID, UserId: interaction.Member.User.ID})
+	kickRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	kickResponse, err := client.KickServer(ctx, kickRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't kick server, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Kicked server %s from the group.", kickResponse.Msg.ServerId))
+}
+
+func handleDeleteGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	groupName := interaction.ApplicationCommandData().Options[0].Options[0].Options[0].StringValue()
+
+	deleteRequest := connect.NewRequest(&snitchv1.DeleteGroupRequest{UserId: interaction.Member.User.ID, ConfirmGroupName: groupName})
+	deleteRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	deleteResponse, err := client.DeleteGroup(ctx, deleteRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't delete group, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Deleted group %s. Use `/register group restore` before <t:%d:f> to undo this.", deleteResponse.Msg.GroupId, deleteResponse.Msg.PurgeAfter.AsTime().Unix()))
+}
+
+func handleRestoreGroup(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient, subscriber ServerSubscriber) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	restoreRequest := connect.NewRequest(&snitchv1.RestoreGroupRequest{UserId: interaction.Member.User.ID})
+	restoreRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	restoreResponse, err := client.RestoreGroup(ctx, restoreRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't restore group, error: %s", err.Error()))
+		return
+	}
+	subscribeGroupServers(ctx, session, client, subscriber, interaction.GuildID, restoreResponse.Msg.GroupId)
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Restored group %s (%s).", restoreResponse.Msg.GroupName, restoreResponse.Msg.GroupId))
+}
+
+func handleGroupCommands(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient, subscriber ServerSubscriber) {
 	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
 	if !ok {
 		slogger = slog.Default()
@@ -83,16 +202,38 @@ func handleGroupCommands(ctx context.Context, session *discordgo.Session, intera
 	options := interaction.ApplicationCommandData().Options[0].Options
 
 	switch options[0].Name {
+	case "list":
+		handleListServerGroups(ctx, session, interaction, client)
 	case "create":
-		handleCreateGroup(ctx, session, interaction, client)
+		handleCreateGroup(ctx, session, interaction, client, subscriber)
 	case "join":
-		handleJoinGroup(ctx, session, interaction, client)
+		handleJoinGroup(ctx, session, interaction, client, subscriber)
+	case "invite":
+		handleCreateInvite(ctx, session, interaction, client)
+	case "invites":
+		handleListInvites(ctx, session, interaction, client)
+	case "revoke-invite":
+		handleRevokeInvite(ctx, session, interaction, client)
+	case "leave":
+		handleLeaveGroup(ctx, session, interaction, client)
+	case "kick":
+		handleKickServer(ctx, session, interaction, client)
+	case "delete":
+		handleDeleteGroup(ctx, session, interaction, client)
+	case "restore":
+		handleRestoreGroup(ctx, session, interaction, client, subscriber)
+	case "servers":
+		handleListGroupServers(ctx, session, interaction, client)
+	case "set-role":
+		handleSetServerRole(ctx, session, interaction, client)
+	case "transfer-ownership":
+		handleTransferOwnership(ctx, session, interaction, client)
 	default:
 		slogger.ErrorContext(ctx, "Invalid subcommand", "Subcommand Name", options[1].Name)
 	}
 }
 
-func CreateRegisterCommandHandler(botconfig botconfig.BotConfig, httpClient http.Client) slashcommand.SlashCommandHandlerFunc {
+func CreateRegisterCommandHandler(botconfig botconfig.BotConfig, httpClient http.Client, subscriber ServerSubscriber) slashcommand.SlashCommandHandlerFunc {
 	backendURL, err := botconfig.BackendURL()
 	if err != nil {
 		log.Fatal(backendURL)
@@ -110,7 +251,7 @@ func CreateRegisterCommandHandler(botconfig botconfig.BotConfig, httpClient http
 
 		switch options[0].Name {
 		case "group":
-			handleGroupCommands(ctx, session, interaction, registrarServiceClient)
+			handleGroupCommands(ctx, session, interaction, registrarServiceClient, subscriber)
 		default:
 			slogger.ErrorContext(ctx, "Invalid subcommand", "Subcommand Name", options[0].Name)
 		}
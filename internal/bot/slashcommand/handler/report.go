@@ -2,52 +2,194 @@ package handler
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
 	"snitch/internal/bot/messageutil"
 	"snitch/internal/bot/slashcommand"
 	"snitch/internal/shared/ctxutil"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
 	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"connectrpc.com/connect"
 	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func handleNewReport(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient, userClient snitchv1connect.UserHistoryServiceClient) {
-	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
-	if !ok {
-		slogger = slog.Default()
+var reportStatusLabels = map[snitchv1.ReportStatus]string{
+	snitchv1.ReportStatus_REPORT_STATUS_OPEN:         "Open",
+	snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW: "Under review",
+	snitchv1.ReportStatus_REPORT_STATUS_RESOLVED:     "Resolved",
+	snitchv1.ReportStatus_REPORT_STATUS_DISMISSED:    "Dismissed",
+}
+
+var reportAuditActionLabels = map[snitchv1.ReportAuditAction]string{
+	snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_STATUS_CHANGED: "Status changed",
+	snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_DELETED:        "Deleted",
+}
+
+// ReportsPageButton is the custom ID name the report list page buttons are routed by
+const ReportsPageButton = "reports-page"
+
+// reportDateLayout is the date format accepted by the /report list date filters
+const reportDateLayout = "2006-01-02"
+
+var evidenceOptionNames = []string{"evidence", "evidence-2", "evidence-3"}
+
+// evidenceFromAttachments collects the attachments passed through the evidence options of a command
+func evidenceFromAttachments(interaction *discordgo.InteractionCreate, optionMap map[string]*discordgo.ApplicationCommandInteractionDataOption) []*snitchv1.ReportEvidence {
+	resolved := interaction.ApplicationCommandData().Resolved
+	if resolved == nil {
+		return nil
 	}
 
-	options := interaction.ApplicationCommandData().Options[0].Options
-	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
-	for _, opt := range options {
-		optionMap[opt.Name] = opt
+	var evidence []*snitchv1.ReportEvidence
+	for _, name := range evidenceOptionNames {
+		option, ok := optionMap[name]
+		if !ok {
+			continue
+		}
+
+		attachmentID, ok := option.Value.(string)
+		if !ok {
+			continue
+		}
+
+		attachment, ok := resolved.Attachments[attachmentID]
+		if !ok {
+			continue
+		}
+
+		evidenceURL, ok := attachmentURL(attachment.URL)
+		if !ok {
+			continue
+		}
+
+		item := &snitchv1.ReportEvidence{Url: evidenceURL}
+		if attachment.ContentType != "" {
+			item.ContentType = &attachment.ContentType
+		}
+		evidence = append(evidence, item)
 	}
 
-	reporterID := interaction.Member.User.ID
+	return evidence
+}
 
-	reportedUserOption, ok := optionMap["reported-user"]
+// formatEvidence renders report evidence as a list of markdown links
+func formatEvidence(evidence []*snitchv1.ReportEvidence) string {
+	lines := make([]string, 0, len(evidence))
+	for index, item := range evidence {
+		label := fmt.Sprintf("Evidence %d", index+1)
+		if item.ContentType != nil {
+			label = fmt.Sprintf("%s (%s)", label, *item.ContentType)
+		}
 
-	if !ok {
-		slogger.ErrorContext(ctx, "Failed to get reported user option", "Error", ok)
+		line := fmt.Sprintf("[%s](%s)", label, item.Url)
+		if item.MessageLink != nil && *item.MessageLink != item.Url {
+			line = fmt.Sprintf("%s from [message](%s)", line, *item.MessageLink)
+		}
+		if item.MessageAuthorId != nil {
+			line = fmt.Sprintf("%s by <@%s>", line, *item.MessageAuthorId)
+		}
+		if item.ChannelId != nil {
+			line = fmt.Sprintf("%s in <#%s>", line, *item.ChannelId)
+		}
+		lines = append(lines, line)
+
+		if item.MessageContent != nil && *item.MessageContent != "" {
+			lines = append(lines, "> "+strings.ReplaceAll(*item.MessageContent, "\n", "\n> "))
+		}
 	}
+	return strings.Join(lines, "\n")
+}
 
-	reportedUser := reportedUserOption.UserValue(session)
-	reportedID := reportedUser.ID
+// reportTitle renders the ID and status of a report
+func reportTitle(report *snitchv1.Report) string {
+	return fmt.Sprintf("Report #%d (%s)", report.Id, reportStatusLabels[report.Status])
+}
 
-	reportReason := ""
-	reportReasonOption, ok := optionMap["report-reason"]
-	if ok {
-		reportReason = reportReasonOption.StringValue()
+// truncateText shortens text to at most maxLength bytes, marking the cut with an ellipsis
+func truncateText(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+
+	const ellipsis = "…"
+	if maxLength < len(ellipsis) {
+		return ""
+	}
+
+	// Cut on a rune boundary so no character is split
+	cut := maxLength - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + ellipsis
+}
+
+// formatReport renders the details of a report as markdown in at most maxLength bytes. The report text is shortened
+// first, so the details and evidence around it still fit.
+func formatReport(report *snitchv1.Report, maxLength int) string {
+	lines := []string{
+		fmt.Sprintf("Reported: <@%s> (%s)", report.ReportedId, report.ReportedId),
+		fmt.Sprintf("Reporter: <@%s>", report.ReporterId),
+		fmt.Sprintf("Origin server: %s", report.OriginServerId),
+	}
+	if report.CreatedAt != nil {
+		lines = append(lines, fmt.Sprintf("Created: <t:%d:f>", report.CreatedAt.Seconds))
+	}
+	if report.UpdatedAt != nil {
+		lines = append(lines, fmt.Sprintf("Updated: <t:%d:f>", report.UpdatedAt.Seconds))
 	}
+	if report.Category != nil {
+		lines = append(lines, fmt.Sprintf("Category: %s", *report.Category))
+	}
+	details := strings.Join(lines, "\n")
+
+	var evidence string
+	if len(report.Evidence) > 0 {
+		evidence = "\n" + formatEvidence(report.Evidence)
+	}
+
+	textLength := maxLength - len(details) - len("\n") - len(evidence)
+	if textLength < 0 {
+		// Not even the evidence fits next to the details, so it is shortened too
+		return truncateText(details+"\n"+evidence, maxLength)
+	}
+	return details + "\n" + truncateText(report.ReportText, textLength) + evidence
+}
+
+// reportFieldLength splits what is left of an embed's size limit between the fields of a page of reports
+func reportFieldLength(embed *messageutil.Embed, reports []*snitchv1.Report) int {
+	remaining := messageutil.EmbedLimitTotal - len(embed.Title) - len(embed.Description)
+	for _, report := range reports {
+		remaining -= len(reportTitle(report))
+	}
+	if len(reports) == 0 {
+		return messageutil.EmbedLimitFieldValue
+	}
+	return max(0, min(messageutil.EmbedLimitFieldValue, remaining/len(reports)))
+}
+
+// submitReport creates the report and records the reported user in the user history
+func submitReport(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient, userClient snitchv1connect.UserHistoryServiceClient, reportedUser *discordgo.User, reportReason string, category *string, evidence []*snitchv1.ReportEvidence) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	reporterID := interaction.Member.User.ID
 
-	reportRequest := connect.NewRequest(&snitchv1.CreateReportRequest{ReportText: reportReason, ReporterId: reporterID, ReportedId: reportedUser.ID})
+	reportRequest := connect.NewRequest(&snitchv1.CreateReportRequest{ReportText: reportReason, ReporterId: reporterID, ReportedId: reportedUser.ID, Evidence: evidence, Category: category})
 	reportRequest.Header().Add("X-Server-ID", interaction.GuildID)
 	reportResponse, err := client.CreateReport(ctx, reportRequest)
 	if err != nil {
@@ -56,7 +198,7 @@ func handleNewReport(ctx context.Context, session *discordgo.Session, interactio
 		return
 	}
 
-	userRequest := connect.NewRequest(&snitchv1.CreateUserHistoryRequest{UserId: reportedID, Username: reportedUser.Username, GlobalName: reportedUser.GlobalName, ChangedAt: time.Now().UTC().Format(time.RFC3339)})
+	userRequest := connect.NewRequest(&snitchv1.CreateUserHistoryRequest{UserId: reportedUser.ID, Username: reportedUser.Username, GlobalName: reportedUser.GlobalName, ChangedAt: time.Now().UTC().Format(time.RFC3339)})
 	userRequest.Header().Add("X-Server-ID", interaction.GuildID)
 	_, err = userClient.CreateUserHistory(ctx, userRequest)
 	if err != nil {
@@ -65,10 +207,46 @@ func handleNewReport(ctx context.Context, session *discordgo.Session, interactio
 		return
 	}
 
-	messageContent := fmt.Sprintf("Reported user: %s; Report reason: %s; Report ID: %d", reportedUser.Username, reportReason, reportResponse.Msg.ReportId)
+	messageContent := fmt.Sprintf("Reported user: %s; Report reason: %s; Evidence attached: %d; Report ID: %d", reportedUser.Username, reportReason, len(evidence), reportResponse.Msg.ReportId)
+	if len(reportResponse.Msg.Reports) > 1 {
+		var reportIDs, failedGroups []string
+		for _, report := range reportResponse.Msg.Reports {
+			if report.Error != "" {
+				failedGroups = append(failedGroups, report.GroupName)
+				continue
+			}
+			reportIDs = append(reportIDs, fmt.Sprintf("%d (group %s)", report.ReportId, report.GroupId))
+		}
+		messageContent = fmt.Sprintf("Reported user: %s; Report reason: %s; Evidence attached: %d; Reported to %d groups, report IDs: %s",
+			reportedUser.Username, reportReason, len(evidence), len(reportIDs), strings.Join(reportIDs, ", "))
+		if len(failedGroups) > 0 {
+			// Reporting to all groups again would file duplicates in the groups that already have the report
+			messageContent += fmt.Sprintf("\nCouldn't report to: %s. Report again with the group option set to each of them.",
+				strings.Join(failedGroups, ", "))
+		}
+	}
 	messageutil.SimpleRespondContext(ctx, session, interaction, messageContent)
 }
 
+func handleNewReport(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, forms *ReportForms) {
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	reportedUserOption, ok := optionMap["reported-user"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing reported-user option")
+		return
+	}
+
+	reportedUser := reportedUserOption.UserValue(session)
+	evidence := evidenceFromAttachments(interaction, optionMap)
+
+	openReportForm(ctx, session, interaction, forms, reportedUser, evidence)
+}
+
 func handleListReports(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient) {
 	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
 	if !ok {
@@ -94,28 +272,296 @@ func handleListReports(ctx context.Context, session *discordgo.Session, interact
 
 	}
 
-	slogger.InfoContext(ctx, "List Params", "Reporter", reporterUserID, "Reported", reportedUserID)
+	var status *snitchv1.ReportStatus
+	statusOption, ok := optionMap["status"]
+	if ok {
+		status = snitchv1.ReportStatus(snitchv1.ReportStatus_value[statusOption.StringValue()]).Enum()
+	}
 
-	listReportRequest := connect.NewRequest(&snitchv1.ListReportsRequest{ReporterId: reporterUserID, ReportedId: reportedUserID})
-	listReportRequest.Header().Add("X-Server-ID", interaction.GuildID)
-	listReportResponse, err := client.ListReports(ctx, listReportRequest)
+	var originServerID *string
+	originServerOption, ok := optionMap["origin-server"]
+	if ok {
+		serverID := strings.TrimSpace(originServerOption.StringValue())
+		if _, err := strconv.ParseUint(serverID, 10, 64); err != nil {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "The origin-server option must be a server ID")
+			return
+		}
+		originServerID = &serverID
+	}
+
+	var createdAfter *timestamppb.Timestamp
+	sinceOption, ok := optionMap["since"]
+	if ok {
+		since, err := time.Parse(reportDateLayout, sinceOption.StringValue())
+		if err != nil {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "The since option must be a date like 2025-01-31")
+			return
+		}
+		createdAfter = timestamppb.New(since)
+	}
+
+	var createdBefore *timestamppb.Timestamp
+	untilOption, ok := optionMap["until"]
+	if ok {
+		until, err := time.Parse(reportDateLayout, untilOption.StringValue())
+		if err != nil {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "The until option must be a date like 2025-01-31")
+			return
+		}
+		// Include reports from the whole until day
+		createdBefore = timestamppb.New(until.AddDate(0, 0, 1))
+	}
 
+	slogger.InfoContext(ctx, "List Params", "Reporter", reporterUserID, "Reported", reportedUserID, "Status", status, "Origin Server", originServerID, "Since", createdAfter, "Until", createdBefore)
+
+	listReportRequest := &snitchv1.ListReportsRequest{
+		ReporterId:     reporterUserID,
+		ReportedId:     reportedUserID,
+		Status:         status,
+		OriginServerId: originServerID,
+		CreatedAfter:   createdAfter,
+		CreatedBefore:  createdBefore,
+	}
+	embeds, components, err := listReportsPage(ctx, client, interaction.GuildID, listReportRequest)
 	if err != nil {
 		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
 		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't list reports, error: %s", err.Error()))
 		return
 	}
 
+	messageutil.EmbedComponentsRespondContext(ctx, session, interaction, embeds, components)
+}
+
+// reportsPageCustomID builds the custom ID of a report list page button, carrying the list filters and the
+// picked group along. Custom IDs are limited to 100 characters, so IDs and timestamps are packed in base 36
+// and group IDs in base 64.
+func reportsPageCustomID(pageToken *string, request *snitchv1.ListReportsRequest, groupID string) string {
+	var status, createdAfter, createdBefore string
+	if request.Status != nil {
+		status = strconv.Itoa(int(*request.Status))
+	}
+	if request.CreatedAfter != nil {
+		createdAfter = strconv.FormatInt(request.CreatedAfter.Seconds, 36)
+	}
+	if request.CreatedBefore != nil {
+		createdBefore = strconv.FormatInt(request.CreatedBefore.Seconds, 36)
+	}
+
+	return slashcommand.CustomID(ReportsPageButton,
+		ptrValue(pageToken),
+		packID(request.GetReporterId()),
+		packID(request.GetReportedId()),
+		packID(request.GetOriginServerId()),
+		status,
+		createdAfter,
+		createdBefore,
+		packGroupID(groupID),
+	)
+}
+
+// reportsPageRequest rebuilds the list request and group encoded in a report list page button custom ID.
+// Buttons made before groups could be picked have no group argument.
+func reportsPageRequest(args []string) (*snitchv1.ListReportsRequest, string, error) {
+	if len(args) != 7 && len(args) != 8 {
+		return nil, "", fmt.Errorf("expected 7 or 8 custom ID arguments, got %d", len(args))
+	}
+
+	request := &snitchv1.ListReportsRequest{PageToken: optionalValue(args[0])}
+
+	var err error
+	if request.ReporterId, err = unpackID(args[1]); err != nil {
+		return nil, "", err
+	}
+	if request.ReportedId, err = unpackID(args[2]); err != nil {
+		return nil, "", err
+	}
+	if request.OriginServerId, err = unpackID(args[3]); err != nil {
+		return nil, "", err
+	}
+
+	if args[4] != "" {
+		status, err := strconv.Atoi(args[4])
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid status %q: %w", args[4], err)
+		}
+		request.Status = snitchv1.ReportStatus(status).Enum()
+	}
+
+	if args[5] != "" {
+		seconds, err := strconv.ParseInt(args[5], 36, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid created after %q: %w", args[5], err)
+		}
+		request.CreatedAfter = &timestamppb.Timestamp{Seconds: seconds}
+	}
+	if args[6] != "" {
+		seconds, err := strconv.ParseInt(args[6], 36, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid created before %q: %w", args[6], err)
+		}
+		request.CreatedBefore = &timestamppb.Timestamp{Seconds: seconds}
+	}
+
+	var groupID string
+	if len(args) == 8 {
+		if groupID, err = unpackGroupID(args[7]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return request, groupID, nil
+}
+
+// packGroupID shortens a group UUID by writing its bytes in base 64
+func packGroupID(groupID string) string {
+	id, err := uuid.Parse(groupID)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(id[:])
+}
+
+// unpackGroupID restores a group ID packed by packGroupID
+func unpackGroupID(packed string) (string, error) {
+	if packed == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(packed)
+	if err != nil {
+		return "", fmt.Errorf("invalid group ID %q: %w", packed, err)
+	}
+	id, err := uuid.FromBytes(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid group ID %q: %w", packed, err)
+	}
+	return id.String(), nil
+}
+
+// packID shortens a Discord snowflake ID by writing it in base 36
+func packID(id string) string {
+	snowflake, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatUint(snowflake, 36)
+}
+
+// unpackID restores a snowflake ID packed by packID
+func unpackID(packed string) (*string, error) {
+	if packed == "" {
+		return nil, nil
+	}
+	snowflake, err := strconv.ParseUint(packed, 36, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID %q: %w", packed, err)
+	}
+	id := strconv.FormatUint(snowflake, 10)
+	return &id, nil
+}
+
+func ptrValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalValue(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// listReportsPage fetches one page of reports and renders it along with its Previous/Next buttons
+func listReportsPage(ctx context.Context, client snitchv1connect.ReportServiceClient, guildID string, request *snitchv1.ListReportsRequest) ([]*discordgo.MessageEmbed, []discordgo.MessageComponent, error) {
+	listReportRequest := connect.NewRequest(request)
+	listReportRequest.Header().Add("X-Server-ID", guildID)
+	listReportResponse, err := client.ListReports(ctx, listReportRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Further pages stay in the group picked for this one; with no group picked the server has only one
+	var groupID string
+	if groupselector.FromContext(ctx) != "" {
+		groupID = listReportResponse.Msg.GroupId
+	}
+
 	reportEmbed := messageutil.NewEmbed().
 		SetTitle("Reports").
 		SetDescription("Report List")
 
 	reports := listReportResponse.Msg.Reports
-	for index, report := range reports {
-		headerField := fmt.Sprintf("%d: Reporter ID: %s, Reported ID: %s", index, report.ReporterId, report.ReportedId)
-		reportEmbed.AddField(headerField, report.ReportText)
+	if len(reports) == 0 {
+		reportEmbed.SetDescription("No reports found")
+	}
+	// Every report of the page gets an equal share of Discord's limit on the size of an embed
+	fieldLength := reportFieldLength(reportEmbed, reports)
+	for _, report := range reports {
+		reportEmbed.AddField(reportTitle(report), formatReport(report, fieldLength))
+	}
+
+	previousPageToken := listReportResponse.Msg.PreviousPageToken
+	nextPageToken := listReportResponse.Msg.NextPageToken
+	if previousPageToken == nil && nextPageToken == nil {
+		return []*discordgo.MessageEmbed{reportEmbed.MessageEmbed}, []discordgo.MessageComponent{}, nil
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: reportsPageCustomID(previousPageToken, request, groupID),
+					Disabled: previousPageToken == nil,
+				},
+				discordgo.Button{
+					Label:    "Next",
+					Style:    discordgo.SecondaryButton,
+					CustomID: reportsPageCustomID(nextPageToken, request, groupID),
+					Disabled: nextPageToken == nil,
+				},
+			},
+		},
 	}
 
+	return []*discordgo.MessageEmbed{reportEmbed.MessageEmbed}, components, nil
+}
+
+func handleViewReport(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	reportIDOption, ok := optionMap["report-id"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing report-id option")
+		return
+	}
+
+	getReportRequest := connect.NewRequest(&snitchv1.GetReportRequest{ReportId: reportIDOption.IntValue()})
+	getReportRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	getReportResponse, err := client.GetReport(ctx, getReportRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't get report, error: %s", err.Error()))
+		return
+	}
+
+	report := getReportResponse.Msg.Report
+	reportEmbed := messageutil.NewEmbed().
+		SetTitle(reportTitle(report)).
+		SetDescription(formatReport(report, messageutil.EmbedLimitDescription))
+
 	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{reportEmbed.MessageEmbed})
 }
 
@@ -137,7 +583,7 @@ func handleDeleteReport(ctx context.Context, session *discordgo.Session, interac
 		reportID = reportIDOption.IntValue()
 	}
 
-	deleteReportRequest := connect.NewRequest(&snitchv1.DeleteReportRequest{ReportId: reportID})
+	deleteReportRequest := connect.NewRequest(&snitchv1.DeleteReportRequest{ReportId: reportID, UserId: interaction.Member.User.ID})
 	deleteReportRequest.Header().Add("X-Server-ID", interaction.GuildID)
 	deleteReportResponse, err := client.DeleteReport(ctx, deleteReportRequest)
 	if err != nil {
@@ -149,13 +595,138 @@ func handleDeleteReport(ctx context.Context, session *discordgo.Session, interac
 	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Deleted report %d", deleteReportResponse.Msg.ReportId))
 }
 
-func CreateReportCommandHandler(botconfig botconfig.BotConfig, httpClient http.Client) slashcommand.SlashCommandHandlerFunc {
+func handleUpdateReportStatus(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	options := interaction.ApplicationCommandData().Options[0].Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	reportIDOption, ok := optionMap["report-id"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing report-id option")
+		return
+	}
+
+	statusOption, ok := optionMap["status"]
+	if !ok {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "Missing status option")
+		return
+	}
+
+	reportID := reportIDOption.IntValue()
+	status := snitchv1.ReportStatus(snitchv1.ReportStatus_value[statusOption.StringValue()])
+
+	updateStatusRequest := connect.NewRequest(&snitchv1.UpdateReportStatusRequest{ReportId: reportID, Status: status, UserId: interaction.Member.User.ID})
+	updateStatusRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	updateStatusResponse, err := client.UpdateReportStatus(ctx, updateStatusRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't update report status, error: %s", err.Error()))
+		return
+	}
+
+	messageContent := fmt.Sprintf("Report %d moved from %s to %s",
+		updateStatusResponse.Msg.ReportId,
+		reportStatusLabels[updateStatusResponse.Msg.PreviousStatus],
+		reportStatusLabels[updateStatusResponse.Msg.Status])
+	messageutil.SimpleRespondContext(ctx, session, interaction, messageContent)
+}
+
+// maxAuditDetailsText keeps a page of audit entries within Discord's embed size limit
+const maxAuditDetailsText = 200
+
+// formatAuditEntry renders who did what to a report as markdown
+func formatAuditEntry(entry *snitchv1.ReportAuditEntry) string {
+	lines := []string{
+		fmt.Sprintf("By: <@%s> from server %s", entry.ActorUserId, entry.ActorServerId),
+		fmt.Sprintf("Reported: <@%s>, filed from server %s", entry.ReportedUserId, entry.OriginServerId),
+	}
+	if entry.CreatedAt != nil {
+		lines = append(lines, fmt.Sprintf("When: <t:%d:f>", entry.CreatedAt.Seconds))
+	}
+	if entry.Details != nil {
+		details := *entry.Details
+		if len([]rune(details)) > maxAuditDetailsText {
+			details = string([]rune(details)[:maxAuditDetailsText]) + "…"
+		}
+		lines = append(lines, fmt.Sprintf("Details: %s", details))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func handleReportAuditLog(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.ReportServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	auditRequest := connect.NewRequest(&snitchv1.ListReportAuditLogRequest{})
+	auditRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	auditResponse, err := client.ListReportAuditLog(ctx, auditRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't get the report audit log, error: %s", err.Error()))
+		return
+	}
+
+	if len(auditResponse.Msg.Entries) == 0 {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "No reports have been deleted or updated yet")
+		return
+	}
+
+	auditEmbed := messageutil.NewEmbed().SetTitle("Report audit log")
+	for _, entry := range auditResponse.Msg.Entries {
+		auditEmbed.AddField(fmt.Sprintf("%s: report #%d", reportAuditActionLabels[entry.Action], entry.ReportId), formatAuditEntry(entry))
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{auditEmbed.MessageEmbed})
+}
+
+func CreateReportsPageHandler(botconfig botconfig.BotConfig, httpClient http.Client) slashcommand.SlashCommandHandlerFunc {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+	reportServiceClient := snitchv1connect.NewReportServiceClient(&httpClient, backendURL.String())
+
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+		if !ok {
+			slogger = slog.Default()
+		}
+
+		_, args := slashcommand.ParseCustomID(interaction.MessageComponentData().CustomID)
+		request, groupID, err := reportsPageRequest(args)
+		if err != nil {
+			slogger.ErrorContext(ctx, "Invalid report page button", "Error", err)
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid report page")
+			return
+		}
+		ctx = groupselector.WithSelector(ctx, groupID)
+
+		embeds, components, err := listReportsPage(ctx, reportServiceClient, interaction.GuildID, request)
+		if err != nil {
+			slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+			messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't list reports, error: %s", err.Error()))
+			return
+		}
+
+		messageutil.EmbedComponentsUpdateContext(ctx, session, interaction, embeds, components)
+	}
+}
+
+func CreateReportCommandHandler(botconfig botconfig.BotConfig, httpClient http.Client, forms *ReportForms) slashcommand.SlashCommandHandlerFunc {
 	backendURL, err := botconfig.BackendURL()
 	if err != nil {
 		log.Fatal(backendURL)
 	}
 	reportServiceClient := snitchv1connect.NewReportServiceClient(&httpClient, backendURL.String())
-	userServiceClient := snitchv1connect.NewUserHistoryServiceClient(&httpClient, backendURL.String())
 
 	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
 		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
@@ -167,11 +738,17 @@ func CreateReportCommandHandler(botconfig botconfig.BotConfig, httpClient http.C
 
 		switch options[0].Name {
 		case "new":
-			handleNewReport(ctx, session, interaction, reportServiceClient, userServiceClient)
+			handleNewReport(ctx, session, interaction, forms)
 		case "list":
 			handleListReports(ctx, session, interaction, reportServiceClient)
+		case "view":
+			handleViewReport(ctx, session, interaction, reportServiceClient)
 		case "delete":
 			handleDeleteReport(ctx, session, interaction, reportServiceClient)
+		case "status":
+			handleUpdateReportStatus(ctx, session, interaction, reportServiceClient)
+		case "audit":
+			handleReportAuditLog(ctx, session, interaction, reportServiceClient)
 		default:
 			slogger.ErrorContext(ctx, "Invalid subcommand", "Subcommand Name", options[0].Name)
 		}
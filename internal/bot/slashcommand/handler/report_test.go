@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"snitch/internal/bot/messageutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestTruncateText(t *testing.T) {
+	if got := truncateText("short", 10); got != "short" {
+		t.Errorf("truncateText kept %q, expected the text unchanged", got)
+	}
+
+	got := truncateText(strings.Repeat("é", 10), 10)
+	if len(got) > 10 || !utf8.ValidString(got) || !strings.HasSuffix(got, "…") {
+		t.Errorf("truncateText returned %q, expected at most 10 bytes of valid UTF-8 ending in an ellipsis", got)
+	}
+}
+
+func TestFormatReportKeepsEvidence(t *testing.T) {
+	report := &snitchv1.Report{
+		Id:             1,
+		ReportedId:     "1234",
+		ReporterId:     "5678",
+		OriginServerId: "9012",
+		ReportText:     strings.Repeat("a", 2000),
+		Evidence: []*snitchv1.ReportEvidence{
+			{Url: "https://example.com/evidence"},
+		},
+	}
+
+	formatted := formatReport(report, messageutil.EmbedLimitFieldValue)
+	if len(formatted) > messageutil.EmbedLimitFieldValue {
+		t.Errorf("Formatted report is %d bytes, expected at most %d", len(formatted), messageutil.EmbedLimitFieldValue)
+	}
+	if !strings.Contains(formatted, "https://example.com/evidence") {
+		t.Error("Expected the evidence to survive shortening the report text")
+	}
+}
+
+func TestReportPageFitsEmbedLimit(t *testing.T) {
+	embed := messageutil.NewEmbed().SetTitle("Reports").SetDescription("Report List")
+
+	var reports []*snitchv1.Report
+	for i := range 25 {
+		reports = append(reports, &snitchv1.Report{
+			Id:         int64(i + 1),
+			ReportedId: "1234",
+			ReporterId: "5678",
+			ReportText: strings.Repeat("a", 2000),
+		})
+	}
+
+	fieldLength := reportFieldLength(embed, reports)
+	for _, report := range reports {
+		embed.AddField(reportTitle(report), formatReport(report, fieldLength))
+	}
+
+	total := len(embed.Title) + len(embed.Description)
+	for _, field := range embed.Fields {
+		total += len(field.Name) + len(field.Value)
+	}
+	if total > messageutil.EmbedLimitTotal {
+		t.Errorf("A page of reports is %d characters, expected at most %d", total, messageutil.EmbedLimitTotal)
+	}
+}
+
+func TestEvidenceFromAttachments(t *testing.T) {
+	interaction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{
+			Resolved: &discordgo.ApplicationCommandInteractionDataResolved{
+				Attachments: map[string]*discordgo.MessageAttachment{
+					"attachment-1": {URL: "https://cdn.discordapp.com/attachments/1/2/image.png?ex=1", ContentType: "image/png"},
+					"attachment-2": {URL: "https://cdn.discordapp.com/attachments/1/2/log.txt"},
+				},
+			},
+		},
+	}}
+	optionMap := map[string]*discordgo.ApplicationCommandInteractionDataOption{
+		"evidence-3": {Name: "evidence-3", Type: discordgo.ApplicationCommandOptionAttachment, Value: "attachment-2"},
+		"evidence":   {Name: "evidence", Type: discordgo.ApplicationCommandOptionAttachment, Value: "attachment-1"},
+		"evidence-2": {Name: "evidence-2", Type: discordgo.ApplicationCommandOptionAttachment, Value: "missing"},
+	}
+
+	evidence := evidenceFromAttachments(interaction, optionMap)
+	if len(evidence) != 2 {
+		t.Fatalf("Expected the 2 resolved attachments, got %d", len(evidence))
+	}
+	if evidence[0].Url != "https://cdn.discordapp.com/attachments/1/2/image.png" || evidence[0].GetContentType() != "image/png" {
+		t.Errorf("Expected the first evidence option first with its content type, got %v", evidence[0])
+	}
+	if evidence[1].Url != "https://cdn.discordapp.com/attachments/1/2/log.txt" || evidence[1].ContentType != nil {
+		t.Errorf("Expected the attachment without a content type to have none, got %v", evidence[1])
+	}
+}
@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"snitch/internal/bot/botconfig"
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ReportFormModal is the custom ID name report form submissions are routed by
+const ReportFormModal = "report-form"
+
+// reportFormTTL matches the lifetime of the interaction token that opened the form
+const reportFormTTL = 15 * time.Minute
+
+const (
+	reportReasonInput       = "reason"
+	reportCategoryInput     = "category"
+	reportEvidenceLinkInput = "evidence-link"
+	reportGroupInput        = groupselector.OptionName
+)
+
+// Limits enforced by the reports and report_evidence tables
+const (
+	maxReportReasonLength   = 2000
+	maxReportCategoryLength = 100
+	maxEvidenceLinkLength   = 500
+)
+
+// maxGroupSelectorLength fits a group ID or a reasonably long group name
+const maxGroupSelectorLength = 100
+
+type pendingReport struct {
+	reportedUser *discordgo.User
+	evidence     []*snitchv1.ReportEvidence
+	expiresAt    time.Time
+}
+
+// ReportForms holds the details of reports whose modal is still open, keyed by the interaction that opened it
+type ReportForms struct {
+	mu      sync.Mutex
+	pending map[string]pendingReport
+}
+
+func NewReportForms() *ReportForms {
+	return &ReportForms{pending: make(map[string]pendingReport)}
+}
+
+func (f *ReportForms) put(formID string, report pendingReport) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Forms that are never submitted would otherwise pile up
+	now := time.Now()
+	for id, pending := range f.pending {
+		if now.After(pending.expiresAt) {
+			delete(f.pending, id)
+		}
+	}
+
+	report.expiresAt = now.Add(reportFormTTL)
+	f.pending[formID] = report
+}
+
+func (f *ReportForms) take(formID string) (pendingReport, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	report, ok := f.pending[formID]
+	if !ok {
+		return pendingReport{}, false
+	}
+	delete(f.pending, formID)
+
+	if time.Now().After(report.expiresAt) {
+		return pendingReport{}, false
+	}
+	return report, true
+}
+
+// messageLink builds the jump link for a message
+func messageLink(guildID string, message *discordgo.Message) string {
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, message.ChannelID, message.ID)
+}
+
+// attachmentURL drops the query of a Discord CDN attachment URL, which only carries a signature that expires
+// after a day and can push the URL past the evidence limit. It returns false when the URL still doesn't fit in the
+// evidence table.
+func attachmentURL(rawURL string) (string, bool) {
+	if parsed, err := url.Parse(rawURL); err == nil {
+		parsed.RawQuery = ""
+		parsed.Fragment = ""
+		rawURL = parsed.String()
+	}
+	if utf8.RuneCountInString(rawURL) > maxEvidenceLinkLength {
+		return "", false
+	}
+	return rawURL, true
+}
+
+// evidenceFromMessage captures a reported message and its attachments as report evidence. Attachments are kept
+// with the link to their message, since their own URLs expire.
+func evidenceFromMessage(guildID string, message *discordgo.Message) []*snitchv1.ReportEvidence {
+	link := messageLink(guildID, message)
+	channelID := message.ChannelID
+	authorID := message.Author.ID
+	content := message.Content
+
+	evidence := []*snitchv1.ReportEvidence{{
+		Url:             link,
+		MessageLink:     &link,
+		MessageContent:  &content,
+		MessageAuthorId: &authorID,
+		ChannelId:       &channelID,
+	}}
+
+	for _, attachment := range message.Attachments {
+		evidenceURL, ok := attachmentURL(attachment.URL)
+		if !ok {
+			continue
+		}
+		item := &snitchv1.ReportEvidence{
+			Url:             evidenceURL,
+			MessageLink:     &link,
+			MessageAuthorId: &authorID,
+			ChannelId:       &channelID,
+		}
+		if attachment.ContentType != "" {
+			item.ContentType = &attachment.ContentType
+		}
+		evidence = append(evidence, item)
+	}
+
+	return evidence
+}
+
+// openReportForm stores the report details and asks the moderator for the rest through a modal
+func openReportForm(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, forms *ReportForms, reportedUser *discordgo.User, evidence []*snitchv1.ReportEvidence) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	forms.put(interaction.ID, pendingReport{reportedUser: reportedUser, evidence: evidence})
+
+	if err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: slashcommand.CustomID(ReportFormModal, interaction.ID),
+			Title:    fmt.Sprintf("Report %s", reportedUser.Username),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:  reportReasonInput,
+							Label:     "Reason",
+							Style:     discordgo.TextInputParagraph,
+							Required:  true,
+							MinLength: 1,
+							MaxLength: maxReportReasonLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    reportCategoryInput,
+							Label:       "Category",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "e.g. spam, harassment, scam",
+							Required:    false,
+							MaxLength:   maxReportCategoryLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    reportEvidenceLinkInput,
+							Label:       "Evidence link",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "https://",
+							Required:    false,
+							MaxLength:   maxEvidenceLinkLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    reportGroupInput,
+							Label:       "Group",
+							Style:       discordgo.TextInputShort,
+							Placeholder: fmt.Sprintf("Group name or ID, or %s; only if in several groups", groupselector.All),
+							Value:       groupselector.FromContext(ctx),
+							Required:    false,
+							MaxLength:   maxGroupSelectorLength,
+						},
+					},
+				},
+			},
+		},
+	}); err != nil {
+		forms.take(interaction.ID)
+		slogger.ErrorContext(ctx, "Failed to open report form", "Error", err)
+	}
+}
+
+// modalValues maps the custom ID of every text input in a submitted modal to its value
+func modalValues(data discordgo.ModalSubmitInteractionData) map[string]string {
+	values := make(map[string]string)
+	for _, component := range data.Components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, rowComponent := range row.Components {
+			if input, ok := rowComponent.(*discordgo.TextInput); ok {
+				values[input.CustomID] = input.Value
+			}
+		}
+	}
+	return values
+}
+
+// validateReportForm checks the submitted form against the limits the database schema enforces
+func validateReportForm(reason, category, evidenceLink string) error {
+	if reason == "" {
+		return errors.New("a reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxReportReasonLength {
+		return fmt.Errorf("the reason must be at most %d characters", maxReportReasonLength)
+	}
+	if utf8.RuneCountInString(category) > maxReportCategoryLength {
+		return fmt.Errorf("the category must be at most %d characters", maxReportCategoryLength)
+	}
+
+	if evidenceLink == "" {
+		return nil
+	}
+	if utf8.RuneCountInString(evidenceLink) > maxEvidenceLinkLength {
+		return fmt.Errorf("the evidence link must be at most %d characters", maxEvidenceLinkLength)
+	}
+	link, err := url.Parse(evidenceLink)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+		return errors.New("the evidence link must be an http or https URL")
+	}
+
+	return nil
+}
+
+func CreateReportUserCommandHandler(forms *ReportForms) slashcommand.SlashCommandHandlerFunc {
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		data := interaction.ApplicationCommandData()
+
+		reportedUser, ok := data.Resolved.Users[data.TargetID]
+		if !ok {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Couldn't find the user to report")
+			return
+		}
+
+		openReportForm(ctx, session, interaction, forms, reportedUser, nil)
+	}
+}
+
+func CreateReportMessageCommandHandler(forms *ReportForms) slashcommand.SlashCommandHandlerFunc {
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		data := interaction.ApplicationCommandData()
+
+		message, ok := data.Resolved.Messages[data.TargetID]
+		if !ok || message.Author == nil {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Couldn't find the message to report")
+			return
+		}
+
+		openReportForm(ctx, session, interaction, forms, message.Author, evidenceFromMessage(interaction.GuildID, message))
+	}
+}
+
+func CreateReportFormHandler(botconfig botconfig.BotConfig, httpClient http.Client, forms *ReportForms) slashcommand.SlashCommandHandlerFunc {
+	backendURL, err := botconfig.BackendURL()
+	if err != nil {
+		log.Fatal(backendURL)
+	}
+	reportServiceClient := snitchv1connect.NewReportServiceClient(&httpClient, backendURL.String())
+	userServiceClient := snitchv1connect.NewUserHistoryServiceClient(&httpClient, backendURL.String())
+
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		data := interaction.ModalSubmitData()
+
+		_, args := slashcommand.ParseCustomID(data.CustomID)
+		if len(args) != 1 {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "Invalid report form")
+			return
+		}
+
+		report, ok := forms.take(args[0])
+		if !ok {
+			messageutil.SimpleRespondContext(ctx, session, interaction, "This report form has expired, please start the report again")
+			return
+		}
+
+		values := modalValues(data)
+		reason := strings.TrimSpace(values[reportReasonInput])
+		category := strings.TrimSpace(values[reportCategoryInput])
+		evidenceLink := strings.TrimSpace(values[reportEvidenceLinkInput])
+
+		// The group input is filled in from the command's group option and can still be changed in the form
+		ctx = groupselector.WithSelector(ctx, strings.TrimSpace(values[reportGroupInput]))
+
+		if err := validateReportForm(reason, category, evidenceLink); err != nil {
+			messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't report user, %s", err.Error()))
+			return
+		}
+
+		evidence := report.evidence
+		if evidenceLink != "" {
+			evidence = append(evidence, &snitchv1.ReportEvidence{Url: evidenceLink})
+		}
+
+		var reportCategory *string
+		if category != "" {
+			reportCategory = &category
+		}
+
+		submitReport(ctx, session, interaction, reportServiceClient, userServiceClient, report.reportedUser, reason, reportCategory, evidence)
+	}
+}
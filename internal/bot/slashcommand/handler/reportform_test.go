@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestEvidenceFromMessage(t *testing.T) {
+	message := &discordgo.Message{
+		ID:        "message-1",
+		ChannelID: "channel-1",
+		Content:   "spam",
+		Author:    &discordgo.User{ID: "user-1"},
+		Attachments: []*discordgo.MessageAttachment{
+			{URL: "https://cdn.discordapp.com/attachments/1/2/image.png?ex=1&is=2&hm=" + strings.Repeat("a", 64), ContentType: "image/png"},
+			{URL: "https://cdn.discordapp.com/attachments/1/2/" + strings.Repeat("a", maxEvidenceLinkLength) + ".png"},
+		},
+	}
+
+	evidence := evidenceFromMessage("guild-1", message)
+	if len(evidence) != 2 {
+		t.Fatalf("Expected the message and the attachment that fits, got %d items", len(evidence))
+	}
+
+	link := "https://discord.com/channels/guild-1/channel-1/message-1"
+	if evidence[0].Url != link || evidence[0].GetMessageContent() != "spam" {
+		t.Errorf("Expected the message evidence to link to the message, got %v", evidence[0])
+	}
+	if evidence[1].Url != "https://cdn.discordapp.com/attachments/1/2/image.png" {
+		t.Errorf("Expected the attachment URL without its expiring signature, got %s", evidence[1].Url)
+	}
+	if evidence[1].GetMessageLink() != link {
+		t.Errorf("Expected the attachment to keep the message link, got %s", evidence[1].GetMessageLink())
+	}
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"snitch/internal/bot/messageutil"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// groupRoleName is the display name of a role, e.g. "Admin"
+func groupRoleName(role snitchv1.GroupRole) string {
+	name := strings.ToLower(strings.TrimPrefix(role.String(), "GROUP_ROLE_"))
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func handleListGroupServers(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	listRequest := connect.NewRequest(&snitchv1.ListGroupServersRequest{})
+	listRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	listResponse, err := client.ListGroupServers(ctx, listRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't list servers, error: %s", err.Error()))
+		return
+	}
+
+	embed := messageutil.NewEmbed().SetTitle("Group Servers")
+	for _, server := range listResponse.Msg.Servers {
+		name := server.ServerId
+		if guild, err := session.State.Guild(server.ServerId); err == nil {
+			name = fmt.Sprintf("%s (%s)", guild.Name, guild.ID)
+		}
+		embed.AddField(name, groupRoleName(server.Role))
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{embed.Truncate().MessageEmbed})
+}
+
+// handleListServerGroups lists the groups this server is in, with the IDs the group option accepts
+func handleListServerGroups(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	listRequest := connect.NewRequest(&snitchv1.ListServerGroupsRequest{})
+	listRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	listResponse, err := client.ListServerGroups(ctx, listRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't list groups, error: %s", err.Error()))
+		return
+	}
+
+	if len(listResponse.Msg.Groups) == 0 {
+		messageutil.SimpleRespondContext(ctx, session, interaction, "This server isn't in a group yet, use /register group create or /register group join.")
+		return
+	}
+
+	embed := messageutil.NewEmbed().SetTitle("Groups")
+	if len(listResponse.Msg.Groups) > 1 {
+		embed.SetDescription("Pick a group with the group option of a command, by name or ID")
+	}
+	for _, group := range listResponse.Msg.Groups {
+		embed.AddField(fmt.Sprintf("%s (%s)", group.GroupName, group.GroupId), groupRoleName(group.Role))
+	}
+
+	messageutil.EmbedRespondContext(ctx, session, interaction, []*discordgo.MessageEmbed{embed.Truncate().MessageEmbed})
+}
+
+func handleSetServerRole(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	var serverID string
+	var role snitchv1.GroupRole
+	for _, option := range interaction.ApplicationCommandData().Options[0].Options[0].Options {
+		switch option.Name {
+		case "server-id":
+			serverID = option.StringValue()
+		case "role":
+			role = snitchv1.GroupRole(snitchv1.GroupRole_value[option.StringValue()])
+		}
+	}
+
+	roleRequest := connect.NewRequest(&snitchv1.SetServerRoleRequest{ServerId: serverID, Role: role, UserId: interaction.Member.User.ID})
+	roleRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	roleResponse, err := client.SetServerRole(ctx, roleRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't change server role, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Server %s is now %s (was %s).",
+		roleResponse.Msg.ServerId, groupRoleName(roleResponse.Msg.Role), groupRoleName(roleResponse.Msg.PreviousRole)))
+}
+
+func handleTransferOwnership(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, client snitchv1connect.RegistrarServiceClient) {
+	slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+	if !ok {
+		slogger = slog.Default()
+	}
+
+	serverID := interaction.ApplicationCommandData().Options[0].Options[0].Options[0].StringValue()
+
+	transferRequest := connect.NewRequest(&snitchv1.TransferOwnershipRequest{ServerId: serverID, UserId: interaction.Member.User.ID})
+	transferRequest.Header().Add("X-Server-ID", interaction.GuildID)
+	transferResponse, err := client.TransferOwnership(ctx, transferRequest)
+	if err != nil {
+		slogger.ErrorContext(ctx, "Backend Request Call", "Error", err)
+		messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Couldn't transfer ownership, error: %s", err.Error()))
+		return
+	}
+
+	messageutil.SimpleRespondContext(ctx, session, interaction, fmt.Sprintf("Server %s now owns the group; this server is an admin.", transferResponse.Msg.OwnerServerId))
+}
@@ -2,14 +2,68 @@ package slashcommand
 
 import (
 	"context"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// customIDSeparator separates the handler name from its arguments in modal and component custom IDs
+const customIDSeparator = ":"
+
 type SlashCommandHandlerFunc func(context.Context, *discordgo.Session, *discordgo.InteractionCreate)
 
 func (slashCommandFuncContext SlashCommandHandlerFunc) Adapt() func(*discordgo.Session, *discordgo.InteractionCreate) {
 	return func(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if interaction.Type != discordgo.InteractionApplicationCommand {
+			return
+		}
+		slashCommandFuncContext(context.Background(), session, interaction)
+	}
+}
+
+// AdaptModalSubmit is like Adapt but only receives modal submissions
+func (slashCommandFuncContext SlashCommandHandlerFunc) AdaptModalSubmit() func(*discordgo.Session, *discordgo.InteractionCreate) {
+	return func(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if interaction.Type != discordgo.InteractionModalSubmit {
+			return
+		}
+		slashCommandFuncContext(context.Background(), session, interaction)
+	}
+}
+
+// AdaptMessageComponent is like Adapt but only receives message component interactions such as button clicks
+func (slashCommandFuncContext SlashCommandHandlerFunc) AdaptMessageComponent() func(*discordgo.Session, *discordgo.InteractionCreate) {
+	return func(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if interaction.Type != discordgo.InteractionMessageComponent {
+			return
+		}
 		slashCommandFuncContext(context.Background(), session, interaction)
 	}
 }
+
+// CustomID builds a modal or component custom ID routed to the handler registered under name
+func CustomID(name string, args ...string) string {
+	return strings.Join(append([]string{name}, args...), customIDSeparator)
+}
+
+// ParseCustomID splits a custom ID built by CustomID into the handler name and its arguments
+func ParseCustomID(customID string) (string, []string) {
+	parts := strings.Split(customID, customIDSeparator)
+	return parts[0], parts[1:]
+}
+
+// InteractionName returns the command name or custom ID handler name of an interaction
+func InteractionName(interaction *discordgo.InteractionCreate) string {
+	switch interaction.Type {
+	case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+		return interaction.ApplicationCommandData().Name
+	case discordgo.InteractionModalSubmit:
+		name, _ := ParseCustomID(interaction.ModalSubmitData().CustomID)
+		return name
+	case discordgo.InteractionMessageComponent:
+		name, _ := ParseCustomID(interaction.MessageComponentData().CustomID)
+		return name
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"snitch/internal/bot/groupselector"
+	"snitch/internal/bot/slashcommand"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandGroupSelector finds the group option of a slash command, looking through its subcommands
+func commandGroupSelector(options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	for _, option := range options {
+		switch option.Type {
+		case discordgo.ApplicationCommandOptionSubCommand, discordgo.ApplicationCommandOptionSubCommandGroup:
+			if selector := commandGroupSelector(option.Options); selector != "" {
+				return selector
+			}
+		case discordgo.ApplicationCommandOptionString:
+			if option.Name == groupselector.OptionName {
+				return strings.TrimSpace(option.StringValue())
+			}
+		}
+	}
+	return ""
+}
+
+// GroupSelector makes backend requests of a slash command target the group picked with its group option.
+// Modals and buttons carry their group in their custom ID and select it themselves.
+func GroupSelector(next slashcommand.SlashCommandHandlerFunc) slashcommand.SlashCommandHandlerFunc {
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if interaction.Type == discordgo.InteractionApplicationCommand {
+			ctx = groupselector.WithSelector(ctx, commandGroupSelector(interaction.ApplicationCommandData().Options))
+		}
+		next(ctx, session, interaction)
+	}
+}
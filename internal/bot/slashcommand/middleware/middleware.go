@@ -26,7 +26,7 @@ func Log(next slashcommand.SlashCommandHandlerFunc) slashcommand.SlashCommandHan
 		slogger := slog.New(slog.NewTextHandler(os.Stdout, nil)).With(
 			slog.String("User ID", interaction.Member.User.ID),
 			slog.String("Guild ID", interaction.GuildID),
-			slog.String("Command", interaction.ApplicationCommandData().Name),
+			slog.String("Command", slashcommand.InteractionName(interaction)),
 		)
 
 		ctx = ctxutil.WithValue(ctx, slogger)
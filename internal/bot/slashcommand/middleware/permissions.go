@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"snitch/internal/bot/slashcommand"
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+	"snitch/pkg/proto/gen/snitch/v1/snitchv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/bwmarrin/discordgo"
+)
+
+// PermissionFunc returns the bot permission an interaction needs
+type PermissionFunc func(interaction *discordgo.InteractionCreate) snitchv1.BotPermission
+
+// memberAllowed reports whether a member may use a permission granted to roleIDs.
+// Administrators always may; without any roles the permission falls back to Manage Server.
+func memberAllowed(member *discordgo.Member, roleIDs []string) bool {
+	if member.Permissions&discordgo.PermissionAdministrator != 0 {
+		return true
+	}
+	if len(roleIDs) == 0 {
+		return member.Permissions&discordgo.PermissionManageGuild != 0
+	}
+	return slices.ContainsFunc(member.Roles, func(roleID string) bool {
+		return slices.Contains(roleIDs, roleID)
+	})
+}
+
+// permissionRoles returns the roles a policy grants a permission to
+func permissionRoles(policy *snitchv1.PermissionPolicy, permission snitchv1.BotPermission) []string {
+	for _, rule := range policy.GetRules() {
+		if rule.Permission == permission {
+			return rule.RoleIds
+		}
+	}
+	return nil
+}
+
+// RequirePermission checks the invoking member's roles against the guild's permission policy before running next
+func RequirePermission(next slashcommand.SlashCommandHandlerFunc, client snitchv1connect.ConfigServiceClient, permissionFor PermissionFunc) slashcommand.SlashCommandHandlerFunc {
+	return func(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		slogger, ok := ctxutil.Value[*slog.Logger](ctx)
+		if !ok {
+			slogger = slog.Default()
+		}
+
+		deny := func(content string) {
+			if err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: content,
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			}); err != nil {
+				slogger.ErrorContext(ctx, "Couldn't Write Discord Response", "Error", err)
+			}
+		}
+
+		if interaction.Member == nil {
+			deny("This command can only be used in a server")
+			return
+		}
+
+		permission := permissionFor(interaction)
+
+		// Administrators skip the lookup so a broken policy can always be fixed
+		if interaction.Member.Permissions&discordgo.PermissionAdministrator != 0 {
+			next(ctx, session, interaction)
+			return
+		}
+
+		policyRequest := connect.NewRequest(&snitchv1.GetPermissionPolicyRequest{})
+		policyRequest.Header().Add("X-Server-ID", interaction.GuildID)
+		policyResponse, err := client.GetPermissionPolicy(ctx, policyRequest)
+		if err != nil {
+			slogger.ErrorContext(ctx, "Couldn't get permission policy", "Error", err)
+			deny("Couldn't check your permissions, try again later")
+			return
+		}
+
+		roleIDs := permissionRoles(policyResponse.Msg.Policy, permission)
+		if !memberAllowed(interaction.Member, roleIDs) {
+			slogger.InfoContext(ctx, "Permission denied", "Permission", permission, "Member Roles", interaction.Member.Roles)
+			deny("You are not allowed to use this command!")
+			return
+		}
+
+		next(ctx, session, interaction)
+	}
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestMemberAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		member  *discordgo.Member
+		roleIDs []string
+		allowed bool
+	}{
+		{"administrator", &discordgo.Member{Permissions: discordgo.PermissionAdministrator}, []string{"r1"}, true},
+		{"manage server without roles", &discordgo.Member{Permissions: discordgo.PermissionManageGuild}, nil, true},
+		{"no permissions without roles", &discordgo.Member{Roles: []string{"r1"}}, nil, false},
+		{"matching role", &discordgo.Member{Roles: []string{"r2", "r1"}}, []string{"r1"}, true},
+		{"other role", &discordgo.Member{Roles: []string{"r2"}}, []string{"r1"}, false},
+		{"manage server with roles", &discordgo.Member{Permissions: discordgo.PermissionManageGuild}, []string{"r1"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := memberAllowed(tt.member, tt.roleIDs); got != tt.allowed {
+			t.Errorf("%s: memberAllowed() = %v, expected %v", tt.name, got, tt.allowed)
+		}
+	}
+}
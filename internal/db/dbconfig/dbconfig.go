@@ -7,7 +7,7 @@ import (
 )
 
 type DbConfig struct {
-	DbDirPath, CertFilePath, KeyFilePath string
+	DbDirPath, CaCertFilePath, CertFilePath, KeyFilePath string
 }
 
 func FromEnv() (DbConfig, error) {
@@ -23,9 +23,10 @@ func FromEnv() (DbConfig, error) {
 	}
 
 	cfg := DbConfig{
-		DbDirPath:    get("DB_DIR_PATH"),
-		CertFilePath: get("CERT_FILE_PATH"),
-		KeyFilePath:  get("KEY_FILE_PATH"),
+		DbDirPath:      get("DB_DIR_PATH"),
+		CaCertFilePath: get("CA_CERT_FILE_PATH"),
+		CertFilePath:   get("CERT_FILE_PATH"),
+		KeyFilePath:    get("KEY_FILE_PATH"),
 	}
 
 	if len(missing) > 0 {
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE servers ADD COLUMN ban_policy TEXT NOT NULL DEFAULT 'announce' CHECK(ban_policy IN ('announce', 'approve', 'auto'));
+
+-- +goose Down
+ALTER TABLE servers DROP COLUMN ban_policy;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Servers were registered with a placeholder output channel; 0 marks it as not configured
+UPDATE servers SET output_channel = 0 WHERE output_channel = 69420;
+
+-- +goose Down
+UPDATE servers SET output_channel = 69420 WHERE output_channel = 0;
\ No newline at end of file
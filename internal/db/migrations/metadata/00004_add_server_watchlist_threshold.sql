@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE servers ADD COLUMN watchlist_threshold INTEGER NOT NULL DEFAULT 1 CHECK(watchlist_threshold >= 0);
+
+-- +goose Down
+ALTER TABLE servers DROP COLUMN watchlist_threshold;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL CHECK(length(name) <= 100 AND length(name) > 0),
+    key_hash TEXT NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TEXT
+) STRICT;
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS invites (
+    code TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(group_id),
+    created_by TEXT NOT NULL,
+    max_uses INTEGER NOT NULL DEFAULT 0 CHECK(max_uses >= 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TEXT
+) STRICT;
+
+CREATE INDEX IF NOT EXISTS idx_invites_group_id ON invites(group_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_invites_group_id;
+DROP TABLE IF EXISTS invites;
\ No newline at end of file
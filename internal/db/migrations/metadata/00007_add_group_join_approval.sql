@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE groups ADD COLUMN join_requires_approval INTEGER NOT NULL DEFAULT 0 CHECK(join_requires_approval IN (0, 1));
+
+CREATE TABLE IF NOT EXISTS join_requests (
+    request_id TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(group_id),
+    server_id TEXT NOT NULL,
+    requested_by TEXT NOT NULL,
+    invite_code TEXT REFERENCES invites(code),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'approved', 'denied')),
+    decided_by TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    decided_at TEXT
+) STRICT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_join_requests_pending_server ON join_requests(server_id) WHERE status = 'pending';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_join_requests_pending_server;
+DROP TABLE IF EXISTS join_requests;
+ALTER TABLE groups DROP COLUMN join_requires_approval;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Groups created before owners were tracked are owned by their first server
+ALTER TABLE groups ADD COLUMN owner_server_id TEXT;
+UPDATE groups SET owner_server_id = (
+    SELECT server_id FROM servers WHERE servers.group_id = groups.group_id ORDER BY servers.rowid LIMIT 1
+);
+
+-- Deleted groups are kept until the grace period ends so the deletion can be undone
+ALTER TABLE groups ADD COLUMN deleted_at TEXT;
+
+-- +goose Down
+ALTER TABLE groups DROP COLUMN deleted_at;
+ALTER TABLE groups DROP COLUMN owner_server_id;
\ No newline at end of file
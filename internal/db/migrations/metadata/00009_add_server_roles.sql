@@ -0,0 +1,10 @@
+-- +goose Up
+-- permission_level now holds the server's GroupRole: 1 observer, 2 member, 3 admin, 4 owner.
+-- Servers registered so far could do everything, so they keep that as admins.
+UPDATE servers SET permission_level = CASE
+    WHEN server_id = (SELECT owner_server_id FROM groups WHERE groups.group_id = servers.group_id) THEN 4
+    ELSE 3
+END;
+
+-- +goose Down
+UPDATE servers SET permission_level = 777;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Discord roles of a guild allowed to use a group of bot commands. Keyed by guild rather than
+-- group membership so the policy survives leaving and rejoining groups.
+CREATE TABLE IF NOT EXISTS permission_roles (
+    server_id TEXT NOT NULL,
+    permission TEXT NOT NULL CHECK(permission IN ('create_reports', 'list_reports', 'delete_reports', 'configure_group')),
+    role_id TEXT NOT NULL,
+    PRIMARY KEY (server_id, permission, role_id)
+) STRICT;
+
+-- +goose Down
+DROP TABLE IF EXISTS permission_roles;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- +goose Up
+-- A server can now wait on join requests to several groups at once, but only one per group
+DROP INDEX IF EXISTS idx_join_requests_pending_server;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_join_requests_pending_server_group ON join_requests(server_id, group_id) WHERE status = 'pending';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_join_requests_pending_server_group;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_join_requests_pending_server ON join_requests(server_id) WHERE status = 'pending';
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE reports ADD COLUMN status TEXT NOT NULL DEFAULT 'open' CHECK(status IN ('open', 'under_review', 'resolved', 'dismissed'));
+ALTER TABLE reports ADD COLUMN updated_at TEXT;
+
+-- Indexes for performance
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports(status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reports_status;
+ALTER TABLE reports DROP COLUMN updated_at;
+ALTER TABLE reports DROP COLUMN status;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS report_evidence (
+    evidence_id INTEGER PRIMARY KEY,
+    report_id INTEGER NOT NULL REFERENCES reports(report_id) ON DELETE CASCADE,
+    url TEXT NOT NULL CHECK(length(url) <= 500 AND length(url) > 0),
+    content_type TEXT CHECK(content_type IS NULL OR length(content_type) <= 100),
+    message_link TEXT CHECK(message_link IS NULL OR length(message_link) <= 500),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+-- Indexes for performance
+CREATE INDEX IF NOT EXISTS idx_report_evidence_report_id ON report_evidence(report_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_report_evidence_report_id;
+DROP TABLE IF EXISTS report_evidence;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE report_evidence ADD COLUMN message_content TEXT CHECK(message_content IS NULL OR length(message_content) <= 4000);
+ALTER TABLE report_evidence ADD COLUMN message_author_id TEXT;
+ALTER TABLE report_evidence ADD COLUMN channel_id TEXT;
+
+-- +goose Down
+ALTER TABLE report_evidence DROP COLUMN channel_id;
+ALTER TABLE report_evidence DROP COLUMN message_author_id;
+ALTER TABLE report_evidence DROP COLUMN message_content;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE reports ADD COLUMN category TEXT CHECK(category IS NULL OR length(category) <= 100);
+
+-- +goose Down
+ALTER TABLE reports DROP COLUMN category;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS bans (
+    ban_id INTEGER PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id),
+    server_id TEXT NOT NULL REFERENCES servers(server_id),
+    reason TEXT CHECK(reason IS NULL OR length(reason) <= 512),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+-- Indexes for performance
+CREATE INDEX IF NOT EXISTS idx_bans_user_id ON bans(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bans_user_id;
+DROP TABLE IF EXISTS bans;
\ No newline at end of file
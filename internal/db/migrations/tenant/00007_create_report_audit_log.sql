@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS report_audit_log (
+    audit_id INTEGER PRIMARY KEY,
+    report_id INTEGER NOT NULL,
+    action TEXT NOT NULL CHECK(action IN ('status_changed', 'deleted')),
+    actor_server_id TEXT NOT NULL,
+    actor_user_id TEXT NOT NULL,
+    origin_server_id TEXT NOT NULL,
+    reported_user_id TEXT NOT NULL,
+    details TEXT CHECK(details IS NULL OR length(details) <= 500),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+-- Indexes for performance
+CREATE INDEX IF NOT EXISTS idx_report_audit_log_report_id ON report_audit_log(report_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_report_audit_log_report_id;
+DROP TABLE IF EXISTS report_audit_log;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS events (
+    sequence INTEGER PRIMARY KEY AUTOINCREMENT,
+    event_type INTEGER NOT NULL,
+    payload BLOB NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+-- +goose Down
+DROP TABLE IF EXISTS events;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE events ADD COLUMN idempotency_key TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_idempotency_key ON events(idempotency_key);
+
+CREATE TABLE IF NOT EXISTS event_outbox (
+    outbox_id INTEGER PRIMARY KEY,
+    payload BLOB NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+-- +goose Down
+DROP TABLE IF EXISTS event_outbox;
+DROP INDEX IF EXISTS idx_events_idempotency_key;
+ALTER TABLE events DROP COLUMN idempotency_key;
\ No newline at end of file
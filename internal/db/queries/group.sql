@@ -7,27 +7,49 @@ INSERT OR IGNORE INTO users (user_id) VALUES (?);
 INSERT OR IGNORE INTO servers (server_id) VALUES (?);
 
 -- name: CreateReport :one
-INSERT INTO reports (report_text, reporter_id, reported_user_id, origin_server_id) 
-VALUES (?, ?, ?, ?) RETURNING report_id;
+INSERT INTO reports (report_text, reporter_id, reported_user_id, origin_server_id, category) 
+VALUES (?, ?, ?, ?, ?) RETURNING report_id;
 
 -- name: GetReport :one
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
+SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at, status, updated_at, category 
 FROM reports WHERE report_id = ?;
 
--- name: ListReports :many
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
-FROM reports
-ORDER BY created_at DESC;
-
--- name: ListReportsByUser :many
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
-FROM reports 
-WHERE reported_user_id = ?
-ORDER BY created_at DESC;
+-- name: UpdateReportStatus :execrows
+UPDATE reports SET status = sqlc.arg(status), updated_at = CURRENT_TIMESTAMP
+WHERE report_id = sqlc.arg(report_id) AND status = sqlc.arg(expected_status);
 
 -- name: DeleteReport :execrows
 DELETE FROM reports WHERE report_id = ?;
 
+-- name: GetUserReportSummary :one
+SELECT COUNT(*) AS report_count,
+       COUNT(CASE WHEN status = 'open' THEN 1 END) AS open_report_count,
+       COUNT(DISTINCT origin_server_id) AS server_count,
+       CAST(COALESCE(MAX(created_at), '') AS TEXT) AS last_reported_at
+FROM reports WHERE reported_user_id = ?;
+
+-- Report evidence queries
+-- name: CreateReportEvidence :exec
+INSERT INTO report_evidence (report_id, url, content_type, message_link, message_content, message_author_id, channel_id) 
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListReportEvidence :many
+SELECT evidence_id, report_id, url, content_type, message_link, created_at, message_content, message_author_id, channel_id 
+FROM report_evidence 
+WHERE report_id = ? 
+ORDER BY evidence_id;
+
+-- name: DeleteReportEvidence :exec
+DELETE FROM report_evidence WHERE report_id = ?;
+
+-- Ban queries
+-- name: CreateBan :one
+INSERT INTO bans (user_id, server_id, reason) 
+VALUES (?, ?, ?) RETURNING ban_id;
+
+-- name: CountUserBans :one
+SELECT COUNT(*) FROM bans WHERE user_id = ?;
+
 -- User history queries
 -- name: CreateUserHistory :one
 INSERT INTO user_history (user_id, server_id, action, reason, evidence_url) 
@@ -37,4 +59,52 @@ VALUES (?, ?, ?, ?, ?) RETURNING history_id;
 SELECT history_id, user_id, server_id, action, reason, evidence_url, created_at 
 FROM user_history 
 WHERE user_id = ? 
-ORDER BY created_at DESC;
\ No newline at end of file
+ORDER BY created_at DESC;
+
+-- Report audit queries
+-- name: CreateReportAuditEntry :exec
+INSERT INTO report_audit_log (report_id, action, actor_server_id, actor_user_id, origin_server_id, reported_user_id, details) 
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListReportAuditEntries :many
+SELECT audit_id, report_id, action, actor_server_id, actor_user_id, origin_server_id, reported_user_id, details, created_at 
+FROM report_audit_log 
+ORDER BY audit_id DESC 
+LIMIT ?;
+
+
+-- Event log queries
+-- name: AppendEvent :one
+INSERT INTO events (event_type, payload, idempotency_key) 
+VALUES (?, ?, ?) 
+ON CONFLICT(idempotency_key) DO NOTHING 
+RETURNING sequence;
+
+-- name: GetEventSequenceByKey :one
+SELECT sequence FROM events WHERE idempotency_key = ?;
+
+-- name: ListEventsAfter :many
+SELECT sequence, event_type, payload, created_at, idempotency_key 
+FROM events 
+WHERE sequence > ? 
+ORDER BY sequence 
+LIMIT ?;
+
+-- name: GetLatestEventSequence :one
+SELECT CAST(COALESCE(MAX(sequence), 0) AS INTEGER) AS sequence FROM events;
+
+-- name: PruneEvents :exec
+DELETE FROM events WHERE sequence <= ?;
+
+-- Event outbox queries
+-- name: CreateOutboxEvent :exec
+INSERT INTO event_outbox (payload) VALUES (?);
+
+-- name: ListOutboxEvents :many
+SELECT outbox_id, payload, created_at 
+FROM event_outbox 
+ORDER BY outbox_id 
+LIMIT ?;
+
+-- name: DeleteOutboxEvent :exec
+DELETE FROM event_outbox WHERE outbox_id = ?;
\ No newline at end of file
@@ -1,13 +1,146 @@
 -- Metadata database queries (groups and servers)
 
 -- name: CreateGroup :exec
-INSERT INTO groups (group_id, group_name) VALUES (?, ?);
+INSERT INTO groups (group_id, group_name, owner_server_id) VALUES (?, ?, ?);
 
--- name: FindGroupByServer :one
-SELECT group_id FROM servers WHERE server_id = ?;
+-- name: GetGroup :one
+SELECT group_id, group_name, join_requires_approval, owner_server_id, deleted_at FROM groups WHERE group_id = ?;
+
+-- name: GetGroupJoinApproval :one
+SELECT join_requires_approval FROM groups WHERE group_id = ?;
+
+-- name: UpdateGroupJoinApproval :execrows
+UPDATE groups SET join_requires_approval = ? WHERE group_id = ?;
+
+-- name: ListServerGroups :many
+SELECT servers.group_id, groups.group_name, servers.permission_level FROM servers
+JOIN groups ON groups.group_id = servers.group_id
+WHERE servers.server_id = ? AND groups.deleted_at IS NULL
+ORDER BY groups.group_name, servers.group_id;
 
 -- name: AddServerToGroup :exec
-INSERT INTO servers (server_id, output_channel, group_id, permission_level) VALUES (?, ?, ?, ?);
+INSERT INTO servers (server_id, output_channel, group_id, permission_level, ban_policy, watchlist_threshold) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: RemoveServerFromGroup :execrows
+DELETE FROM servers WHERE server_id = ? AND group_id = ?;
 
 -- name: ListServers :many
-SELECT server_id, group_id FROM servers WHERE group_id = ?;
\ No newline at end of file
+SELECT server_id, group_id, permission_level FROM servers WHERE group_id = ? ORDER BY permission_level DESC, server_id;
+
+-- name: GetServerRole :one
+SELECT permission_level FROM servers WHERE server_id = ? AND group_id = ?;
+
+-- name: UpdateServerRole :execrows
+UPDATE servers SET permission_level = ? WHERE server_id = ? AND group_id = ?;
+
+-- name: UpdateGroupOwner :execrows
+UPDATE groups SET owner_server_id = ? WHERE group_id = ?;
+
+-- name: GetServerConfig :one
+SELECT ban_policy, output_channel, watchlist_threshold FROM servers WHERE server_id = ?;
+
+-- name: UpdateServerBanPolicy :execrows
+UPDATE servers SET ban_policy = ? WHERE server_id = ?;
+
+-- name: UpdateServerOutputChannel :execrows
+UPDATE servers SET output_channel = ? WHERE server_id = ?;
+
+-- name: UpdateServerWatchlistThreshold :execrows
+UPDATE servers SET watchlist_threshold = ? WHERE server_id = ?;
+
+-- API key queries
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (key_id, name, key_hash) VALUES (?, ?, ?);
+
+-- name: GetAPIKey :one
+SELECT key_id, name, key_hash, created_at, revoked_at FROM api_keys WHERE key_id = ?;
+
+-- name: ListAPIKeys :many
+SELECT key_id, name, key_hash, created_at, revoked_at FROM api_keys ORDER BY created_at, key_id;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE key_id = ? AND revoked_at IS NULL;
+
+-- Invite queries
+-- name: CreateInvite :exec
+INSERT INTO invites (code, group_id, created_by, max_uses, expires_at) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetInvite :one
+SELECT code, group_id, created_by, max_uses, uses, expires_at, created_at, revoked_at FROM invites WHERE code = ?;
+
+-- name: ListInvites :many
+SELECT code, group_id, created_by, max_uses, uses, expires_at, created_at, revoked_at FROM invites WHERE group_id = ? ORDER BY created_at, code;
+
+-- name: RevokeInvite :execrows
+UPDATE invites SET revoked_at = CURRENT_TIMESTAMP WHERE code = ? AND group_id = ? AND revoked_at IS NULL;
+
+-- name: ConsumeInvite :one
+UPDATE invites SET uses = uses + 1
+WHERE code = ?
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+  AND (max_uses = 0 OR uses < max_uses)
+  AND group_id IN (SELECT group_id FROM groups WHERE deleted_at IS NULL)
+RETURNING group_id;
+
+-- Join request queries
+-- name: CreateJoinRequest :exec
+INSERT INTO join_requests (request_id, group_id, server_id, requested_by, invite_code) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetJoinRequest :one
+SELECT request_id, group_id, server_id, requested_by, invite_code, status, decided_by, created_at, decided_at FROM join_requests WHERE request_id = ?;
+
+-- name: CountPendingJoinRequests :one
+SELECT COUNT(*) FROM join_requests WHERE server_id = ? AND group_id = ? AND status = 'pending';
+
+-- name: DecideJoinRequest :one
+UPDATE join_requests SET status = ?, decided_by = ?, decided_at = CURRENT_TIMESTAMP
+WHERE request_id = ? AND group_id = ? AND status = 'pending'
+RETURNING server_id;
+
+-- name: DeletePendingJoinRequest :one
+DELETE FROM join_requests WHERE request_id = ? AND group_id = ? AND status = 'pending'
+RETURNING invite_code;
+
+-- name: ReleaseInvite :exec
+UPDATE invites SET uses = uses - 1 WHERE code = ? AND uses > 0;
+
+-- Group deletion queries
+-- name: SoftDeleteGroup :execrows
+UPDATE groups SET deleted_at = CURRENT_TIMESTAMP WHERE group_id = ? AND deleted_at IS NULL;
+
+-- name: FindDeletedGroupByOwner :one
+SELECT group_id FROM groups
+WHERE owner_server_id = ? AND deleted_at IS NOT NULL AND deleted_at > ?
+ORDER BY deleted_at DESC
+LIMIT 1;
+
+-- name: RestoreGroup :execrows
+UPDATE groups SET deleted_at = NULL WHERE group_id = ? AND deleted_at IS NOT NULL;
+
+-- name: ListActiveGroupIDs :many
+SELECT group_id FROM groups WHERE deleted_at IS NULL ORDER BY group_id;
+
+-- name: ListPurgeableGroups :many
+SELECT group_id FROM groups WHERE deleted_at IS NOT NULL AND deleted_at <= ?;
+
+-- name: DeleteGroupJoinRequests :exec
+DELETE FROM join_requests WHERE group_id = ?;
+
+-- name: DeleteGroupInvites :exec
+DELETE FROM invites WHERE group_id = ?;
+
+-- name: DeleteGroupServers :exec
+DELETE FROM servers WHERE group_id = ?;
+
+-- name: PurgeGroup :execrows
+DELETE FROM groups WHERE group_id = ? AND deleted_at IS NOT NULL AND deleted_at <= ?;
+
+-- name: ListPermissionRoles :many
+SELECT permission, role_id FROM permission_roles WHERE server_id = ? ORDER BY permission, role_id;
+
+-- name: DeletePermissionRoles :exec
+DELETE FROM permission_roles WHERE server_id = ? AND permission = ?;
+
+-- name: AddPermissionRole :exec
+INSERT OR IGNORE INTO permission_roles (server_id, permission, role_id) VALUES (?, ?, ?);
\ No newline at end of file
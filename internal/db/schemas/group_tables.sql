@@ -12,7 +12,10 @@ CREATE TABLE IF NOT EXISTS reports (
     reporter_id TEXT NOT NULL REFERENCES users(user_id),
     reported_user_id TEXT NOT NULL REFERENCES users(user_id),
     origin_server_id TEXT NOT NULL REFERENCES servers(server_id),
-    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    status TEXT NOT NULL DEFAULT 'open' CHECK(status IN ('open', 'under_review', 'resolved', 'dismissed')),
+    updated_at TEXT,
+    category TEXT CHECK(category IS NULL OR length(category) <= 100)
 ) STRICT;
 
 CREATE TABLE IF NOT EXISTS user_history (
@@ -27,6 +30,52 @@ CREATE TABLE IF NOT EXISTS user_history (
     FOREIGN KEY (server_id) REFERENCES servers(server_id)
 ) STRICT;
 
+CREATE TABLE IF NOT EXISTS report_evidence (
+    evidence_id INTEGER PRIMARY KEY,
+    report_id INTEGER NOT NULL REFERENCES reports(report_id) ON DELETE CASCADE,
+    url TEXT NOT NULL CHECK(length(url) <= 500 AND length(url) > 0),
+    content_type TEXT CHECK(content_type IS NULL OR length(content_type) <= 100),
+    message_link TEXT CHECK(message_link IS NULL OR length(message_link) <= 500),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    message_content TEXT CHECK(message_content IS NULL OR length(message_content) <= 4000),
+    message_author_id TEXT,
+    channel_id TEXT
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS bans (
+    ban_id INTEGER PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id),
+    server_id TEXT NOT NULL REFERENCES servers(server_id),
+    reason TEXT CHECK(reason IS NULL OR length(reason) <= 512),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS report_audit_log (
+    audit_id INTEGER PRIMARY KEY,
+    report_id INTEGER NOT NULL,
+    action TEXT NOT NULL CHECK(action IN ('status_changed', 'deleted')),
+    actor_server_id TEXT NOT NULL,
+    actor_user_id TEXT NOT NULL,
+    origin_server_id TEXT NOT NULL,
+    reported_user_id TEXT NOT NULL,
+    details TEXT CHECK(details IS NULL OR length(details) <= 500),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS events (
+    sequence INTEGER PRIMARY KEY AUTOINCREMENT,
+    event_type INTEGER NOT NULL,
+    payload BLOB NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    idempotency_key TEXT
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS event_outbox (
+    outbox_id INTEGER PRIMARY KEY,
+    payload BLOB NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP
+) STRICT;
+
 -- Indexes for performance
 CREATE INDEX IF NOT EXISTS idx_reports_reporter_id ON reports(reporter_id);
 CREATE INDEX IF NOT EXISTS idx_reports_reported_user_id ON reports(reported_user_id);
@@ -36,4 +85,9 @@ CREATE INDEX IF NOT EXISTS idx_user_history_user_id ON user_history(user_id);
 CREATE INDEX IF NOT EXISTS idx_user_history_server_id ON user_history(server_id);
 CREATE INDEX IF NOT EXISTS idx_user_history_created_at ON user_history(created_at);
 CREATE INDEX IF NOT EXISTS idx_reports_user_date ON reports(reported_user_id, created_at);
-CREATE INDEX IF NOT EXISTS idx_reports_server_date ON reports(origin_server_id, created_at);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_reports_server_date ON reports(origin_server_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports(status);
+CREATE INDEX IF NOT EXISTS idx_report_evidence_report_id ON report_evidence(report_id);
+CREATE INDEX IF NOT EXISTS idx_bans_user_id ON bans(user_id);
+CREATE INDEX IF NOT EXISTS idx_report_audit_log_report_id ON report_audit_log(report_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_idempotency_key ON events(idempotency_key);
\ No newline at end of file
@@ -1,15 +1,65 @@
 CREATE TABLE IF NOT EXISTS groups (
     group_id TEXT PRIMARY KEY,
-    group_name TEXT NOT NULL
+    group_name TEXT NOT NULL,
+    join_requires_approval INTEGER NOT NULL DEFAULT 0 CHECK(join_requires_approval IN (0, 1)),
+    owner_server_id TEXT,
+    -- Set while a deleted group waits out its grace period before being purged
+    deleted_at TEXT
 ) STRICT;
 
 CREATE TABLE IF NOT EXISTS servers (
     server_id TEXT NOT NULL,
     output_channel INTEGER NOT NULL,
     group_id TEXT NOT NULL REFERENCES groups(group_id),
+    -- The server's GroupRole: 1 observer, 2 member, 3 admin, 4 owner
     permission_level INTEGER NOT NULL,
+    ban_policy TEXT NOT NULL DEFAULT 'announce' CHECK(ban_policy IN ('announce', 'approve', 'auto')),
+    watchlist_threshold INTEGER NOT NULL DEFAULT 1 CHECK(watchlist_threshold >= 0),
     PRIMARY KEY (server_id, group_id)
 ) STRICT;
 
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL CHECK(length(name) <= 100 AND length(name) > 0),
+    key_hash TEXT NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TEXT
+) STRICT;
+
+-- max_uses of 0 means unlimited, a NULL expires_at never expires
+CREATE TABLE IF NOT EXISTS invites (
+    code TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(group_id),
+    created_by TEXT NOT NULL,
+    max_uses INTEGER NOT NULL DEFAULT 0 CHECK(max_uses >= 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TEXT
+) STRICT;
+
+-- Servers joining a group that requires approval wait here until a member server decides
+CREATE TABLE IF NOT EXISTS join_requests (
+    request_id TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(group_id),
+    server_id TEXT NOT NULL,
+    requested_by TEXT NOT NULL,
+    invite_code TEXT REFERENCES invites(code),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'approved', 'denied')),
+    decided_by TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    decided_at TEXT
+) STRICT;
+
+-- Discord roles of a guild allowed to use a group of bot commands
+CREATE TABLE IF NOT EXISTS permission_roles (
+    server_id TEXT NOT NULL,
+    permission TEXT NOT NULL CHECK(permission IN ('create_reports', 'list_reports', 'delete_reports', 'configure_group')),
+    role_id TEXT NOT NULL,
+    PRIMARY KEY (server_id, permission, role_id)
+) STRICT;
+
 -- Indexes for performance
-CREATE INDEX IF NOT EXISTS idx_servers_group_id ON servers(group_id);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_servers_group_id ON servers(group_id);
+CREATE INDEX IF NOT EXISTS idx_invites_group_id ON invites(group_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_join_requests_pending_server_group ON join_requests(server_id, group_id) WHERE status = 'pending';
\ No newline at end of file
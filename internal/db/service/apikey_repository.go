@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"snitch/internal/db/sqlc/gen/metadata"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// APIKeyRepository handles API key operations
+type APIKeyRepository struct {
+	service *DatabaseService
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository
+func NewAPIKeyRepository(service *DatabaseService) *APIKeyRepository {
+	return &APIKeyRepository{
+		service: service,
+	}
+}
+
+// apiKeyFromRow converts an api_keys row into its API representation, leaving out the hash
+func apiKeyFromRow(row metadata.ApiKey) *snitchv1.APIKey {
+	key := &snitchv1.APIKey{
+		KeyId:     row.KeyID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt.String,
+	}
+	if row.RevokedAt.Valid {
+		key.RevokedAt = &row.RevokedAt.String
+	}
+	return key
+}
+
+// CreateAPIKey stores the hash of a newly issued API key using sqlc
+func (r *APIKeyRepository) CreateAPIKey(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCreateAPIKeyRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCreateAPIKeyResponse], error) {
+	if req.Msg.KeyId == "" || req.Msg.Name == "" || req.Msg.KeyHash == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key ID, name and key hash are required"))
+	}
+
+	queries := metadata.New(r.service.metadataDB)
+
+	if err := queries.CreateAPIKey(ctx, metadata.CreateAPIKeyParams{
+		KeyID:   req.Msg.KeyId,
+		Name:    req.Msg.Name,
+		KeyHash: req.Msg.KeyHash,
+	}); err != nil {
+		r.service.logger.Error("Failed to create API key", "key_id", req.Msg.KeyId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create API key: %w", err))
+	}
+
+	row, err := queries.GetAPIKey(ctx, req.Msg.KeyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get created API key: %w", err))
+	}
+
+	r.service.logger.Info("Created API key", "key_id", req.Msg.KeyId, "name", req.Msg.Name)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceCreateAPIKeyResponse{Key: apiKeyFromRow(row)}), nil
+}
+
+// GetAPIKey retrieves an API key and its hash using sqlc
+func (r *APIKeyRepository) GetAPIKey(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetAPIKeyRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetAPIKeyResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	row, err := queries.GetAPIKey(ctx, req.Msg.KeyId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("API key not found: %s", req.Msg.KeyId))
+		}
+		r.service.logger.Error("Failed to get API key", "key_id", req.Msg.KeyId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get API key: %w", err))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceGetAPIKeyResponse{
+		Key:     apiKeyFromRow(row),
+		KeyHash: row.KeyHash,
+	}), nil
+}
+
+// ListAPIKeys retrieves every API key, including revoked ones, using sqlc
+func (r *APIKeyRepository) ListAPIKeys(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListAPIKeysRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListAPIKeysResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	rows, err := queries.ListAPIKeys(ctx)
+	if err != nil {
+		r.service.logger.Error("Failed to list API keys", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list API keys: %w", err))
+	}
+
+	keys := make([]*snitchv1.APIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, apiKeyFromRow(row))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListAPIKeysResponse{Keys: keys}), nil
+}
+
+// RevokeAPIKey marks an API key as revoked using sqlc
+func (r *APIKeyRepository) RevokeAPIKey(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceRevokeAPIKeyRequest],
+) (*connect.Response[snitchv1.DatabaseServiceRevokeAPIKeyResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	rowsAffected, err := queries.RevokeAPIKey(ctx, req.Msg.KeyId)
+	if err != nil {
+		r.service.logger.Error("Failed to revoke API key", "key_id", req.Msg.KeyId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke API key: %w", err))
+	}
+	if rowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("API key not found or already revoked: %s", req.Msg.KeyId))
+	}
+
+	row, err := queries.GetAPIKey(ctx, req.Msg.KeyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get revoked API key: %w", err))
+	}
+
+	r.service.logger.Info("Revoked API key", "key_id", req.Msg.KeyId)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceRevokeAPIKeyResponse{Key: apiKeyFromRow(row)}), nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"snitch/internal/db/sqlc/gen/groupdb"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// BanRepository handles ban operations
+type BanRepository struct {
+	service *DatabaseService
+}
+
+// NewBanRepository creates a new BanRepository
+func NewBanRepository(service *DatabaseService) *BanRepository {
+	return &BanRepository{
+		service: service,
+	}
+}
+
+// CreateBan records a ban in the group database using sqlc
+func (r *BanRepository) CreateBan(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCreateBanRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCreateBanResponse], error) {
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	queries := groupdb.New(db)
+
+	// Ensure user and server exist using sqlc
+	if err := queries.EnsureUserExists(ctx, req.Msg.UserId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to ensure user exists: %w", err))
+	}
+	if err := queries.EnsureServerExists(ctx, req.Msg.ServerId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to ensure server exists: %w", err))
+	}
+
+	banID, err := queries.CreateBan(ctx, groupdb.CreateBanParams{
+		UserID:   req.Msg.UserId,
+		ServerID: req.Msg.ServerId,
+		Reason:   nullString(req.Msg.Reason),
+	})
+	if err != nil {
+		r.service.logger.Error("Failed to create ban", "group_id", req.Msg.GroupId, "user_id", req.Msg.UserId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create ban: %w", err))
+	}
+
+	r.service.logger.Info("Created ban",
+		"group_id", req.Msg.GroupId,
+		"user_id", req.Msg.UserId,
+		"server_id", req.Msg.ServerId,
+		"ban_id", banID)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceCreateBanResponse{BanId: banID}), nil
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"snitch/internal/db/migrations"
 	"snitch/internal/db/sqlc/gen/groupdb"
@@ -26,6 +27,12 @@ const (
 	tenantMigrationsPath   = "tenant"
 )
 
+// DefaultGroupDeletionGracePeriod is how long a deleted group can be restored before it is purged
+const DefaultGroupDeletionGracePeriod = 7 * 24 * time.Hour
+
+// archiveDirName is the directory under the db directory that purged tenant databases are moved to
+const archiveDirName = "archive"
+
 type DatabaseService struct {
 	metadataDB      *sql.DB
 	metadataQueries *metadata.Queries
@@ -35,11 +42,19 @@ type DatabaseService struct {
 	dbDir           string
 	logger          *slog.Logger
 
+	// GroupDeletionGracePeriod is how long deleted groups are kept before PurgeDeletedGroups removes them
+	GroupDeletionGracePeriod time.Duration
+
 	// Repository pattern
-	GroupRepository  *GroupRepository
-	ReportRepository *ReportRepository
-	UserRepository   *UserRepository
-	ServerRepository *ServerRepository
+	GroupRepository      *GroupRepository
+	ReportRepository     *ReportRepository
+	UserRepository       *UserRepository
+	ServerRepository     *ServerRepository
+	BanRepository        *BanRepository
+	EventRepository      *EventRepository
+	APIKeyRepository     *APIKeyRepository
+	InviteRepository     *InviteRepository
+	PermissionRepository *PermissionRepository
 }
 
 func NewDatabaseService(ctx context.Context, dbDir string, logger *slog.Logger) (*DatabaseService, error) {
@@ -78,6 +93,8 @@ func NewDatabaseService(ctx context.Context, dbDir string, logger *slog.Logger)
 		groupQueries:    make(map[string]*groupdb.Queries),
 		dbDir:           dbDir,
 		logger:          logger,
+
+		GroupDeletionGracePeriod: DefaultGroupDeletionGracePeriod,
 	}
 
 	// Initialize repositories
@@ -85,6 +102,11 @@ func NewDatabaseService(ctx context.Context, dbDir string, logger *slog.Logger)
 	service.ReportRepository = NewReportRepository(service)
 	service.UserRepository = NewUserRepository(service)
 	service.ServerRepository = NewServerRepository(service)
+	service.BanRepository = NewBanRepository(service)
+	service.EventRepository = NewEventRepository(service)
+	service.APIKeyRepository = NewAPIKeyRepository(service)
+	service.InviteRepository = NewInviteRepository(service)
+	service.PermissionRepository = NewPermissionRepository(service)
 
 	return service, nil
 }
@@ -174,11 +196,34 @@ func (s *DatabaseService) getGroupDB(_ context.Context, groupID string) (*sql.DB
 	return nil, fmt.Errorf("group database not found for group %s", groupID)
 }
 
+// openGroupDB returns a group database, opening its file when it exists but isn't open yet. Unlike createGroupDB it
+// never creates the file, so it can't bring back the database of a group archived meanwhile.
+func (s *DatabaseService) openGroupDB(ctx context.Context, groupID string) (*sql.DB, error) {
+	if db, err := s.getGroupDB(ctx, groupID); err == nil {
+		return db, nil
+	}
+
+	// The file is checked under the lock archiveGroupDB holds while moving it away
+	s.groupDBMutex.Lock()
+	defer s.groupDBMutex.Unlock()
+
+	if _, err := os.Stat(filepath.Join(s.dbDir, fmt.Sprintf("group_%s.db", groupID))); err != nil {
+		return nil, fmt.Errorf("group database not found for group %s: %w", groupID, err)
+	}
+	return s.loadGroupDB(ctx, groupID)
+}
+
 // createGroupDB explicitly creates a new group database
 func (s *DatabaseService) createGroupDB(ctx context.Context, groupID string) (*sql.DB, error) {
 	s.groupDBMutex.Lock()
 	defer s.groupDBMutex.Unlock()
 
+	return s.loadGroupDB(ctx, groupID)
+}
+
+// loadGroupDB opens a group database, creating its file if needed, and migrates it
+// Note: this method assumes groupDBMutex is already held by the caller
+func (s *DatabaseService) loadGroupDB(ctx context.Context, groupID string) (*sql.DB, error) {
 	// Check if it already exists
 	if db, exists := s.groupDBs[groupID]; exists {
 		return db, nil
@@ -214,6 +259,43 @@ func (s *DatabaseService) createGroupDB(ctx context.Context, groupID string) (*s
 	return db, nil
 }
 
+// archiveGroupDB closes a group's database and moves its files, including WAL and SHM siblings, into the archive directory
+func (s *DatabaseService) archiveGroupDB(groupID string) error {
+	s.groupDBMutex.Lock()
+	defer s.groupDBMutex.Unlock()
+
+	if db, exists := s.groupDBs[groupID]; exists {
+		delete(s.groupDBs, groupID)
+		delete(s.groupQueries, groupID)
+		if err := db.Close(); err != nil {
+			s.logger.Warn("Failed to close group database before archiving", "group_id", groupID, "error", err)
+		}
+	}
+
+	archiveDir := filepath.Join(s.dbDir, archiveDirName)
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	// Suffix archived files with the purge time so a reused group ID cannot overwrite them
+	groupFile := fmt.Sprintf("group_%s.db", groupID)
+	archivedFile := fmt.Sprintf("group_%s.%d.db", groupID, time.Now().Unix())
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		src := filepath.Join(s.dbDir, groupFile+suffix)
+		if err := os.Rename(src, filepath.Join(archiveDir, archivedFile+suffix)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to archive %s: %w", src, err)
+		}
+	}
+
+	s.logger.Info("Archived group database", "group_id", groupID, "archive", filepath.Join(archiveDir, archivedFile))
+	return nil
+}
+
+// PurgeDeletedGroups removes groups whose deletion grace period has ended
+func (s *DatabaseService) PurgeDeletedGroups(ctx context.Context) (int, error) {
+	return s.GroupRepository.PurgeDeletedGroups(ctx)
+}
+
 // runTenantMigrations applies tenant database migrations using goose
 func (s *DatabaseService) runTenantMigrations(ctx context.Context, db *sql.DB, groupID string) error {
 	// Set goose to use the embedded migration files
@@ -293,6 +375,14 @@ func (s *DatabaseService) CreateGroupDatabase(ctx context.Context, req *connect.
 	return s.GroupRepository.CreateGroupDatabase(ctx, req)
 }
 
+func (s *DatabaseService) DeleteGroup(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceDeleteGroupRequest]) (*connect.Response[snitchv1.DatabaseServiceDeleteGroupResponse], error) {
+	return s.GroupRepository.DeleteGroup(ctx, req)
+}
+
+func (s *DatabaseService) RestoreGroup(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceRestoreGroupRequest]) (*connect.Response[snitchv1.DatabaseServiceRestoreGroupResponse], error) {
+	return s.GroupRepository.RestoreGroup(ctx, req)
+}
+
 // Report operations
 func (s *DatabaseService) CreateReport(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCreateReportRequest]) (*connect.Response[snitchv1.DatabaseServiceCreateReportResponse], error) {
 	return s.ReportRepository.CreateReport(ctx, req)
@@ -310,6 +400,14 @@ func (s *DatabaseService) DeleteReport(ctx context.Context, req *connect.Request
 	return s.ReportRepository.DeleteReport(ctx, req)
 }
 
+func (s *DatabaseService) UpdateReportStatus(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceUpdateReportStatusRequest]) (*connect.Response[snitchv1.DatabaseServiceUpdateReportStatusResponse], error) {
+	return s.ReportRepository.UpdateReportStatus(ctx, req)
+}
+
+func (s *DatabaseService) ListReportAuditLog(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListReportAuditLogRequest]) (*connect.Response[snitchv1.DatabaseServiceListReportAuditLogResponse], error) {
+	return s.ReportRepository.ListReportAuditLog(ctx, req)
+}
+
 // User operations
 func (s *DatabaseService) CreateUserHistory(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCreateUserHistoryRequest]) (*connect.Response[snitchv1.DatabaseServiceCreateUserHistoryResponse], error) {
 	return s.UserRepository.CreateUserHistory(ctx, req)
@@ -319,6 +417,36 @@ func (s *DatabaseService) GetUserHistory(ctx context.Context, req *connect.Reque
 	return s.UserRepository.GetUserHistory(ctx, req)
 }
 
+func (s *DatabaseService) GetUserReportSummary(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetUserReportSummaryRequest]) (*connect.Response[snitchv1.DatabaseServiceGetUserReportSummaryResponse], error) {
+	return s.ReportRepository.GetUserReportSummary(ctx, req)
+}
+
+// Moderation operations
+func (s *DatabaseService) CreateBan(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCreateBanRequest]) (*connect.Response[snitchv1.DatabaseServiceCreateBanResponse], error) {
+	return s.BanRepository.CreateBan(ctx, req)
+}
+
+// Event log operations
+func (s *DatabaseService) AppendEvent(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceAppendEventRequest]) (*connect.Response[snitchv1.DatabaseServiceAppendEventResponse], error) {
+	return s.EventRepository.AppendEvent(ctx, req)
+}
+
+func (s *DatabaseService) ListEvents(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListEventsRequest]) (*connect.Response[snitchv1.DatabaseServiceListEventsResponse], error) {
+	return s.EventRepository.ListEvents(ctx, req)
+}
+
+func (s *DatabaseService) GetLatestEventSequence(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetLatestEventSequenceRequest]) (*connect.Response[snitchv1.DatabaseServiceGetLatestEventSequenceResponse], error) {
+	return s.EventRepository.GetLatestEventSequence(ctx, req)
+}
+
+func (s *DatabaseService) ListOutboxEvents(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListOutboxEventsRequest]) (*connect.Response[snitchv1.DatabaseServiceListOutboxEventsResponse], error) {
+	return s.EventRepository.ListOutboxEvents(ctx, req)
+}
+
+func (s *DatabaseService) DeleteOutboxEvent(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceDeleteOutboxEventRequest]) (*connect.Response[snitchv1.DatabaseServiceDeleteOutboxEventResponse], error) {
+	return s.EventRepository.DeleteOutboxEvent(ctx, req)
+}
+
 // Server and metadata operations
 func (s *DatabaseService) CreateGroup(ctx context.Context, req *connect.Request[snitchv1.CreateGroupRequest]) (*connect.Response[snitchv1.CreateGroupResponse], error) {
 	return s.ServerRepository.CreateGroup(ctx, req)
@@ -328,10 +456,94 @@ func (s *DatabaseService) FindGroupByServer(ctx context.Context, req *connect.Re
 	return s.ServerRepository.FindGroupByServer(ctx, req)
 }
 
+func (s *DatabaseService) ListServerGroups(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListServerGroupsRequest]) (*connect.Response[snitchv1.DatabaseServiceListServerGroupsResponse], error) {
+	return s.ServerRepository.ListServerGroups(ctx, req)
+}
+
 func (s *DatabaseService) AddServerToGroup(ctx context.Context, req *connect.Request[snitchv1.AddServerToGroupRequest]) (*connect.Response[snitchv1.AddServerToGroupResponse], error) {
 	return s.ServerRepository.AddServerToGroup(ctx, req)
 }
 
+func (s *DatabaseService) RemoveServerFromGroup(ctx context.Context, req *connect.Request[snitchv1.RemoveServerFromGroupRequest]) (*connect.Response[snitchv1.RemoveServerFromGroupResponse], error) {
+	return s.ServerRepository.RemoveServerFromGroup(ctx, req)
+}
+
+func (s *DatabaseService) SetServerRole(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceSetServerRoleRequest]) (*connect.Response[snitchv1.DatabaseServiceSetServerRoleResponse], error) {
+	return s.ServerRepository.SetServerRole(ctx, req)
+}
+
+func (s *DatabaseService) TransferGroupOwnership(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceTransferGroupOwnershipRequest]) (*connect.Response[snitchv1.DatabaseServiceTransferGroupOwnershipResponse], error) {
+	return s.ServerRepository.TransferGroupOwnership(ctx, req)
+}
+
 func (s *DatabaseService) ListServers(ctx context.Context, req *connect.Request[snitchv1.ListServersRequest]) (*connect.Response[snitchv1.ListServersResponse], error) {
 	return s.ServerRepository.ListServers(ctx, req)
 }
+
+func (s *DatabaseService) GetServerConfig(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetServerConfigRequest]) (*connect.Response[snitchv1.DatabaseServiceGetServerConfigResponse], error) {
+	return s.ServerRepository.GetServerConfig(ctx, req)
+}
+
+func (s *DatabaseService) UpdateServerConfig(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceUpdateServerConfigRequest]) (*connect.Response[snitchv1.DatabaseServiceUpdateServerConfigResponse], error) {
+	return s.ServerRepository.UpdateServerConfig(ctx, req)
+}
+
+// API key operations
+func (s *DatabaseService) CreateAPIKey(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCreateAPIKeyRequest]) (*connect.Response[snitchv1.DatabaseServiceCreateAPIKeyResponse], error) {
+	return s.APIKeyRepository.CreateAPIKey(ctx, req)
+}
+
+func (s *DatabaseService) GetAPIKey(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[snitchv1.DatabaseServiceGetAPIKeyResponse], error) {
+	return s.APIKeyRepository.GetAPIKey(ctx, req)
+}
+
+func (s *DatabaseService) ListAPIKeys(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListAPIKeysRequest]) (*connect.Response[snitchv1.DatabaseServiceListAPIKeysResponse], error) {
+	return s.APIKeyRepository.ListAPIKeys(ctx, req)
+}
+
+func (s *DatabaseService) RevokeAPIKey(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceRevokeAPIKeyRequest]) (*connect.Response[snitchv1.DatabaseServiceRevokeAPIKeyResponse], error) {
+	return s.APIKeyRepository.RevokeAPIKey(ctx, req)
+}
+
+// Invite operations
+func (s *DatabaseService) CreateInvite(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCreateInviteRequest]) (*connect.Response[snitchv1.DatabaseServiceCreateInviteResponse], error) {
+	return s.InviteRepository.CreateInvite(ctx, req)
+}
+
+func (s *DatabaseService) ListInvites(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceListInvitesRequest]) (*connect.Response[snitchv1.DatabaseServiceListInvitesResponse], error) {
+	return s.InviteRepository.ListInvites(ctx, req)
+}
+
+func (s *DatabaseService) RevokeInvite(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceRevokeInviteRequest]) (*connect.Response[snitchv1.DatabaseServiceRevokeInviteResponse], error) {
+	return s.InviteRepository.RevokeInvite(ctx, req)
+}
+
+func (s *DatabaseService) RedeemInvite(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceRedeemInviteRequest]) (*connect.Response[snitchv1.DatabaseServiceRedeemInviteResponse], error) {
+	return s.InviteRepository.RedeemInvite(ctx, req)
+}
+
+func (s *DatabaseService) DecideJoinRequest(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceDecideJoinRequestRequest]) (*connect.Response[snitchv1.DatabaseServiceDecideJoinRequestResponse], error) {
+	return s.InviteRepository.DecideJoinRequest(ctx, req)
+}
+
+func (s *DatabaseService) CancelJoinRequest(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceCancelJoinRequestRequest]) (*connect.Response[snitchv1.DatabaseServiceCancelJoinRequestResponse], error) {
+	return s.InviteRepository.CancelJoinRequest(ctx, req)
+}
+
+// Group config operations
+func (s *DatabaseService) GetGroupConfig(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetGroupConfigRequest]) (*connect.Response[snitchv1.DatabaseServiceGetGroupConfigResponse], error) {
+	return s.ServerRepository.GetGroupConfig(ctx, req)
+}
+
+func (s *DatabaseService) UpdateGroupConfig(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceUpdateGroupConfigRequest]) (*connect.Response[snitchv1.DatabaseServiceUpdateGroupConfigResponse], error) {
+	return s.ServerRepository.UpdateGroupConfig(ctx, req)
+}
+
+// Bot permission policy operations
+func (s *DatabaseService) GetPermissionPolicy(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceGetPermissionPolicyRequest]) (*connect.Response[snitchv1.DatabaseServiceGetPermissionPolicyResponse], error) {
+	return s.PermissionRepository.GetPermissionPolicy(ctx, req)
+}
+
+func (s *DatabaseService) UpdatePermissionPolicy(ctx context.Context, req *connect.Request[snitchv1.DatabaseServiceUpdatePermissionPolicyRequest]) (*connect.Response[snitchv1.DatabaseServiceUpdatePermissionPolicyResponse], error) {
+	return s.PermissionRepository.UpdatePermissionPolicy(ctx, req)
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"snitch/internal/db/sqlc/gen/groupdb"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventLogRetention is how many of its latest events a group keeps for subscribers to replay
+const eventLogRetention = 10000
+
+// maxListEventsLimit caps how many events a single ListEvents call returns
+const maxListEventsLimit = 500
+
+// EventRepository handles the per-group event log
+type EventRepository struct {
+	service *DatabaseService
+}
+
+// NewEventRepository creates a new EventRepository
+func NewEventRepository(service *DatabaseService) *EventRepository {
+	return &EventRepository{
+		service: service,
+	}
+}
+
+// AppendEvent stores an event in the group's event log, assigning it the next sequence, and prunes events past retention.
+// An event whose idempotency key was already appended isn't stored again; the earlier sequence is returned instead.
+func (r *EventRepository) AppendEvent(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceAppendEventRequest],
+) (*connect.Response[snitchv1.DatabaseServiceAppendEventResponse], error) {
+	if req.Msg.Event == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event is required"))
+	}
+
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	// The sequence is assigned by the database, so it isn't part of the stored payload
+	event := proto.Clone(req.Msg.Event).(*snitchv1.SubscribeResponse)
+	event.Sequence = 0
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to encode event: %w", err))
+	}
+
+	var idempotencyKey sql.NullString
+	if event.IdempotencyKey != "" {
+		idempotencyKey = sql.NullString{String: event.IdempotencyKey, Valid: true}
+	}
+
+	queries := groupdb.New(db)
+
+	// Duplicates are looked up first since a conflicting insert still uses up a sequence, leaving a gap
+	if idempotencyKey.Valid {
+		sequence, err := queries.GetEventSequenceByKey(ctx, idempotencyKey)
+		if err == nil {
+			return connect.NewResponse(&snitchv1.DatabaseServiceAppendEventResponse{Sequence: sequence, Duplicate: true}), nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.service.logger.Error("Failed to look up duplicate event", "group_id", req.Msg.GroupId, "idempotency_key", event.IdempotencyKey, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up duplicate event: %w", err))
+		}
+	}
+
+	sequence, err := queries.AppendEvent(ctx, groupdb.AppendEventParams{
+		EventType:      int64(event.Type),
+		Payload:        payload,
+		IdempotencyKey: idempotencyKey,
+	})
+	if errors.Is(err, sql.ErrNoRows) && idempotencyKey.Valid {
+		// Appended concurrently under the same key since the lookup
+		sequence, err = queries.GetEventSequenceByKey(ctx, idempotencyKey)
+		if err != nil {
+			r.service.logger.Error("Failed to look up duplicate event", "group_id", req.Msg.GroupId, "idempotency_key", event.IdempotencyKey, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up duplicate event: %w", err))
+		}
+		return connect.NewResponse(&snitchv1.DatabaseServiceAppendEventResponse{Sequence: sequence, Duplicate: true}), nil
+	}
+	if err != nil {
+		r.service.logger.Error("Failed to append event", "group_id", req.Msg.GroupId, "type", event.Type, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to append event: %w", err))
+	}
+
+	if sequence > eventLogRetention {
+		if err := queries.PruneEvents(ctx, sequence-eventLogRetention); err != nil {
+			// The event is stored, so a failed prune is left for the next append
+			r.service.logger.Warn("Failed to prune event log", "group_id", req.Msg.GroupId, "error", err)
+		}
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceAppendEventResponse{Sequence: sequence}), nil
+}
+
+// ListEvents returns the group's events after a sequence, oldest first
+func (r *EventRepository) ListEvents(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListEventsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListEventsResponse], error) {
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 || limit > maxListEventsLimit {
+		limit = maxListEventsLimit
+	}
+
+	rows, err := groupdb.New(db).ListEventsAfter(ctx, groupdb.ListEventsAfterParams{
+		Sequence: req.Msg.AfterSequence,
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		r.service.logger.Error("Failed to list events", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list events: %w", err))
+	}
+
+	events := make([]*snitchv1.SubscribeResponse, 0, len(rows))
+	for _, row := range rows {
+		event := &snitchv1.SubscribeResponse{}
+		if err := proto.Unmarshal(row.Payload, event); err != nil {
+			r.service.logger.Error("Failed to decode stored event", "group_id", req.Msg.GroupId, "sequence", row.Sequence, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode event %d: %w", row.Sequence, err))
+		}
+		event.Sequence = row.Sequence
+		events = append(events, event)
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListEventsResponse{Events: events}), nil
+}
+
+// GetLatestEventSequence returns the sequence of the group's latest event, or 0 when it has none
+func (r *EventRepository) GetLatestEventSequence(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetLatestEventSequenceRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetLatestEventSequenceResponse], error) {
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	sequence, err := groupdb.New(db).GetLatestEventSequence(ctx)
+	if err != nil {
+		r.service.logger.Error("Failed to get latest event sequence", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest event sequence: %w", err))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceGetLatestEventSequenceResponse{Sequence: sequence}), nil
+}
+
+// maxListOutboxEventsLimit caps how many outbox events a single ListOutboxEvents call returns
+const maxListOutboxEventsLimit = 100
+
+// createOutboxEvent writes an event to the group's outbox within a caller's transaction, so it is published
+// if and only if the change it describes is committed
+func createOutboxEvent(ctx context.Context, queries *groupdb.Queries, event *snitchv1.SubscribeResponse) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	return queries.CreateOutboxEvent(ctx, payload)
+}
+
+// ListOutboxEvents returns the oldest outbox events of every group, up to the limit in total. Groups are listed
+// from the metadata database, so outboxes of groups nobody has used since a restart are relayed too.
+func (r *EventRepository) ListOutboxEvents(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListOutboxEventsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListOutboxEventsResponse], error) {
+	limit := req.Msg.Limit
+	if limit <= 0 || limit > maxListOutboxEventsLimit {
+		limit = maxListOutboxEventsLimit
+	}
+
+	// Deleted groups are skipped, their databases may be being archived
+	groupIDs, err := r.service.metadataQueries.ListActiveGroupIDs(ctx)
+	if err != nil {
+		r.service.logger.Error("Failed to list groups", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list groups: %w", err))
+	}
+
+	var events []*snitchv1.DbOutboxEvent
+	for _, groupID := range groupIDs {
+		remaining := int64(limit) - int64(len(events))
+		if remaining <= 0 {
+			break
+		}
+
+		db, err := r.service.openGroupDB(ctx, groupID)
+		if err != nil {
+			// A group without a database has no outbox to relay
+			r.service.logger.Debug("Skipping outbox of group without a database", "group_id", groupID, "error", err)
+			continue
+		}
+		queries := groupdb.New(db)
+
+		rows, err := queries.ListOutboxEvents(ctx, remaining)
+		if err != nil {
+			r.service.logger.Error("Failed to list outbox events", "group_id", groupID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list outbox events for group %s: %w", groupID, err))
+		}
+
+		for _, row := range rows {
+			event := &snitchv1.SubscribeResponse{}
+			if err := proto.Unmarshal(row.Payload, event); err != nil {
+				// An event that can't be decoded can never be published, so it is dropped rather than left to
+				// take up the outbox on every relay
+				r.service.logger.Error("Dropping outbox event that can't be decoded", "group_id", groupID, "outbox_id", row.OutboxID, "payload_size", len(row.Payload), "error", err)
+				if err := queries.DeleteOutboxEvent(ctx, row.OutboxID); err != nil {
+					r.service.logger.Error("Failed to drop outbox event", "group_id", groupID, "outbox_id", row.OutboxID, "error", err)
+				}
+				continue
+			}
+			events = append(events, &snitchv1.DbOutboxEvent{
+				GroupId:  groupID,
+				OutboxId: row.OutboxID,
+				Event:    event,
+			})
+		}
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListOutboxEventsResponse{Events: events}), nil
+}
+
+// DeleteOutboxEvent removes an outbox event once it has been published
+func (r *EventRepository) DeleteOutboxEvent(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceDeleteOutboxEventRequest],
+) (*connect.Response[snitchv1.DatabaseServiceDeleteOutboxEventResponse], error) {
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	if err := groupdb.New(db).DeleteOutboxEvent(ctx, req.Msg.OutboxId); err != nil {
+		r.service.logger.Error("Failed to delete outbox event", "group_id", req.Msg.GroupId, "outbox_id", req.Msg.OutboxId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete outbox event: %w", err))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceDeleteOutboxEventResponse{}), nil
+}
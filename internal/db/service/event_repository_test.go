@@ -0,0 +1,65 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// createReportWithEvent files a report whose created event goes to the group's outbox
+func createReportWithEvent(t *testing.T, service *DatabaseService, groupID string) {
+	t.Helper()
+
+	if _, err := service.CreateReport(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+		GroupId:    groupID,
+		UserId:     "user-1",
+		ReporterId: "user-2",
+		ServerId:   "server-1",
+		Reason:     "spam",
+		Event: &snitchv1.SubscribeResponse{
+			Type:    snitchv1.EventType_EVENT_TYPE_REPORT_CREATED,
+			GroupId: groupID,
+		},
+	})); err != nil {
+		t.Fatalf("CreateReport failed: %v", err)
+	}
+}
+
+func TestEventRepository_ListOutboxEventsSkipsDeletedGroups(t *testing.T) {
+	service, dir := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+	createTestGroup(t, service, "group-2", "Topic", "server-1")
+	createReportWithEvent(t, service, "group-1")
+	createReportWithEvent(t, service, "group-2")
+	deleteTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	listOutbox := func() []*snitchv1.DbOutboxEvent {
+		t.Helper()
+		listResp, err := service.ListOutboxEvents(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListOutboxEventsRequest{Limit: 10}))
+		if err != nil {
+			t.Fatalf("ListOutboxEvents failed: %v", err)
+		}
+		return listResp.Msg.Events
+	}
+
+	events := listOutbox()
+	if len(events) != 1 || events[0].GroupId != "group-2" {
+		t.Fatalf("Expected only the outbox event of group-2, got %v", events)
+	}
+
+	service.GroupDeletionGracePeriod = -time.Hour
+	if _, err := service.PurgeDeletedGroups(ctx); err != nil {
+		t.Fatalf("PurgeDeletedGroups failed: %v", err)
+	}
+
+	listOutbox()
+	if _, err := os.Stat(filepath.Join(dir, "group_group-1.db")); !os.IsNotExist(err) {
+		t.Errorf("Expected relaying outboxes not to recreate the purged group's database, got %v", err)
+	}
+}
@@ -2,11 +2,16 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
+	"snitch/internal/db/sqlc/gen/metadata"
+	"snitch/internal/shared/dbtime"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GroupRepository handles group database management operations
@@ -34,3 +39,196 @@ func (r *GroupRepository) CreateGroupDatabase(
 
 	return connect.NewResponse(&snitchv1.CreateGroupDatabaseResponse{GroupId: req.Msg.GroupId}), nil
 }
+
+// DeleteGroup soft-deletes a group once its owning server confirms the group's name
+func (r *GroupRepository) DeleteGroup(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceDeleteGroupRequest],
+) (*connect.Response[snitchv1.DatabaseServiceDeleteGroupResponse], error) {
+	if req.Msg.GroupId == "" || req.Msg.ServerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("group ID and server ID are required"))
+	}
+
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	group, err := queries.GetGroup(ctx, req.Msg.GroupId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("group not found: %s", req.Msg.GroupId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group: %w", err))
+	}
+	if group.DeletedAt.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("group is already deleted"))
+	}
+	if !group.OwnerServerID.Valid || group.OwnerServerID.String != req.Msg.ServerId {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the server that created the group can delete it"))
+	}
+	if req.Msg.ConfirmGroupName != group.GroupName {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("confirmation does not match the group name"))
+	}
+
+	if _, err := queries.SoftDeleteGroup(ctx, req.Msg.GroupId); err != nil {
+		r.service.logger.Error("Failed to delete group", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete group: %w", err))
+	}
+
+	group, err = queries.GetGroup(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group: %w", err))
+	}
+	deletedAt, err := dbtime.Parse(group.DeletedAt.String)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse deletion time: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	r.service.logger.Info("Deleted group", "group_id", req.Msg.GroupId, "server_id", req.Msg.ServerId, "grace_period", r.service.GroupDeletionGracePeriod)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceDeleteGroupResponse{
+		GroupId:    req.Msg.GroupId,
+		DeletedAt:  timestamppb.New(deletedAt),
+		PurgeAfter: timestamppb.New(deletedAt.Add(r.service.GroupDeletionGracePeriod)),
+	}), nil
+}
+
+// RestoreGroup undoes the latest deletion of a group owned by the server while it is within its grace period
+func (r *GroupRepository) RestoreGroup(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceRestoreGroupRequest],
+) (*connect.Response[snitchv1.DatabaseServiceRestoreGroupResponse], error) {
+	if req.Msg.ServerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID is required"))
+	}
+
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	groupID, err := queries.FindDeletedGroupByOwner(ctx, metadata.FindDeletedGroupByOwnerParams{
+		OwnerServerID: sql.NullString{String: req.Msg.ServerId, Valid: true},
+		DeletedAt:     sql.NullString{String: r.purgeCutoff(), Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no deleted group owned by this server is within its grace period"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to find deleted group: %w", err))
+	}
+
+	if _, err := queries.RestoreGroup(ctx, groupID); err != nil {
+		r.service.logger.Error("Failed to restore group", "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restore group: %w", err))
+	}
+
+	group, err := queries.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	r.service.logger.Info("Restored group", "group_id", groupID, "server_id", req.Msg.ServerId)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceRestoreGroupResponse{
+		GroupId:   group.GroupID,
+		GroupName: group.GroupName,
+	}), nil
+}
+
+// purgeCutoff returns the deletion time before which deleted groups are past their grace period
+func (r *GroupRepository) purgeCutoff() string {
+	return dbtime.Format(time.Now().Add(-r.service.GroupDeletionGracePeriod))
+}
+
+// PurgeDeletedGroups permanently removes groups whose grace period has ended,
+// archiving their tenant databases, and returns how many were purged
+func (r *GroupRepository) PurgeDeletedGroups(ctx context.Context) (int, error) {
+	cutoff := sql.NullString{String: r.purgeCutoff(), Valid: true}
+
+	groupIDs, err := metadata.New(r.service.metadataDB).ListPurgeableGroups(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list purgeable groups: %w", err)
+	}
+
+	purged := 0
+	for _, groupID := range groupIDs {
+		ok, err := r.purgeGroupMetadata(ctx, groupID, cutoff)
+		if err != nil {
+			r.service.logger.Error("Failed to purge group", "group_id", groupID, "error", err)
+			continue
+		}
+		if !ok {
+			// Restored since it was listed
+			continue
+		}
+
+		if err := r.service.archiveGroupDB(groupID); err != nil {
+			r.service.logger.Error("Failed to archive group database", "group_id", groupID, "error", err)
+		}
+
+		r.service.logger.Info("Purged deleted group", "group_id", groupID)
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purgeGroupMetadata removes a deleted group and everything referencing it from the metadata database
+func (r *GroupRepository) purgeGroupMetadata(ctx context.Context, groupID string, cutoff sql.NullString) (bool, error) {
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	if err := queries.DeleteGroupJoinRequests(ctx, groupID); err != nil {
+		return false, fmt.Errorf("failed to delete join requests: %w", err)
+	}
+	if err := queries.DeleteGroupInvites(ctx, groupID); err != nil {
+		return false, fmt.Errorf("failed to delete invites: %w", err)
+	}
+	if err := queries.DeleteGroupServers(ctx, groupID); err != nil {
+		return false, fmt.Errorf("failed to delete servers: %w", err)
+	}
+
+	rowsAffected, err := queries.PurgeGroup(ctx, metadata.PurgeGroupParams{
+		GroupID:   groupID,
+		DeletedAt: cutoff,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete group: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// newTestDatabaseService opens a database service on a temporary directory
+func newTestDatabaseService(t *testing.T) (*DatabaseService, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	service, err := NewDatabaseService(t.Context(), dir, slog.Default())
+	if err != nil {
+		t.Fatalf("NewDatabaseService failed: %v", err)
+	}
+	t.Cleanup(func() { service.Close() })
+	return service, dir
+}
+
+// createTestGroup creates a group with its database, owned by its first server
+func createTestGroup(t *testing.T, service *DatabaseService, groupID, groupName string, serverIDs ...string) {
+	t.Helper()
+	ctx := t.Context()
+
+	if _, err := service.CreateGroup(ctx, connect.NewRequest(&snitchv1.CreateGroupRequest{
+		GroupId:       groupID,
+		GroupName:     groupName,
+		OwnerServerId: serverIDs[0],
+	})); err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	if _, err := service.CreateGroupDatabase(ctx, connect.NewRequest(&snitchv1.CreateGroupDatabaseRequest{GroupId: groupID})); err != nil {
+		t.Fatalf("CreateGroupDatabase failed: %v", err)
+	}
+	for _, serverID := range serverIDs {
+		if _, err := service.AddServerToGroup(ctx, connect.NewRequest(&snitchv1.AddServerToGroupRequest{
+			GroupId:  groupID,
+			ServerId: serverID,
+		})); err != nil {
+			t.Fatalf("AddServerToGroup failed: %v", err)
+		}
+	}
+}
+
+// deleteTestGroup deletes a group on behalf of its owner
+func deleteTestGroup(t *testing.T, service *DatabaseService, groupID, groupName, ownerServerID string) {
+	t.Helper()
+
+	if _, err := service.DeleteGroup(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceDeleteGroupRequest{
+		GroupId:          groupID,
+		ServerId:         ownerServerID,
+		ConfirmGroupName: groupName,
+	})); err != nil {
+		t.Fatalf("DeleteGroup failed: %v", err)
+	}
+}
+
+func TestGroupRepository_RestoreGroup(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1", "server-2")
+	deleteTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	if _, err := service.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{ServerId: "server-2"})); err == nil {
+		t.Fatal("Expected a deleted group not to be found from its servers")
+	}
+
+	// Only the owner can restore the group
+	if _, err := service.RestoreGroup(ctx, connect.NewRequest(&snitchv1.DatabaseServiceRestoreGroupRequest{ServerId: "server-2"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("Expected NotFound restoring from a member, got %v", err)
+	}
+
+	restoreResp, err := service.RestoreGroup(ctx, connect.NewRequest(&snitchv1.DatabaseServiceRestoreGroupRequest{ServerId: "server-1"}))
+	if err != nil {
+		t.Fatalf("RestoreGroup failed: %v", err)
+	}
+	if restoreResp.Msg.GroupId != "group-1" || restoreResp.Msg.GroupName != "Regional" {
+		t.Errorf("Expected group-1 Regional to be restored, got %s %s", restoreResp.Msg.GroupId, restoreResp.Msg.GroupName)
+	}
+
+	findResp, err := service.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{ServerId: "server-2"}))
+	if err != nil {
+		t.Fatalf("Expected the restored group to be found from its servers, got %v", err)
+	}
+	if findResp.Msg.GroupId != "group-1" {
+		t.Errorf("Expected group-1, got %s", findResp.Msg.GroupId)
+	}
+
+	// The restored group's data is still there
+	if _, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+		GroupId:    "group-1",
+		UserId:     "user-1",
+		ReporterId: "user-2",
+		ServerId:   "server-2",
+		Reason:     "spam",
+	})); err != nil {
+		t.Errorf("Expected reports to be filed in the restored group, got %v", err)
+	}
+}
+
+func TestGroupRepository_RestoreGroupAfterGracePeriod(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+	deleteTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	service.GroupDeletionGracePeriod = -time.Hour
+	if _, err := service.RestoreGroup(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceRestoreGroupRequest{ServerId: "server-1"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("Expected NotFound restoring past the grace period, got %v", err)
+	}
+}
+
+func TestGroupRepository_PurgeDeletedGroups(t *testing.T) {
+	service, dir := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1", "server-2")
+	createTestGroup(t, service, "group-2", "Topic", "server-2")
+	deleteTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	purged, err := service.PurgeDeletedGroups(ctx)
+	if err != nil {
+		t.Fatalf("PurgeDeletedGroups failed: %v", err)
+	}
+	if purged != 0 {
+		t.Fatalf("Expected no group to be purged within the grace period, got %d", purged)
+	}
+
+	service.GroupDeletionGracePeriod = -time.Hour
+	purged, err = service.PurgeDeletedGroups(ctx)
+	if err != nil {
+		t.Fatalf("PurgeDeletedGroups failed: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("Expected 1 group to be purged, got %d", purged)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "group_group-1.db")); !os.IsNotExist(err) {
+		t.Errorf("Expected the purged group's database to be moved away, got %v", err)
+	}
+	archived, err := filepath.Glob(filepath.Join(dir, "archive", "group_group-1*"))
+	if err != nil {
+		t.Fatalf("Glob failed: %v", err)
+	}
+	if len(archived) == 0 {
+		t.Error("Expected the purged group's database to be archived")
+	}
+
+	// The purged group is gone, its servers' other groups are not
+	if _, err := service.RestoreGroup(ctx, connect.NewRequest(&snitchv1.DatabaseServiceRestoreGroupRequest{ServerId: "server-1"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected NotFound restoring a purged group, got %v", err)
+	}
+	findResp, err := service.FindGroupByServer(ctx, connect.NewRequest(&snitchv1.FindGroupByServerRequest{ServerId: "server-2"}))
+	if err != nil {
+		t.Fatalf("FindGroupByServer failed: %v", err)
+	}
+	if findResp.Msg.GroupId != "group-2" {
+		t.Errorf("Expected server-2 to be left in group-2, got %s", findResp.Msg.GroupId)
+	}
+
+	purged, err = service.PurgeDeletedGroups(ctx)
+	if err != nil {
+		t.Fatalf("PurgeDeletedGroups failed: %v", err)
+	}
+	if purged != 0 {
+		t.Errorf("Expected nothing left to purge, got %d", purged)
+	}
+}
@@ -0,0 +1,58 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"snitch/internal/shared/ctxutil"
+
+	"connectrpc.com/connect"
+)
+
+// Identity is the subject of the client certificate a request was made with
+type Identity struct {
+	CommonName         string
+	OrganizationalUnit []string
+}
+
+// WithClientIdentity records the verified client certificate of each request in its context for NewIdentityInterceptor
+func WithClientIdentity(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// PeerCertificates is only set once the TLS layer has verified the chain against the CA
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			subject := r.TLS.PeerCertificates[0].Subject
+			identity := Identity{
+				CommonName:         subject.CommonName,
+				OrganizationalUnit: subject.OrganizationalUnit,
+			}
+			r = r.WithContext(ctxutil.WithValue(r.Context(), identity))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// NewIdentityInterceptor only lets through callers whose certificate common name is in allowed, and logs who made each call
+func NewIdentityInterceptor(logger *slog.Logger, allowed ...string) connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			identity, ok := ctxutil.Value[Identity](ctx)
+			if !ok {
+				logger.Warn("Rejected call without client certificate", "procedure", req.Spec().Procedure, "peer", req.Peer().Addr)
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("a client certificate is required"))
+			}
+
+			if !slices.Contains(allowed, identity.CommonName) {
+				logger.Warn("Rejected call from unknown client", "procedure", req.Spec().Procedure, "caller", identity.CommonName)
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("client %q may not call the database service", identity.CommonName))
+			}
+
+			logger.Info("Database call", "procedure", req.Spec().Procedure, "caller", identity.CommonName)
+			return next(ctx, req)
+		})
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
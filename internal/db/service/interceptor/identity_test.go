@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"snitch/internal/shared/ctxutil"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+func TestWithClientIdentity(t *testing.T) {
+	var identity Identity
+	var found bool
+	handler := WithClientIdentity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, found = ctxutil.Value[Identity](r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if found {
+		t.Error("request without TLS should not have an identity")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+		Subject: pkix.Name{CommonName: "snitch-backend", OrganizationalUnit: []string{"Backend"}},
+	}}}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if !found || identity.CommonName != "snitch-backend" {
+		t.Errorf("identity = %+v, found = %v, want snitch-backend", identity, found)
+	}
+}
+
+func TestIdentityInterceptor(t *testing.T) {
+	called := false
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return nil, nil
+	})
+	handler := NewIdentityInterceptor(slog.Default(), "snitch-backend")(next)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{"no certificate", t.Context(), connect.CodeUnauthenticated},
+		{"unknown client", ctxutil.WithValue(t.Context(), Identity{CommonName: "snitch-bot"}), connect.CodePermissionDenied},
+		{"allowed client", ctxutil.WithValue(t.Context(), Identity{CommonName: "snitch-backend"}), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			_, err := handler(tt.ctx, connect.NewRequest(&snitchv1.CreateGroupRequest{}))
+			if tt.wantCode == 0 {
+				if err != nil || !called {
+					t.Fatalf("error = %v, called = %v, want the call to go through", err, called)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.wantCode || called {
+				t.Errorf("code = %v, called = %v, want %v", connect.CodeOf(err), called, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"snitch/internal/db/sqlc/gen/metadata"
+	"snitch/internal/shared/dbtime"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// Statuses a pending join request can be decided into
+const (
+	joinRequestApproved = "approved"
+	joinRequestDenied   = "denied"
+)
+
+// InviteRepository handles group invite and join request operations
+type InviteRepository struct {
+	service *DatabaseService
+}
+
+// NewInviteRepository creates a new InviteRepository
+func NewInviteRepository(service *DatabaseService) *InviteRepository {
+	return &InviteRepository{
+		service: service,
+	}
+}
+
+// inviteFromRow converts an invites row into its API representation
+func inviteFromRow(row metadata.Invite) *snitchv1.DbInvite {
+	invite := &snitchv1.DbInvite{
+		Code:      row.Code,
+		GroupId:   row.GroupID,
+		CreatedBy: row.CreatedBy,
+		MaxUses:   row.MaxUses,
+		Uses:      row.Uses,
+		CreatedAt: row.CreatedAt.String,
+	}
+	if row.ExpiresAt.Valid {
+		invite.ExpiresAt = &row.ExpiresAt.String
+	}
+	if row.RevokedAt.Valid {
+		invite.RevokedAt = &row.RevokedAt.String
+	}
+	return invite
+}
+
+// CreateInvite stores a new invite code for a group using sqlc
+func (r *InviteRepository) CreateInvite(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCreateInviteRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCreateInviteResponse], error) {
+	if req.Msg.Code == "" || req.Msg.GroupId == "" || req.Msg.CreatedBy == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("code, group ID and creator are required"))
+	}
+	if req.Msg.MaxUses < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max uses cannot be negative"))
+	}
+
+	var expiresAt sql.NullString
+	if req.Msg.ExpiresAt != nil {
+		expiresAt = sql.NullString{String: dbtime.Format(req.Msg.ExpiresAt.AsTime()), Valid: true}
+	}
+
+	queries := metadata.New(r.service.metadataDB)
+
+	if err := queries.CreateInvite(ctx, metadata.CreateInviteParams{
+		Code:      req.Msg.Code,
+		GroupID:   req.Msg.GroupId,
+		CreatedBy: req.Msg.CreatedBy,
+		MaxUses:   req.Msg.MaxUses,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		r.service.logger.Error("Failed to create invite", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create invite: %w", err))
+	}
+
+	row, err := queries.GetInvite(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get created invite: %w", err))
+	}
+
+	r.service.logger.Info("Created invite", "group_id", req.Msg.GroupId, "created_by", req.Msg.CreatedBy)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceCreateInviteResponse{Invite: inviteFromRow(row)}), nil
+}
+
+// ListInvites retrieves every invite of a group, including expired and revoked ones, using sqlc
+func (r *InviteRepository) ListInvites(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListInvitesRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListInvitesResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	rows, err := queries.ListInvites(ctx, req.Msg.GroupId)
+	if err != nil {
+		r.service.logger.Error("Failed to list invites", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list invites: %w", err))
+	}
+
+	invites := make([]*snitchv1.DbInvite, 0, len(rows))
+	for _, row := range rows {
+		invites = append(invites, inviteFromRow(row))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListInvitesResponse{Invites: invites}), nil
+}
+
+// RevokeInvite marks one of a group's invites as revoked using sqlc
+func (r *InviteRepository) RevokeInvite(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceRevokeInviteRequest],
+) (*connect.Response[snitchv1.DatabaseServiceRevokeInviteResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	rowsAffected, err := queries.RevokeInvite(ctx, metadata.RevokeInviteParams{
+		Code:    req.Msg.Code,
+		GroupID: req.Msg.GroupId,
+	})
+	if err != nil {
+		r.service.logger.Error("Failed to revoke invite", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke invite: %w", err))
+	}
+	if rowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite not found or already revoked: %s", req.Msg.Code))
+	}
+
+	row, err := queries.GetInvite(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get revoked invite: %w", err))
+	}
+
+	r.service.logger.Info("Revoked invite", "group_id", req.Msg.GroupId)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceRevokeInviteResponse{Invite: inviteFromRow(row)}), nil
+}
+
+// RedeemInvite uses up one use of an invite and, in the same transaction, either adds the server to its group
+// or files a join request when the group requires approval
+func (r *InviteRepository) RedeemInvite(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceRedeemInviteRequest],
+) (*connect.Response[snitchv1.DatabaseServiceRedeemInviteResponse], error) {
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	groupID, err := queries.ConsumeInvite(ctx, req.Msg.Code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite is unknown, expired, revoked or used up"))
+		}
+		r.service.logger.Error("Failed to consume invite", "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to consume invite: %w", err))
+	}
+
+	// Servers can be in several groups, but only once in each; rolling back gives the invite use back
+	if err := checkNotMember(ctx, queries, req.Msg.ServerId, groupID); err != nil {
+		return nil, err
+	}
+
+	pendingRequests, err := queries.CountPendingJoinRequests(ctx, metadata.CountPendingJoinRequestsParams{
+		ServerID: req.Msg.ServerId,
+		GroupID:  groupID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check pending join requests: %w", err))
+	}
+	if pendingRequests > 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("server already has a pending request to join this group"))
+	}
+
+	joinRequiresApproval, err := queries.GetGroupJoinApproval(ctx, groupID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group config: %w", err))
+	}
+
+	if joinRequiresApproval != 0 {
+		requestID := uuid.NewString()
+		if err := queries.CreateJoinRequest(ctx, metadata.CreateJoinRequestParams{
+			RequestID:   requestID,
+			GroupID:     groupID,
+			ServerID:    req.Msg.ServerId,
+			RequestedBy: req.Msg.UserId,
+			InviteCode:  sql.NullString{String: req.Msg.Code, Valid: true},
+		}); err != nil {
+			r.service.logger.Error("Failed to create join request", "server_id", req.Msg.ServerId, "group_id", groupID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create join request: %w", err))
+		}
+
+		if err := tx.Commit(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit invite redemption: %w", err))
+		}
+
+		r.service.logger.Info("Created join request", "request_id", requestID, "server_id", req.Msg.ServerId, "group_id", groupID)
+
+		return connect.NewResponse(&snitchv1.DatabaseServiceRedeemInviteResponse{
+			GroupId:       groupID,
+			JoinRequestId: &requestID,
+		}), nil
+	}
+
+	if err := addServerToGroup(ctx, queries, req.Msg.ServerId, groupID, snitchv1.GroupRole_GROUP_ROLE_MEMBER); err != nil {
+		r.service.logger.Error("Failed to add server to group", "server_id", req.Msg.ServerId, "group_id", groupID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add server to group: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit invite redemption: %w", err))
+	}
+
+	r.service.logger.Info("Redeemed invite", "server_id", req.Msg.ServerId, "group_id", groupID)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceRedeemInviteResponse{GroupId: groupID}), nil
+}
+
+// DecideJoinRequest approves or denies a pending join request of a group, adding the server to the group when approved
+func (r *InviteRepository) DecideJoinRequest(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceDecideJoinRequestRequest],
+) (*connect.Response[snitchv1.DatabaseServiceDecideJoinRequestResponse], error) {
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	status := joinRequestDenied
+	if req.Msg.Approve {
+		status = joinRequestApproved
+	}
+
+	serverID, err := queries.DecideJoinRequest(ctx, metadata.DecideJoinRequestParams{
+		Status:    status,
+		DecidedBy: sql.NullString{String: req.Msg.DecidedBy, Valid: req.Msg.DecidedBy != ""},
+		RequestID: req.Msg.RequestId,
+		GroupID:   req.Msg.GroupId,
+	})
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.service.logger.Error("Failed to decide join request", "request_id", req.Msg.RequestId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decide join request: %w", err))
+		}
+
+		joinRequest, getErr := queries.GetJoinRequest(ctx, req.Msg.RequestId)
+		if getErr != nil || joinRequest.GroupID != req.Msg.GroupId {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("join request not found: %s", req.Msg.RequestId))
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("join request was already %s", joinRequest.Status))
+	}
+
+	if req.Msg.Approve {
+		// The server may have joined the group with another invite while it waited
+		if err := checkNotMember(ctx, queries, serverID, req.Msg.GroupId); err != nil {
+			return nil, err
+		}
+
+		// Or joined other groups; the request stays pending until it has room, so this isn't a FailedPrecondition
+		// that would mark the request as settled
+		if req.Msg.MaxServerGroups > 0 {
+			groups, err := queries.ListServerGroups(ctx, serverID)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list server groups: %w", err))
+			}
+			if len(groups) >= int(req.Msg.MaxServerGroups) {
+				return nil, connect.NewError(connect.CodeResourceExhausted,
+					fmt.Errorf("server %s is already in %d groups, the most it can be in", serverID, len(groups)))
+			}
+		}
+
+		if err := addServerToGroup(ctx, queries, serverID, req.Msg.GroupId, snitchv1.GroupRole_GROUP_ROLE_MEMBER); err != nil {
+			r.service.logger.Error("Failed to add server to group", "server_id", serverID, "group_id", req.Msg.GroupId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add server to group: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit join request decision: %w", err))
+	}
+
+	r.service.logger.Info("Decided join request", "request_id", req.Msg.RequestId, "server_id", serverID, "group_id", req.Msg.GroupId, "status", status)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceDecideJoinRequestResponse{ServerId: serverID}), nil
+}
+
+// CancelJoinRequest withdraws a pending join request and gives back the use of the invite it was filed with,
+// so the server can redeem the invite again
+func (r *InviteRepository) CancelJoinRequest(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceCancelJoinRequestRequest],
+) (*connect.Response[snitchv1.DatabaseServiceCancelJoinRequestResponse], error) {
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	inviteCode, err := queries.DeletePendingJoinRequest(ctx, metadata.DeletePendingJoinRequestParams{
+		RequestID: req.Msg.RequestId,
+		GroupID:   req.Msg.GroupId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no pending join request %s", req.Msg.RequestId))
+		}
+		r.service.logger.Error("Failed to delete join request", "request_id", req.Msg.RequestId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete join request: %w", err))
+	}
+
+	if inviteCode.Valid {
+		if err := queries.ReleaseInvite(ctx, inviteCode.String); err != nil {
+			r.service.logger.Error("Failed to give back invite use", "request_id", req.Msg.RequestId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to give back invite use: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit join request cancellation: %w", err))
+	}
+
+	r.service.logger.Info("Cancelled join request", "request_id", req.Msg.RequestId, "group_id", req.Msg.GroupId)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceCancelJoinRequestResponse{}), nil
+}
+
+// checkNotMember fails with AlreadyExists when the server is already in the group
+func checkNotMember(ctx context.Context, queries *metadata.Queries, serverID, groupID string) error {
+	_, err := queries.GetServerRole(ctx, metadata.GetServerRoleParams{
+		ServerID: serverID,
+		GroupID:  groupID,
+	})
+	if err == nil {
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("server %s is already in group %s", serverID, groupID))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check group membership: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"testing"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// createApprovalGroup creates a group owned by server-1 that requires approval to join, with an invite to it
+func createApprovalGroup(t *testing.T, service *DatabaseService, groupID, inviteCode string) {
+	t.Helper()
+	ctx := t.Context()
+
+	createTestGroup(t, service, groupID, "Group "+groupID, "server-1")
+	if _, err := service.UpdateGroupConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdateGroupConfigRequest{
+		GroupId:              groupID,
+		JoinRequiresApproval: proto.Bool(true),
+	})); err != nil {
+		t.Fatalf("UpdateGroupConfig failed: %v", err)
+	}
+	if _, err := service.CreateInvite(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateInviteRequest{
+		Code:      inviteCode,
+		GroupId:   groupID,
+		CreatedBy: "user-1",
+	})); err != nil {
+		t.Fatalf("CreateInvite failed: %v", err)
+	}
+}
+
+// requestToJoin redeems an invite to a group that requires approval, returning the join request ID
+func requestToJoin(t *testing.T, service *DatabaseService, inviteCode, serverID string) string {
+	t.Helper()
+
+	redeemResp, err := service.RedeemInvite(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceRedeemInviteRequest{
+		Code:     inviteCode,
+		ServerId: serverID,
+		UserId:   "user-2",
+	}))
+	if err != nil {
+		t.Fatalf("RedeemInvite failed: %v", err)
+	}
+	if redeemResp.Msg.JoinRequestId == nil {
+		t.Fatal("Expected a join request instead of joining the group")
+	}
+	return *redeemResp.Msg.JoinRequestId
+}
+
+// isMember reports whether a server is in a group
+func isMember(t *testing.T, service *DatabaseService, serverID, groupID string) bool {
+	t.Helper()
+
+	listResp, err := service.ListServerGroups(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceListServerGroupsRequest{ServerId: serverID}))
+	if err != nil {
+		t.Fatalf("ListServerGroups failed: %v", err)
+	}
+	for _, group := range listResp.Msg.Groups {
+		if group.GroupId == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// decideJoinRequest approves or denies a join request with the backend's group limit
+func decideJoinRequest(t *testing.T, service *DatabaseService, requestID, groupID string, approve bool) (*connect.Response[snitchv1.DatabaseServiceDecideJoinRequestResponse], error) {
+	return service.DecideJoinRequest(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceDecideJoinRequestRequest{
+		RequestId:       requestID,
+		GroupId:         groupID,
+		Approve:         approve,
+		DecidedBy:       "moderator",
+		MaxServerGroups: 10,
+	}))
+}
+
+func TestInviteRepository_ApproveJoinRequest(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	createApprovalGroup(t, service, "group-1", "INVITE1")
+
+	requestID := requestToJoin(t, service, "INVITE1", "server-2")
+	if isMember(t, service, "server-2", "group-1") {
+		t.Fatal("Expected the server not to join before its request is approved")
+	}
+
+	decideResp, err := decideJoinRequest(t, service, requestID, "group-1", true)
+	if err != nil {
+		t.Fatalf("DecideJoinRequest failed: %v", err)
+	}
+	if decideResp.Msg.ServerId != "server-2" {
+		t.Errorf("Expected the request of server-2 to be decided, got %s", decideResp.Msg.ServerId)
+	}
+	if !isMember(t, service, "server-2", "group-1") {
+		t.Error("Expected the server to join once its request is approved")
+	}
+
+	// A request is decided once
+	if _, err := decideJoinRequest(t, service, requestID, "group-1", false); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected FailedPrecondition deciding an approved request, got %v", err)
+	}
+}
+
+func TestInviteRepository_DenyJoinRequest(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	createApprovalGroup(t, service, "group-1", "INVITE1")
+
+	requestID := requestToJoin(t, service, "INVITE1", "server-2")
+	if _, err := decideJoinRequest(t, service, requestID, "group-1", false); err != nil {
+		t.Fatalf("DecideJoinRequest failed: %v", err)
+	}
+	if isMember(t, service, "server-2", "group-1") {
+		t.Error("Expected a denied server not to join the group")
+	}
+
+	// Denied servers can ask again
+	requestToJoin(t, service, "INVITE1", "server-2")
+}
+
+func TestInviteRepository_DuplicateJoinRequest(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	createApprovalGroup(t, service, "group-1", "INVITE1")
+
+	requestToJoin(t, service, "INVITE1", "server-2")
+	if _, err := service.RedeemInvite(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceRedeemInviteRequest{
+		Code:     "INVITE1",
+		ServerId: "server-2",
+		UserId:   "user-2",
+	})); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("Expected AlreadyExists requesting to join twice, got %v", err)
+	}
+}
+
+func TestInviteRepository_ApproveJoinRequestEnforcesGroupLimit(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	createApprovalGroup(t, service, "group-1", "INVITE1")
+	requestID := requestToJoin(t, service, "INVITE1", "server-2")
+
+	// The server joined another group while its request was pending, reaching the limit
+	createTestGroup(t, service, "group-2", "Other", "server-2")
+	_, err := service.DecideJoinRequest(t.Context(), connect.NewRequest(&snitchv1.DatabaseServiceDecideJoinRequestRequest{
+		RequestId:       requestID,
+		GroupId:         "group-1",
+		Approve:         true,
+		DecidedBy:       "moderator",
+		MaxServerGroups: 1,
+	}))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("Expected ResourceExhausted approving a server at its group limit, got %v", err)
+	}
+	if isMember(t, service, "server-2", "group-1") {
+		t.Error("Expected the server not to join past its group limit")
+	}
+
+	// The request stays pending for when the server has room
+	if _, err := decideJoinRequest(t, service, requestID, "group-1", true); err != nil {
+		t.Errorf("Expected the request to still be pending, got %v", err)
+	}
+}
+
+func TestInviteRepository_CancelJoinRequest(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createApprovalGroup(t, service, "group-1", "INVITE1")
+
+	requestID := requestToJoin(t, service, "INVITE1", "server-2")
+	if _, err := service.CancelJoinRequest(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCancelJoinRequestRequest{
+		RequestId: requestID,
+		GroupId:   "group-1",
+	})); err != nil {
+		t.Fatalf("CancelJoinRequest failed: %v", err)
+	}
+
+	if _, err := decideJoinRequest(t, service, requestID, "group-1", true); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected NotFound deciding a cancelled request, got %v", err)
+	}
+
+	listResp, err := service.ListInvites(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListInvitesRequest{GroupId: "group-1"}))
+	if err != nil {
+		t.Fatalf("ListInvites failed: %v", err)
+	}
+	if len(listResp.Msg.Invites) != 1 || listResp.Msg.Invites[0].Uses != 0 {
+		t.Errorf("Expected the invite use to be given back, got %v", listResp.Msg.Invites)
+	}
+
+	// The server can ask again
+	requestToJoin(t, service, "INVITE1", "server-2")
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"snitch/internal/db/sqlc/gen/metadata"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+)
+
+// maxPermissionRoles caps the Discord roles a single permission can be granted to
+const maxPermissionRoles = 25
+
+// PermissionRepository handles the bot permission policies of guilds
+type PermissionRepository struct {
+	service *DatabaseService
+}
+
+// NewPermissionRepository creates a new PermissionRepository
+func NewPermissionRepository(service *DatabaseService) *PermissionRepository {
+	return &PermissionRepository{
+		service: service,
+	}
+}
+
+// permissionColumns maps bot permissions to the values stored in the permission_roles.permission column
+var permissionColumns = map[snitchv1.BotPermission]string{
+	snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS:  "create_reports",
+	snitchv1.BotPermission_BOT_PERMISSION_LIST_REPORTS:    "list_reports",
+	snitchv1.BotPermission_BOT_PERMISSION_DELETE_REPORTS:  "delete_reports",
+	snitchv1.BotPermission_BOT_PERMISSION_CONFIGURE_GROUP: "configure_group",
+}
+
+// permissionToColumn converts a bot permission into its column value
+func permissionToColumn(permission snitchv1.BotPermission) (string, error) {
+	column, ok := permissionColumns[permission]
+	if !ok {
+		return "", fmt.Errorf("invalid permission: %s", permission)
+	}
+	return column, nil
+}
+
+// GetPermissionPolicy lists the roles allowed to use each bot permission in a guild using sqlc
+func (r *PermissionRepository) GetPermissionPolicy(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetPermissionPolicyRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetPermissionPolicyResponse], error) {
+	policy, err := r.permissionPolicy(ctx, metadata.New(r.service.metadataDB), req.Msg.ServerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceGetPermissionPolicyResponse{Policy: policy}), nil
+}
+
+// UpdatePermissionPolicy replaces the roles allowed to use a bot permission in a guild using sqlc
+func (r *PermissionRepository) UpdatePermissionPolicy(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceUpdatePermissionPolicyRequest],
+) (*connect.Response[snitchv1.DatabaseServiceUpdatePermissionPolicyResponse], error) {
+	permission, err := permissionToColumn(req.Msg.Permission)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.ServerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID is required"))
+	}
+	if len(req.Msg.RoleIds) > maxPermissionRoles {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("a permission can be granted to at most %d roles", maxPermissionRoles))
+	}
+
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	if err := queries.DeletePermissionRoles(ctx, metadata.DeletePermissionRolesParams{
+		ServerID:   req.Msg.ServerId,
+		Permission: permission,
+	}); err != nil {
+		r.service.logger.Error("Failed to clear permission roles", "server_id", req.Msg.ServerId, "permission", permission, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update permission policy: %w", err))
+	}
+
+	for _, roleID := range req.Msg.RoleIds {
+		if roleID == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role ID must not be empty"))
+		}
+		if err := queries.AddPermissionRole(ctx, metadata.AddPermissionRoleParams{
+			ServerID:   req.Msg.ServerId,
+			Permission: permission,
+			RoleID:     roleID,
+		}); err != nil {
+			r.service.logger.Error("Failed to add permission role", "server_id", req.Msg.ServerId, "permission", permission, "role_id", roleID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update permission policy: %w", err))
+		}
+	}
+
+	policy, err := r.permissionPolicy(ctx, queries, req.Msg.ServerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.service.logger.Error("Failed to commit permission policy", "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit permission policy: %w", err))
+	}
+
+	r.service.logger.Info("Updated permission policy", "server_id", req.Msg.ServerId, "permission", permission, "roles", len(req.Msg.RoleIds))
+	return connect.NewResponse(&snitchv1.DatabaseServiceUpdatePermissionPolicyResponse{Policy: policy}), nil
+}
+
+// permissionPolicy builds a guild's policy with one rule per permission, in enum order
+func (r *PermissionRepository) permissionPolicy(ctx context.Context, queries *metadata.Queries, serverID string) (*snitchv1.PermissionPolicy, error) {
+	rows, err := queries.ListPermissionRoles(ctx, serverID)
+	if err != nil {
+		r.service.logger.Error("Failed to list permission roles", "server_id", serverID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get permission policy: %w", err))
+	}
+
+	roles := make(map[string][]string, len(permissionColumns))
+	for _, row := range rows {
+		roles[row.Permission] = append(roles[row.Permission], row.RoleID)
+	}
+
+	policy := &snitchv1.PermissionPolicy{}
+	for permission := snitchv1.BotPermission_BOT_PERMISSION_CREATE_REPORTS; permission <= snitchv1.BotPermission_BOT_PERMISSION_CONFIGURE_GROUP; permission++ {
+		policy.Rules = append(policy.Rules, &snitchv1.PermissionRule{
+			Permission: permission,
+			RoleIds:    roles[permissionColumns[permission]],
+		})
+	}
+	return policy, nil
+}
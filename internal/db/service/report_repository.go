@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	"snitch/internal/db/sqlc/gen/groupdb"
+	"snitch/internal/shared/dbtime"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 )
 
 // ReportRepository handles report CRUD operations
@@ -16,6 +20,233 @@ type ReportRepository struct {
 	service *DatabaseService
 }
 
+// reportStatusColumns maps API report statuses to the values stored in the reports.status column
+var reportStatusColumns = map[snitchv1.ReportStatus]string{
+	snitchv1.ReportStatus_REPORT_STATUS_OPEN:         "open",
+	snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW: "under_review",
+	snitchv1.ReportStatus_REPORT_STATUS_RESOLVED:     "resolved",
+	snitchv1.ReportStatus_REPORT_STATUS_DISMISSED:    "dismissed",
+}
+
+// reportStatusToColumn converts an API report status into its column value
+func reportStatusToColumn(status snitchv1.ReportStatus) (string, error) {
+	column, ok := reportStatusColumns[status]
+	if !ok {
+		return "", fmt.Errorf("invalid report status: %s", status)
+	}
+	return column, nil
+}
+
+// reportStatusFromColumn converts a reports.status column value into an API report status
+func reportStatusFromColumn(column string) snitchv1.ReportStatus {
+	for status, value := range reportStatusColumns {
+		if value == column {
+			return status
+		}
+	}
+	return snitchv1.ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+// reportFromRow converts a sqlc report row into its protobuf representation
+func reportFromRow(row groupdb.Report) *snitchv1.DatabaseServiceGetReportResponse {
+	report := &snitchv1.DatabaseServiceGetReportResponse{
+		Id:         row.ReportID,
+		Reason:     row.ReportText,
+		ReporterId: row.ReporterID,
+		UserId:     row.ReportedUserID,
+		ServerId:   row.OriginServerID,
+		Status:     reportStatusFromColumn(row.Status),
+	}
+
+	// Handle nullable timestamp fields
+	if row.CreatedAt.Valid {
+		report.CreatedAt = row.CreatedAt.String
+	}
+	if row.UpdatedAt.Valid {
+		report.UpdatedAt = &row.UpdatedAt.String
+	}
+	if row.Category.Valid {
+		report.Category = &row.Category.String
+	}
+
+	return report
+}
+
+// reportColumns lists the reports columns in the order groupdb.Report is scanned in
+const reportColumns = "report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at, status, updated_at, category"
+
+// buildListReportsQuery builds the report listing query for the filters set on the request.
+// Only fixed SQL fragments are concatenated; every filter value is bound as a parameter.
+func buildListReportsQuery(msg *snitchv1.DatabaseServiceListReportsRequest) (string, []any, error) {
+	if msg.GetLimit() < 0 || msg.GetOffset() < 0 {
+		return "", nil, fmt.Errorf("limit and offset must not be negative")
+	}
+
+	var conditions []string
+	var args []any
+
+	if msg.UserId != nil {
+		conditions = append(conditions, "reported_user_id = ?")
+		args = append(args, *msg.UserId)
+	}
+	if msg.ReporterId != nil {
+		conditions = append(conditions, "reporter_id = ?")
+		args = append(args, *msg.ReporterId)
+	}
+	if msg.OriginServerId != nil {
+		conditions = append(conditions, "origin_server_id = ?")
+		args = append(args, *msg.OriginServerId)
+	}
+	if msg.Status != nil {
+		status, err := reportStatusToColumn(*msg.Status)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, "status = ?")
+		args = append(args, status)
+	}
+	if msg.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, dbtime.Format(msg.CreatedAfter.AsTime()))
+	}
+	if msg.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, dbtime.Format(msg.CreatedBefore.AsTime()))
+	}
+
+	var query strings.Builder
+	query.WriteString("SELECT " + reportColumns + " FROM reports")
+	if len(conditions) > 0 {
+		query.WriteString(" WHERE " + strings.Join(conditions, " AND "))
+	}
+	query.WriteString(" ORDER BY created_at DESC, report_id DESC LIMIT ? OFFSET ?")
+
+	// A negative limit means no limit in SQLite
+	limit := int64(-1)
+	if msg.Limit != nil {
+		limit = int64(*msg.Limit)
+	}
+	args = append(args, limit, int64(msg.GetOffset()))
+
+	return query.String(), args, nil
+}
+
+// scanReports reads report rows selected with reportColumns
+func scanReports(rows *sql.Rows) ([]groupdb.Report, error) {
+	defer rows.Close()
+
+	var reports []groupdb.Report
+	for rows.Next() {
+		var report groupdb.Report
+		if err := rows.Scan(
+			&report.ReportID,
+			&report.ReportText,
+			&report.ReporterID,
+			&report.ReportedUserID,
+			&report.OriginServerID,
+			&report.CreatedAt,
+			&report.Status,
+			&report.UpdatedAt,
+			&report.Category,
+		); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// reportEvidenceColumns lists the report_evidence columns in the order groupdb.ReportEvidence is scanned in
+const reportEvidenceColumns = "evidence_id, report_id, url, content_type, message_link, created_at, message_content, message_author_id, channel_id"
+
+// listEvidenceForReports loads the evidence of several reports with a single query, keyed by report ID
+func listEvidenceForReports(ctx context.Context, db *sql.DB, reportIDs []int64) (map[int64][]groupdb.ReportEvidence, error) {
+	evidence := make(map[int64][]groupdb.ReportEvidence, len(reportIDs))
+	if len(reportIDs) == 0 {
+		return evidence, nil
+	}
+
+	args := make([]any, 0, len(reportIDs))
+	for _, reportID := range reportIDs {
+		args = append(args, reportID)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(reportIDs)), ", ")
+	query := "SELECT " + reportEvidenceColumns + " FROM report_evidence WHERE report_id IN (" + placeholders + ") ORDER BY report_id, evidence_id"
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row groupdb.ReportEvidence
+		if err := rows.Scan(
+			&row.EvidenceID,
+			&row.ReportID,
+			&row.Url,
+			&row.ContentType,
+			&row.MessageLink,
+			&row.CreatedAt,
+			&row.MessageContent,
+			&row.MessageAuthorID,
+			&row.ChannelID,
+		); err != nil {
+			return nil, err
+		}
+		evidence[row.ReportID] = append(evidence[row.ReportID], row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// nullString converts an optional protobuf string into a sql.NullString
+func nullString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *value, Valid: true}
+}
+
+// evidenceFromRows converts sqlc report evidence rows into their protobuf representation
+func evidenceFromRows(rows []groupdb.ReportEvidence) []*snitchv1.ReportEvidence {
+	evidence := make([]*snitchv1.ReportEvidence, 0, len(rows))
+	for _, row := range rows {
+		item := &snitchv1.ReportEvidence{
+			Url: row.Url,
+		}
+		if row.ContentType.Valid {
+			item.ContentType = &row.ContentType.String
+		}
+		if row.MessageLink.Valid {
+			item.MessageLink = &row.MessageLink.String
+		}
+		if row.MessageContent.Valid {
+			item.MessageContent = &row.MessageContent.String
+		}
+		if row.MessageAuthorID.Valid {
+			item.MessageAuthorId = &row.MessageAuthorID.String
+		}
+		if row.ChannelID.Valid {
+			item.ChannelId = &row.ChannelID.String
+		}
+		evidence = append(evidence, item)
+	}
+	return evidence
+}
+
+// rollback rolls back a transaction that was not committed, logging unexpected failures
+func (r *ReportRepository) rollback(tx *sql.Tx, groupID string) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		r.service.logger.Warn("Failed to roll back transaction", "group_id", groupID, "error", err)
+	}
+}
+
 // NewReportRepository creates a new ReportRepository
 func NewReportRepository(service *DatabaseService) *ReportRepository {
 	return &ReportRepository{
@@ -33,7 +264,13 @@ func (r *ReportRepository) CreateReport(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
 	}
 
-	queries := groupdb.New(db)
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer r.rollback(tx, req.Msg.GroupId)
+
+	queries := groupdb.New(db).WithTx(tx)
 
 	// Ensure users and servers exist using sqlc
 	if err := queries.EnsureUserExists(ctx, req.Msg.UserId); err != nil {
@@ -52,17 +289,52 @@ func (r *ReportRepository) CreateReport(
 		ReporterID:     req.Msg.ReporterId,
 		ReportedUserID: req.Msg.UserId,
 		OriginServerID: req.Msg.ServerId,
+		Category:       nullString(req.Msg.Category),
 	})
 	if err != nil {
 		r.service.logger.Error("Failed to create report", "group_id", req.Msg.GroupId, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create report: %w", err))
 	}
 
+	// Attach evidence to the report in the same transaction
+	for _, evidence := range req.Msg.Evidence {
+		err := queries.CreateReportEvidence(ctx, groupdb.CreateReportEvidenceParams{
+			ReportID:        reportID,
+			Url:             evidence.Url,
+			ContentType:     nullString(evidence.ContentType),
+			MessageLink:     nullString(evidence.MessageLink),
+			MessageContent:  nullString(evidence.MessageContent),
+			MessageAuthorID: nullString(evidence.MessageAuthorId),
+			ChannelID:       nullString(evidence.ChannelId),
+		})
+		if err != nil {
+			r.service.logger.Error("Failed to create report evidence", "group_id", req.Msg.GroupId, "report_id", reportID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create report evidence: %w", err))
+		}
+	}
+
+	// The report's event is published from the outbox, so it goes out exactly when the report is committed
+	if req.Msg.Event != nil {
+		event := proto.Clone(req.Msg.Event).(*snitchv1.SubscribeResponse)
+		if reportCreated := event.GetReportCreated(); reportCreated != nil {
+			reportCreated.ReportId = reportID
+		}
+		if err := createOutboxEvent(ctx, queries, event); err != nil {
+			r.service.logger.Error("Failed to write report event to outbox", "group_id", req.Msg.GroupId, "report_id", reportID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write report event to outbox: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.service.logger.Error("Failed to commit report", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit report: %w", err))
+	}
+
 	response := &snitchv1.DatabaseServiceCreateReportResponse{
 		ReportId: reportID,
 	}
 
-	r.service.logger.Info("Created report", "group_id", req.Msg.GroupId, "report_id", reportID)
+	r.service.logger.Info("Created report", "group_id", req.Msg.GroupId, "report_id", reportID, "evidence_count", len(req.Msg.Evidence))
 	return connect.NewResponse(response), nil
 }
 
@@ -88,23 +360,19 @@ func (r *ReportRepository) GetReport(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get report: %w", err))
 	}
 
-	response := &snitchv1.DatabaseServiceGetReportResponse{
-		Id:         report.ReportID,
-		Reason:     report.ReportText,
-		ReporterId: report.ReporterID,
-		UserId:     report.ReportedUserID,
-		ServerId:   report.OriginServerID,
+	evidenceRows, err := queries.ListReportEvidence(ctx, report.ReportID)
+	if err != nil {
+		r.service.logger.Error("Failed to list report evidence", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list report evidence: %w", err))
 	}
 
-	// Handle nullable CreatedAt field
-	if report.CreatedAt.Valid {
-		response.CreatedAt = report.CreatedAt.String
-	}
+	response := reportFromRow(report)
+	response.Evidence = evidenceFromRows(evidenceRows)
 
 	return connect.NewResponse(response), nil
 }
 
-// ListReports lists reports from the group database using sqlc
+// ListReports lists reports from the group database matching the request filters
 func (r *ReportRepository) ListReports(
 	ctx context.Context,
 	req *connect.Request[snitchv1.DatabaseServiceListReportsRequest],
@@ -114,57 +382,37 @@ func (r *ReportRepository) ListReports(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
 	}
 
-	queries := groupdb.New(db)
-
-	var reportRows []groupdb.Report
-
-	// Use appropriate sqlc query based on whether user_id filter is provided
-	if req.Msg.UserId != nil {
-		reportRows, err = queries.ListReportsByUser(ctx, *req.Msg.UserId)
-	} else {
-		reportRows, err = queries.ListReports(ctx)
+	query, args, err := buildListReportsQuery(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		r.service.logger.Error("Failed to list reports", "group_id", req.Msg.GroupId, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list reports: %w", err))
 	}
 
-	// Apply limit and offset manually since sqlc doesn't support dynamic LIMIT/OFFSET
-	start := 0
-	if req.Msg.Offset != nil {
-		start = int(*req.Msg.Offset)
+	reportRows, err := scanReports(rows)
+	if err != nil {
+		r.service.logger.Error("Failed to list reports", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list reports: %w", err))
 	}
 
-	end := len(reportRows)
-	if req.Msg.Limit != nil {
-		end = start + int(*req.Msg.Limit)
-		if end > len(reportRows) {
-			end = len(reportRows)
-		}
+	reportIDs := make([]int64, 0, len(reportRows))
+	for _, reportRow := range reportRows {
+		reportIDs = append(reportIDs, reportRow.ReportID)
 	}
-
-	if start >= len(reportRows) {
-		reportRows = []groupdb.Report{}
-	} else {
-		reportRows = reportRows[start:end]
+	evidence, err := listEvidenceForReports(ctx, db, reportIDs)
+	if err != nil {
+		r.service.logger.Error("Failed to list report evidence", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list report evidence: %w", err))
 	}
 
 	var reports []*snitchv1.DatabaseServiceGetReportResponse
 	for _, reportRow := range reportRows {
-		report := &snitchv1.DatabaseServiceGetReportResponse{
-			Id:         reportRow.ReportID,
-			Reason:     reportRow.ReportText,
-			ReporterId: reportRow.ReporterID,
-			UserId:     reportRow.ReportedUserID,
-			ServerId:   reportRow.OriginServerID,
-		}
-
-		// Handle nullable CreatedAt field
-		if reportRow.CreatedAt.Valid {
-			report.CreatedAt = reportRow.CreatedAt.String
-		}
-
+		report := reportFromRow(reportRow)
+		report.Evidence = evidenceFromRows(evidence[reportRow.ReportID])
 		reports = append(reports, report)
 	}
 
@@ -175,29 +423,269 @@ func (r *ReportRepository) ListReports(
 	return connect.NewResponse(response), nil
 }
 
-// DeleteReport deletes a report from the group database using sqlc
+// reportAuditActionColumns maps audit actions to the values stored in the report_audit_log.action column
+var reportAuditActionColumns = map[snitchv1.ReportAuditAction]string{
+	snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_STATUS_CHANGED: "status_changed",
+	snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_DELETED:        "deleted",
+}
+
+// maxAuditDetailsLength mirrors the CHECK constraint on report_audit_log.details
+const maxAuditDetailsLength = 500
+
+// recordReportAudit writes an audit entry for an action taken on a report
+func recordReportAudit(
+	ctx context.Context,
+	queries *groupdb.Queries,
+	report groupdb.Report,
+	action snitchv1.ReportAuditAction,
+	serverID, userID, details string,
+) error {
+	if runes := []rune(details); len(runes) > maxAuditDetailsLength {
+		details = string(runes[:maxAuditDetailsLength])
+	}
+	return queries.CreateReportAuditEntry(ctx, groupdb.CreateReportAuditEntryParams{
+		ReportID:       report.ReportID,
+		Action:         reportAuditActionColumns[action],
+		ActorServerID:  serverID,
+		ActorUserID:    userID,
+		OriginServerID: report.OriginServerID,
+		ReportedUserID: report.ReportedUserID,
+		Details:        sql.NullString{String: details, Valid: details != ""},
+	})
+}
+
+// loadReport reads a report through the given queries, mapping a missing row to NotFound
+func (r *ReportRepository) loadReport(ctx context.Context, queries *groupdb.Queries, groupID string, reportID int64) (groupdb.Report, error) {
+	report, err := queries.GetReport(ctx, reportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return groupdb.Report{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("report not found: %d", reportID))
+		}
+		r.service.logger.Error("Failed to get report", "group_id", groupID, "report_id", reportID, "error", err)
+		return groupdb.Report{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get report: %w", err))
+	}
+	return report, nil
+}
+
+// UpdateReportStatus changes the lifecycle status of a report and records the change in the audit log
+func (r *ReportRepository) UpdateReportStatus(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceUpdateReportStatusRequest],
+) (*connect.Response[snitchv1.DatabaseServiceUpdateReportStatusResponse], error) {
+	status, err := reportStatusToColumn(req.Msg.Status)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	expectedStatus, err := reportStatusToColumn(req.Msg.ExpectedStatus)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expected status: %w", err))
+	}
+	if req.Msg.ServerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID is required"))
+	}
+
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer r.rollback(tx, req.Msg.GroupId)
+
+	queries := groupdb.New(db).WithTx(tx)
+
+	report, err := r.loadReport(ctx, queries, req.Msg.GroupId, req.Msg.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transition was validated against the expected status, so a change made meanwhile voids it
+	if report.Status != expectedStatus {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("report %d was changed meanwhile and is now %s", req.Msg.ReportId, report.Status))
+	}
+
+	// The status guard makes the update fail instead of overwriting a concurrent change
+	rowsAffected, err := queries.UpdateReportStatus(ctx, groupdb.UpdateReportStatusParams{
+		Status:         status,
+		ReportID:       req.Msg.ReportId,
+		ExpectedStatus: expectedStatus,
+	})
+	if err != nil {
+		r.service.logger.Error("Failed to update report status", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update report status: %w", err))
+	}
+	if rowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("report %d was changed meanwhile and is no longer %s", req.Msg.ReportId, expectedStatus))
+	}
+
+	details := fmt.Sprintf("%s -> %s", report.Status, status)
+	if err := recordReportAudit(ctx, queries, report, snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_STATUS_CHANGED, req.Msg.ServerId, req.Msg.UserId, details); err != nil {
+		r.service.logger.Error("Failed to record report audit entry", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record report audit entry: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.service.logger.Error("Failed to commit report status update", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit report status update: %w", err))
+	}
+
+	r.service.logger.Info("Updated report status", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "status", status, "server_id", req.Msg.ServerId)
+	return connect.NewResponse(&snitchv1.DatabaseServiceUpdateReportStatusResponse{
+		ReportId: req.Msg.ReportId,
+		Status:   req.Msg.Status,
+	}), nil
+}
+
+// DeleteReport deletes a report from the group database and records the deletion in the audit log
 func (r *ReportRepository) DeleteReport(
 	ctx context.Context,
 	req *connect.Request[snitchv1.DatabaseServiceDeleteReportRequest],
 ) (*connect.Response[snitchv1.DatabaseServiceDeleteReportResponse], error) {
+	if req.Msg.ServerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server ID is required"))
+	}
+
 	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
 	}
 
-	queries := groupdb.New(db)
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer r.rollback(tx, req.Msg.GroupId)
 
-	// Delete report using sqlc
-	affected, err := queries.DeleteReport(ctx, req.Msg.ReportId)
+	queries := groupdb.New(db).WithTx(tx)
+
+	// Snapshot the report first so the audit entry outlives it
+	report, err := r.loadReport(ctx, queries, req.Msg.GroupId, req.Msg.ReportId)
 	if err != nil {
+		return nil, err
+	}
+
+	// Remove evidence explicitly so it never outlives its report
+	if err := queries.DeleteReportEvidence(ctx, req.Msg.ReportId); err != nil {
+		r.service.logger.Error("Failed to delete report evidence", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete report evidence: %w", err))
+	}
+
+	// Delete report using sqlc
+	if _, err := queries.DeleteReport(ctx, req.Msg.ReportId); err != nil {
 		r.service.logger.Error("Failed to delete report", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete report: %w", err))
 	}
 
-	if affected == 0 {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("report not found: %d", req.Msg.ReportId))
+	if err := recordReportAudit(ctx, queries, report, snitchv1.ReportAuditAction_REPORT_AUDIT_ACTION_DELETED, req.Msg.ServerId, req.Msg.UserId, report.ReportText); err != nil {
+		r.service.logger.Error("Failed to record report audit entry", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record report audit entry: %w", err))
 	}
 
-	r.service.logger.Info("Deleted report", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId)
+	if req.Msg.Event != nil {
+		if err := createOutboxEvent(ctx, queries, req.Msg.Event); err != nil {
+			r.service.logger.Error("Failed to write report event to outbox", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write report event to outbox: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.service.logger.Error("Failed to commit report deletion", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit report deletion: %w", err))
+	}
+
+	r.service.logger.Info("Deleted report", "group_id", req.Msg.GroupId, "report_id", req.Msg.ReportId, "server_id", req.Msg.ServerId)
 	return connect.NewResponse(&snitchv1.DatabaseServiceDeleteReportResponse{ReportId: req.Msg.ReportId}), nil
 }
+
+// ListReportAuditLog returns the group's report audit entries, newest first
+func (r *ReportRepository) ListReportAuditLog(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListReportAuditLogRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListReportAuditLogResponse], error) {
+	if req.Msg.GetLimit() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must not be negative"))
+	}
+
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	// A negative limit means no limit in SQLite
+	limit := int64(-1)
+	if req.Msg.Limit != nil {
+		limit = int64(*req.Msg.Limit)
+	}
+
+	rows, err := groupdb.New(db).ListReportAuditEntries(ctx, limit)
+	if err != nil {
+		r.service.logger.Error("Failed to list report audit entries", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list report audit entries: %w", err))
+	}
+
+	entries := make([]*snitchv1.DatabaseServiceReportAuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := &snitchv1.DatabaseServiceReportAuditEntry{
+			AuditId:        row.AuditID,
+			ReportId:       row.ReportID,
+			ActorServerId:  row.ActorServerID,
+			ActorUserId:    row.ActorUserID,
+			OriginServerId: row.OriginServerID,
+			ReportedUserId: row.ReportedUserID,
+			CreatedAt:      row.CreatedAt.String,
+		}
+		for action, column := range reportAuditActionColumns {
+			if column == row.Action {
+				entry.Action = action
+			}
+		}
+		if row.Details.Valid {
+			entry.Details = &row.Details.String
+		}
+		entries = append(entries, entry)
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListReportAuditLogResponse{Entries: entries}), nil
+}
+
+// GetUserReportSummary counts the reports and bans against a user across the group using sqlc
+func (r *ReportRepository) GetUserReportSummary(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetUserReportSummaryRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetUserReportSummaryResponse], error) {
+	db, err := r.service.getGroupDB(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group database: %w", err))
+	}
+
+	queries := groupdb.New(db)
+
+	summary, err := queries.GetUserReportSummary(ctx, req.Msg.UserId)
+	if err != nil {
+		r.service.logger.Error("Failed to get user report summary", "group_id", req.Msg.GroupId, "user_id", req.Msg.UserId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user report summary: %w", err))
+	}
+
+	banCount, err := queries.CountUserBans(ctx, req.Msg.UserId)
+	if err != nil {
+		r.service.logger.Error("Failed to count user bans", "group_id", req.Msg.GroupId, "user_id", req.Msg.UserId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count user bans: %w", err))
+	}
+
+	resp := &snitchv1.DatabaseServiceGetUserReportSummaryResponse{
+		ReportCount:     summary.ReportCount,
+		OpenReportCount: summary.OpenReportCount,
+		ServerCount:     summary.ServerCount,
+		BanCount:        banCount,
+	}
+	if summary.LastReportedAt != "" {
+		resp.LastReportedAt = &summary.LastReportedAt
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildListReportsQuery(t *testing.T) {
+	reporterID := "reporter"
+	serverID := "server"
+	limit := int32(10)
+	offset := int32(20)
+	after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := after.Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name  string
+		msg   *snitchv1.DatabaseServiceListReportsRequest
+		query string
+		args  []any
+	}{
+		{
+			name:  "no filters",
+			msg:   &snitchv1.DatabaseServiceListReportsRequest{},
+			query: "SELECT " + reportColumns + " FROM reports ORDER BY created_at DESC, report_id DESC LIMIT ? OFFSET ?",
+			args:  []any{int64(-1), int64(0)},
+		},
+		{
+			name: "all filters",
+			msg: &snitchv1.DatabaseServiceListReportsRequest{
+				UserId:         new(string),
+				ReporterId:     &reporterID,
+				OriginServerId: &serverID,
+				Status:         snitchv1.ReportStatus_REPORT_STATUS_UNDER_REVIEW.Enum(),
+				CreatedAfter:   timestamppb.New(after),
+				CreatedBefore:  timestamppb.New(before),
+				Limit:          &limit,
+				Offset:         &offset,
+			},
+			query: "SELECT " + reportColumns + " FROM reports" +
+				" WHERE reported_user_id = ? AND reporter_id = ? AND origin_server_id = ? AND status = ? AND created_at >= ? AND created_at < ?" +
+				" ORDER BY created_at DESC, report_id DESC LIMIT ? OFFSET ?",
+			args: []any{"", "reporter", "server", "under_review", "2025-01-01 00:00:00", "2025-01-08 00:00:00", int64(10), int64(20)},
+		},
+		{
+			name:  "reporter only",
+			msg:   &snitchv1.DatabaseServiceListReportsRequest{ReporterId: &reporterID},
+			query: "SELECT " + reportColumns + " FROM reports WHERE reporter_id = ? ORDER BY created_at DESC, report_id DESC LIMIT ? OFFSET ?",
+			args:  []any{"reporter", int64(-1), int64(0)},
+		},
+	}
+
+	for _, test := range tests {
+		query, args, err := buildListReportsQuery(test.msg)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if query != test.query {
+			t.Errorf("%s: query = %q, expected %q", test.name, query, test.query)
+		}
+		if !slices.Equal(args, test.args) {
+			t.Errorf("%s: args = %v, expected %v", test.name, args, test.args)
+		}
+	}
+}
+
+func TestBuildListReportsQueryRejectsInvalidFilters(t *testing.T) {
+	negative := int32(-1)
+
+	for _, msg := range []*snitchv1.DatabaseServiceListReportsRequest{
+		{Limit: &negative},
+		{Offset: &negative},
+		{Status: snitchv1.ReportStatus_REPORT_STATUS_UNSPECIFIED.Enum()},
+	} {
+		if _, _, err := buildListReportsQuery(msg); err == nil {
+			t.Errorf("buildListReportsQuery(%v) expected an error", msg)
+		}
+	}
+}
+
+func TestUpdateReportStatusRejectsConcurrentChange(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	createResp, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+		GroupId:    "group-1",
+		UserId:     "user-1",
+		ReporterId: "user-2",
+		ServerId:   "server-1",
+		Reason:     "spam",
+	}))
+	if err != nil {
+		t.Fatalf("CreateReport failed: %v", err)
+	}
+
+	// Two moderators both saw the report open; the first to act wins
+	updateStatus := func(status snitchv1.ReportStatus) error {
+		_, err := service.UpdateReportStatus(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdateReportStatusRequest{
+			GroupId:        "group-1",
+			ReportId:       createResp.Msg.ReportId,
+			Status:         status,
+			ServerId:       "server-1",
+			UserId:         "moderator",
+			ExpectedStatus: snitchv1.ReportStatus_REPORT_STATUS_OPEN,
+		}))
+		return err
+	}
+	if err := updateStatus(snitchv1.ReportStatus_REPORT_STATUS_RESOLVED); err != nil {
+		t.Fatalf("UpdateReportStatus failed: %v", err)
+	}
+	if err := updateStatus(snitchv1.ReportStatus_REPORT_STATUS_DISMISSED); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition moving a resolved report to dismissed, got %v", err)
+	}
+
+	getResp, err := service.GetReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetReportRequest{
+		GroupId:  "group-1",
+		ReportId: createResp.Msg.ReportId,
+	}))
+	if err != nil {
+		t.Fatalf("GetReport failed: %v", err)
+	}
+	if getResp.Msg.Status != snitchv1.ReportStatus_REPORT_STATUS_RESOLVED {
+		t.Errorf("Expected the report to stay resolved, got %s", getResp.Msg.Status)
+	}
+}
+
+func TestListReportsLoadsEvidencePerReport(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	evidenceURLs := map[string][]string{
+		"user-1": {"https://example.com/1a", "https://example.com/1b"},
+		"user-2": nil,
+		"user-3": {"https://example.com/3a"},
+	}
+	for _, userID := range []string{"user-1", "user-2", "user-3"} {
+		var evidence []*snitchv1.ReportEvidence
+		for _, url := range evidenceURLs[userID] {
+			evidence = append(evidence, &snitchv1.ReportEvidence{Url: url})
+		}
+		if _, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+			GroupId:    "group-1",
+			UserId:     userID,
+			ReporterId: "reporter",
+			ServerId:   "server-1",
+			Reason:     "spam",
+			Evidence:   evidence,
+		})); err != nil {
+			t.Fatalf("CreateReport failed: %v", err)
+		}
+	}
+
+	listResp, err := service.ListReports(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListReportsRequest{GroupId: "group-1"}))
+	if err != nil {
+		t.Fatalf("ListReports failed: %v", err)
+	}
+	if len(listResp.Msg.Reports) != 3 {
+		t.Fatalf("Expected 3 reports, got %d", len(listResp.Msg.Reports))
+	}
+	for _, report := range listResp.Msg.Reports {
+		var urls []string
+		for _, evidence := range report.Evidence {
+			urls = append(urls, evidence.Url)
+		}
+		if !slices.Equal(urls, evidenceURLs[report.UserId]) {
+			t.Errorf("Expected evidence %v for the report on %s, got %v", evidenceURLs[report.UserId], report.UserId, urls)
+		}
+	}
+}
+
+func TestGetUserReportSummary(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1", "server-2")
+
+	var reportIDs []int64
+	for _, serverID := range []string{"server-1", "server-1", "server-2"} {
+		createResp, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+			GroupId:    "group-1",
+			UserId:     "user-1",
+			ReporterId: "reporter",
+			ServerId:   serverID,
+			Reason:     "spam",
+		}))
+		if err != nil {
+			t.Fatalf("CreateReport failed: %v", err)
+		}
+		reportIDs = append(reportIDs, createResp.Msg.ReportId)
+	}
+	if _, err := service.UpdateReportStatus(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdateReportStatusRequest{
+		GroupId:        "group-1",
+		ReportId:       reportIDs[0],
+		Status:         snitchv1.ReportStatus_REPORT_STATUS_RESOLVED,
+		ServerId:       "server-1",
+		UserId:         "moderator",
+		ExpectedStatus: snitchv1.ReportStatus_REPORT_STATUS_OPEN,
+	})); err != nil {
+		t.Fatalf("UpdateReportStatus failed: %v", err)
+	}
+	if _, err := service.CreateBan(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateBanRequest{
+		GroupId:  "group-1",
+		UserId:   "user-1",
+		ServerId: "server-2",
+	})); err != nil {
+		t.Fatalf("CreateBan failed: %v", err)
+	}
+
+	summaryResp, err := service.GetUserReportSummary(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetUserReportSummaryRequest{
+		GroupId: "group-1",
+		UserId:  "user-1",
+	}))
+	if err != nil {
+		t.Fatalf("GetUserReportSummary failed: %v", err)
+	}
+	summary := summaryResp.Msg
+	if summary.ReportCount != 3 || summary.OpenReportCount != 2 || summary.ServerCount != 2 || summary.BanCount != 1 {
+		t.Errorf("Expected 3 reports, 2 open, from 2 servers and 1 ban, got %v", summary)
+	}
+	if summary.LastReportedAt == nil {
+		t.Error("Expected the time of the last report")
+	}
+
+	summaryResp, err = service.GetUserReportSummary(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetUserReportSummaryRequest{
+		GroupId: "group-1",
+		UserId:  "user-2",
+	}))
+	if err != nil {
+		t.Fatalf("GetUserReportSummary failed: %v", err)
+	}
+	if summaryResp.Msg.ReportCount != 0 || summaryResp.Msg.LastReportedAt != nil {
+		t.Errorf("Expected no reports against a user who was never reported, got %v", summaryResp.Msg)
+	}
+}
+
+func TestCreateReportStoresEvidence(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	contentType := "image/png"
+	messageLink := "https://discord.com/channels/1/2/3"
+	createResp, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+		GroupId:    "group-1",
+		UserId:     "user-1",
+		ReporterId: "reporter",
+		ServerId:   "server-1",
+		Reason:     "spam",
+		Evidence: []*snitchv1.ReportEvidence{
+			{Url: "https://cdn.discordapp.com/attachments/1/2/image.png", ContentType: &contentType, MessageLink: &messageLink},
+			{Url: "https://example.com/log.txt"},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("CreateReport failed: %v", err)
+	}
+
+	getResp, err := service.GetReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetReportRequest{
+		GroupId:  "group-1",
+		ReportId: createResp.Msg.ReportId,
+	}))
+	if err != nil {
+		t.Fatalf("GetReport failed: %v", err)
+	}
+	evidence := getResp.Msg.Evidence
+	if len(evidence) != 2 {
+		t.Fatalf("Expected 2 pieces of evidence, got %d", len(evidence))
+	}
+	if evidence[0].GetContentType() != contentType || evidence[0].GetMessageLink() != messageLink {
+		t.Errorf("Expected the attachment's content type and message link to be kept, got %v", evidence[0])
+	}
+	if evidence[1].Url != "https://example.com/log.txt" || evidence[1].ContentType != nil || evidence[1].MessageLink != nil {
+		t.Errorf("Expected a plain link without optional fields, got %v", evidence[1])
+	}
+}
+
+func TestCreateReportRollsBackOnInvalidEvidence(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	// The evidence table rejects URLs over its length limit, which must not leave a report without its evidence
+	if _, err := service.CreateReport(ctx, connect.NewRequest(&snitchv1.DatabaseServiceCreateReportRequest{
+		GroupId:    "group-1",
+		UserId:     "user-1",
+		ReporterId: "reporter",
+		ServerId:   "server-1",
+		Reason:     "spam",
+		Evidence: []*snitchv1.ReportEvidence{
+			{Url: "https://example.com/ok"},
+			{Url: "https://example.com/" + strings.Repeat("a", 500)},
+		},
+	})); err == nil {
+		t.Fatal("Expected CreateReport to fail with evidence over the length limit")
+	}
+
+	listResp, err := service.ListReports(ctx, connect.NewRequest(&snitchv1.DatabaseServiceListReportsRequest{GroupId: "group-1"}))
+	if err != nil {
+		t.Fatalf("ListReports failed: %v", err)
+	}
+	if len(listResp.Msg.Reports) != 0 {
+		t.Errorf("Expected the report to be rolled back, got %v", listResp.Msg.Reports)
+	}
+}
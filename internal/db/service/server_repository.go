@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"snitch/internal/db/sqlc/gen/metadata"
 	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
@@ -16,6 +19,54 @@ type ServerRepository struct {
 	service *DatabaseService
 }
 
+// banPolicyColumns maps API ban policies to the values stored in the servers.ban_policy column
+var banPolicyColumns = map[snitchv1.BanPolicy]string{
+	snitchv1.BanPolicy_BAN_POLICY_ANNOUNCE: "announce",
+	snitchv1.BanPolicy_BAN_POLICY_APPROVE:  "approve",
+	snitchv1.BanPolicy_BAN_POLICY_AUTO:     "auto",
+}
+
+// banPolicyToColumn converts an API ban policy into its column value
+func banPolicyToColumn(policy snitchv1.BanPolicy) (string, error) {
+	column, ok := banPolicyColumns[policy]
+	if !ok {
+		return "", fmt.Errorf("invalid ban policy: %s", policy)
+	}
+	return column, nil
+}
+
+// banPolicyFromColumn converts a stored ban policy back into its API value
+func banPolicyFromColumn(column string) snitchv1.BanPolicy {
+	for policy, value := range banPolicyColumns {
+		if value == column {
+			return policy
+		}
+	}
+	return snitchv1.BanPolicy_BAN_POLICY_UNSPECIFIED
+}
+
+// outputChannelToColumn converts a channel ID into its servers.output_channel value; an empty ID clears the channel
+func outputChannelToColumn(channelID string) (int64, error) {
+	if channelID == "" {
+		return 0, nil
+	}
+
+	outputChannel, err := strconv.ParseInt(channelID, 10, 64)
+	if err != nil || outputChannel <= 0 {
+		return 0, fmt.Errorf("invalid output channel ID: %s", channelID)
+	}
+	return outputChannel, nil
+}
+
+// outputChannelFromColumn converts a stored output channel back into a channel ID, or nil if none is set
+func outputChannelFromColumn(outputChannel int64) *string {
+	if outputChannel == 0 {
+		return nil
+	}
+	channelID := strconv.FormatInt(outputChannel, 10)
+	return &channelID
+}
+
 // NewServerRepository creates a new ServerRepository
 func NewServerRepository(service *DatabaseService) *ServerRepository {
 	return &ServerRepository{
@@ -31,8 +82,9 @@ func (r *ServerRepository) CreateGroup(
 	queries := metadata.New(r.service.metadataDB)
 
 	err := queries.CreateGroup(ctx, metadata.CreateGroupParams{
-		GroupID:   req.Msg.GroupId,
-		GroupName: req.Msg.GroupName,
+		GroupID:       req.Msg.GroupId,
+		GroupName:     req.Msg.GroupName,
+		OwnerServerID: sql.NullString{String: req.Msg.OwnerServerId, Valid: req.Msg.OwnerServerId != ""},
 	})
 	if err != nil {
 		r.service.logger.Error("Failed to create group", "group_id", req.Msg.GroupId, "error", err)
@@ -43,29 +95,95 @@ func (r *ServerRepository) CreateGroup(
 	return connect.NewResponse(&snitchv1.CreateGroupResponse{GroupId: req.Msg.GroupId}), nil
 }
 
-// FindGroupByServer finds the group ID for a given server ID using sqlc
+// FindGroupByServer finds the group of a server using sqlc. A server in several groups must pick one
+// with the group selector, which matches a group ID or, ignoring case, a group name.
 func (r *ServerRepository) FindGroupByServer(
 	ctx context.Context,
 	req *connect.Request[snitchv1.FindGroupByServerRequest],
 ) (*connect.Response[snitchv1.FindGroupByServerResponse], error) {
 	queries := metadata.New(r.service.metadataDB)
 
-	groupID, err := queries.FindGroupByServer(ctx, req.Msg.ServerId)
+	memberships, err := queries.ListServerGroups(ctx, req.Msg.ServerId)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
-		}
 		r.service.logger.Error("Failed to find group by server", "server_id", req.Msg.ServerId, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to find group: %w", err))
 	}
+	if len(memberships) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
+	}
+
+	membership, err := selectGroup(memberships, req.Msg.GetGroupSelector())
+	if err != nil {
+		return nil, err
+	}
 
 	response := &snitchv1.FindGroupByServerResponse{
-		GroupId: groupID,
+		GroupId:   membership.GroupID,
+		Role:      snitchv1.GroupRole(membership.PermissionLevel),
+		GroupName: membership.GroupName,
 	}
 
 	return connect.NewResponse(response), nil
 }
 
+// selectGroup picks the membership a group selector refers to, or the only one when there is no selector
+func selectGroup(memberships []metadata.ListServerGroupsRow, selector string) (metadata.ListServerGroupsRow, error) {
+	if selector == "" {
+		if len(memberships) > 1 {
+			return metadata.ListServerGroupsRow{}, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("server is in %d groups, choose one with the group option", len(memberships)))
+		}
+		return memberships[0], nil
+	}
+
+	var matches []metadata.ListServerGroupsRow
+	for _, membership := range memberships {
+		// IDs are unique, so an ID match wins over groups that happen to share it as a name
+		if membership.GroupID == selector {
+			return membership, nil
+		}
+		if strings.EqualFold(membership.GroupName, selector) {
+			matches = append(matches, membership)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return metadata.ListServerGroupsRow{}, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("server is not in a group named %q", selector))
+	case 1:
+		return matches[0], nil
+	default:
+		return metadata.ListServerGroupsRow{}, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("server is in %d groups named %q, choose one by its ID", len(matches), selector))
+	}
+}
+
+// ListServerGroups lists every group a server belongs to using sqlc
+func (r *ServerRepository) ListServerGroups(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceListServerGroupsRequest],
+) (*connect.Response[snitchv1.DatabaseServiceListServerGroupsResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	memberships, err := queries.ListServerGroups(ctx, req.Msg.ServerId)
+	if err != nil {
+		r.service.logger.Error("Failed to list server groups", "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list groups: %w", err))
+	}
+
+	groups := make([]*snitchv1.ServerGroup, 0, len(memberships))
+	for _, membership := range memberships {
+		groups = append(groups, &snitchv1.ServerGroup{
+			GroupId:   membership.GroupID,
+			GroupName: membership.GroupName,
+			Role:      snitchv1.GroupRole(membership.PermissionLevel),
+		})
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceListServerGroupsResponse{Groups: groups}), nil
+}
+
 // AddServerToGroup adds a server to a group using sqlc
 func (r *ServerRepository) AddServerToGroup(
 	ctx context.Context,
@@ -73,14 +191,12 @@ func (r *ServerRepository) AddServerToGroup(
 ) (*connect.Response[snitchv1.AddServerToGroupResponse], error) {
 	queries := metadata.New(r.service.metadataDB)
 
-	// Use default values for output_channel and permission_level as in the original queries
-	err := queries.AddServerToGroup(ctx, metadata.AddServerToGroupParams{
-		ServerID:        req.Msg.ServerId,
-		OutputChannel:   69420,
-		GroupID:         req.Msg.GroupId,
-		PermissionLevel: 777,
-	})
-	if err != nil {
+	role := req.Msg.Role
+	if role == snitchv1.GroupRole_GROUP_ROLE_UNSPECIFIED {
+		role = snitchv1.GroupRole_GROUP_ROLE_MEMBER
+	}
+
+	if err := addServerToGroup(ctx, queries, req.Msg.ServerId, req.Msg.GroupId, role); err != nil {
 		r.service.logger.Error("Failed to add server to group",
 			"server_id", req.Msg.ServerId,
 			"group_id", req.Msg.GroupId,
@@ -90,11 +206,182 @@ func (r *ServerRepository) AddServerToGroup(
 
 	r.service.logger.Info("Added server to group",
 		"server_id", req.Msg.ServerId,
-		"group_id", req.Msg.GroupId)
+		"group_id", req.Msg.GroupId,
+		"role", role)
 
 	return connect.NewResponse(&snitchv1.AddServerToGroupResponse{ServerId: req.Msg.ServerId}), nil
 }
 
+// addServerToGroup adds a membership row for a server. Server settings apply to the whole guild, so a server
+// already in another group brings its settings along; otherwise the column defaults apply and the output
+// channel stays unset until configured.
+func addServerToGroup(ctx context.Context, queries *metadata.Queries, serverID, groupID string, role snitchv1.GroupRole) error {
+	params := metadata.AddServerToGroupParams{
+		ServerID:           serverID,
+		OutputChannel:      0,
+		GroupID:            groupID,
+		PermissionLevel:    int64(role),
+		BanPolicy:          banPolicyColumns[snitchv1.BanPolicy_BAN_POLICY_ANNOUNCE],
+		WatchlistThreshold: 1,
+	}
+
+	serverConfig, err := queries.GetServerConfig(ctx, serverID)
+	switch {
+	case err == nil:
+		params.OutputChannel = serverConfig.OutputChannel
+		params.BanPolicy = serverConfig.BanPolicy
+		params.WatchlistThreshold = serverConfig.WatchlistThreshold
+	case !errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("failed to get existing server settings: %w", err)
+	}
+
+	return queries.AddServerToGroup(ctx, params)
+}
+
+// RemoveServerFromGroup removes a server and its settings from a group using sqlc
+func (r *ServerRepository) RemoveServerFromGroup(
+	ctx context.Context,
+	req *connect.Request[snitchv1.RemoveServerFromGroupRequest],
+) (*connect.Response[snitchv1.RemoveServerFromGroupResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	rowsAffected, err := queries.RemoveServerFromGroup(ctx, metadata.RemoveServerFromGroupParams{
+		ServerID: req.Msg.ServerId,
+		GroupID:  req.Msg.GroupId,
+	})
+	if err != nil {
+		r.service.logger.Error("Failed to remove server from group",
+			"server_id", req.Msg.ServerId,
+			"group_id", req.Msg.GroupId,
+			"error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove server from group: %w", err))
+	}
+	if rowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server %s is not in group %s", req.Msg.ServerId, req.Msg.GroupId))
+	}
+
+	r.service.logger.Info("Removed server from group",
+		"server_id", req.Msg.ServerId,
+		"group_id", req.Msg.GroupId)
+
+	return connect.NewResponse(&snitchv1.RemoveServerFromGroupResponse{ServerId: req.Msg.ServerId}), nil
+}
+
+// SetServerRole changes the role of a server within its group using sqlc
+func (r *ServerRepository) SetServerRole(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceSetServerRoleRequest],
+) (*connect.Response[snitchv1.DatabaseServiceSetServerRoleResponse], error) {
+	switch req.Msg.Role {
+	case snitchv1.GroupRole_GROUP_ROLE_OBSERVER, snitchv1.GroupRole_GROUP_ROLE_MEMBER, snitchv1.GroupRole_GROUP_ROLE_ADMIN:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot set role %s, ownership is transferred instead", req.Msg.Role))
+	}
+
+	queries := metadata.New(r.service.metadataDB)
+
+	currentRole, err := queries.GetServerRole(ctx, metadata.GetServerRoleParams{
+		ServerID: req.Msg.ServerId,
+		GroupID:  req.Msg.GroupId,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server %s is not in group %s", req.Msg.ServerId, req.Msg.GroupId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get server role: %w", err))
+	}
+	if snitchv1.GroupRole(currentRole) == snitchv1.GroupRole_GROUP_ROLE_OWNER {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the owner's role changes only by transferring ownership"))
+	}
+
+	if _, err := queries.UpdateServerRole(ctx, metadata.UpdateServerRoleParams{
+		PermissionLevel: int64(req.Msg.Role),
+		ServerID:        req.Msg.ServerId,
+		GroupID:         req.Msg.GroupId,
+	}); err != nil {
+		r.service.logger.Error("Failed to update server role", "server_id", req.Msg.ServerId, "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server role: %w", err))
+	}
+
+	r.service.logger.Info("Updated server role", "server_id", req.Msg.ServerId, "group_id", req.Msg.GroupId, "role", req.Msg.Role)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceSetServerRoleResponse{
+		ServerId: req.Msg.ServerId,
+		Role:     req.Msg.Role,
+	}), nil
+}
+
+// TransferGroupOwnership hands a group to another of its servers, demoting the previous owner to admin
+func (r *ServerRepository) TransferGroupOwnership(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceTransferGroupOwnershipRequest],
+) (*connect.Response[snitchv1.DatabaseServiceTransferGroupOwnershipResponse], error) {
+	if req.Msg.FromServerId == req.Msg.ToServerId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("server already owns the group"))
+	}
+
+	tx, err := r.service.metadataDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	queries := metadata.New(r.service.metadataDB).WithTx(tx)
+
+	group, err := queries.GetGroup(ctx, req.Msg.GroupId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("group not found: %s", req.Msg.GroupId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group: %w", err))
+	}
+	if !group.OwnerServerID.Valid || group.OwnerServerID.String != req.Msg.FromServerId {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the group's owner can transfer it"))
+	}
+
+	if _, err := queries.GetServerRole(ctx, metadata.GetServerRoleParams{
+		ServerID: req.Msg.ToServerId,
+		GroupID:  req.Msg.GroupId,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server %s is not in group %s", req.Msg.ToServerId, req.Msg.GroupId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get server role: %w", err))
+	}
+
+	if _, err := queries.UpdateGroupOwner(ctx, metadata.UpdateGroupOwnerParams{
+		OwnerServerID: sql.NullString{String: req.Msg.ToServerId, Valid: true},
+		GroupID:       req.Msg.GroupId,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update group owner: %w", err))
+	}
+	roles := map[string]snitchv1.GroupRole{
+		req.Msg.FromServerId: snitchv1.GroupRole_GROUP_ROLE_ADMIN,
+		req.Msg.ToServerId:   snitchv1.GroupRole_GROUP_ROLE_OWNER,
+	}
+	for serverID, role := range roles {
+		if _, err := queries.UpdateServerRole(ctx, metadata.UpdateServerRoleParams{
+			PermissionLevel: int64(role),
+			ServerID:        serverID,
+			GroupID:         req.Msg.GroupId,
+		}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server role: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit ownership transfer: %w", err))
+	}
+
+	r.service.logger.Info("Transferred group ownership", "group_id", req.Msg.GroupId, "from", req.Msg.FromServerId, "to", req.Msg.ToServerId)
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceTransferGroupOwnershipResponse{
+		OwnerServerId: req.Msg.ToServerId,
+	}), nil
+}
+
 // ListServers retrieves all servers for a given group from the metadata database using sqlc
 func (r *ServerRepository) ListServers(
 	ctx context.Context,
@@ -113,6 +400,7 @@ func (r *ServerRepository) ListServers(
 		server := &snitchv1.ServerEntry{
 			ServerId: serverRow.ServerID,
 			GroupId:  serverRow.GroupID,
+			Role:     snitchv1.GroupRole(serverRow.PermissionLevel),
 		}
 		servers = append(servers, server)
 	}
@@ -123,3 +411,172 @@ func (r *ServerRepository) ListServers(
 
 	return connect.NewResponse(response), nil
 }
+
+// GetServerConfig retrieves the settings of a server from the metadata database using sqlc
+func (r *ServerRepository) GetServerConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetServerConfigRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetServerConfigResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	serverConfig, err := queries.GetServerConfig(ctx, req.Msg.ServerId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
+		}
+		r.service.logger.Error("Failed to get server config", "server_id", req.Msg.ServerId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get server config: %w", err))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceGetServerConfigResponse{
+		Config: &snitchv1.ServerConfig{
+			BanPolicy:          banPolicyFromColumn(serverConfig.BanPolicy),
+			OutputChannelId:    outputChannelFromColumn(serverConfig.OutputChannel),
+			WatchlistThreshold: int32(serverConfig.WatchlistThreshold),
+		},
+	}), nil
+}
+
+// UpdateServerConfig changes the settings set on the request for a server using sqlc
+func (r *ServerRepository) UpdateServerConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceUpdateServerConfigRequest],
+) (*connect.Response[snitchv1.DatabaseServiceUpdateServerConfigResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	if req.Msg.BanPolicy != nil {
+		banPolicy, err := banPolicyToColumn(*req.Msg.BanPolicy)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		rowsAffected, err := queries.UpdateServerBanPolicy(ctx, metadata.UpdateServerBanPolicyParams{
+			BanPolicy: banPolicy,
+			ServerID:  req.Msg.ServerId,
+		})
+		if err != nil {
+			r.service.logger.Error("Failed to update server ban policy", "server_id", req.Msg.ServerId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server config: %w", err))
+		}
+		if rowsAffected == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
+		}
+
+		r.service.logger.Info("Updated server ban policy", "server_id", req.Msg.ServerId, "ban_policy", banPolicy)
+	}
+
+	if req.Msg.OutputChannelId != nil {
+		outputChannel, err := outputChannelToColumn(*req.Msg.OutputChannelId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		rowsAffected, err := queries.UpdateServerOutputChannel(ctx, metadata.UpdateServerOutputChannelParams{
+			OutputChannel: outputChannel,
+			ServerID:      req.Msg.ServerId,
+		})
+		if err != nil {
+			r.service.logger.Error("Failed to update server output channel", "server_id", req.Msg.ServerId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server config: %w", err))
+		}
+		if rowsAffected == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
+		}
+
+		r.service.logger.Info("Updated server output channel", "server_id", req.Msg.ServerId, "output_channel", outputChannel)
+	}
+
+	if req.Msg.WatchlistThreshold != nil {
+		if *req.Msg.WatchlistThreshold < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("watchlist threshold must not be negative"))
+		}
+
+		rowsAffected, err := queries.UpdateServerWatchlistThreshold(ctx, metadata.UpdateServerWatchlistThresholdParams{
+			WatchlistThreshold: int64(*req.Msg.WatchlistThreshold),
+			ServerID:           req.Msg.ServerId,
+		})
+		if err != nil {
+			r.service.logger.Error("Failed to update server watchlist threshold", "server_id", req.Msg.ServerId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server config: %w", err))
+		}
+		if rowsAffected == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found: %s", req.Msg.ServerId))
+		}
+
+		r.service.logger.Info("Updated server watchlist threshold", "server_id", req.Msg.ServerId, "watchlist_threshold", *req.Msg.WatchlistThreshold)
+	}
+
+	configResp, err := r.GetServerConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetServerConfigRequest{
+		ServerId: req.Msg.ServerId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceUpdateServerConfigResponse{
+		Config: configResp.Msg.Config,
+	}), nil
+}
+
+// GetGroupConfig retrieves the settings shared by every server of a group using sqlc
+func (r *ServerRepository) GetGroupConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceGetGroupConfigRequest],
+) (*connect.Response[snitchv1.DatabaseServiceGetGroupConfigResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	joinRequiresApproval, err := queries.GetGroupJoinApproval(ctx, req.Msg.GroupId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("group not found: %s", req.Msg.GroupId))
+		}
+		r.service.logger.Error("Failed to get group config", "group_id", req.Msg.GroupId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group config: %w", err))
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceGetGroupConfigResponse{
+		Config: &snitchv1.GroupConfig{
+			JoinRequiresApproval: joinRequiresApproval != 0,
+		},
+	}), nil
+}
+
+// UpdateGroupConfig changes the settings set on the request for a group using sqlc
+func (r *ServerRepository) UpdateGroupConfig(
+	ctx context.Context,
+	req *connect.Request[snitchv1.DatabaseServiceUpdateGroupConfigRequest],
+) (*connect.Response[snitchv1.DatabaseServiceUpdateGroupConfigResponse], error) {
+	queries := metadata.New(r.service.metadataDB)
+
+	if req.Msg.JoinRequiresApproval != nil {
+		var joinRequiresApproval int64
+		if *req.Msg.JoinRequiresApproval {
+			joinRequiresApproval = 1
+		}
+
+		rowsAffected, err := queries.UpdateGroupJoinApproval(ctx, metadata.UpdateGroupJoinApprovalParams{
+			JoinRequiresApproval: joinRequiresApproval,
+			GroupID:              req.Msg.GroupId,
+		})
+		if err != nil {
+			r.service.logger.Error("Failed to update group join approval", "group_id", req.Msg.GroupId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update group config: %w", err))
+		}
+		if rowsAffected == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("group not found: %s", req.Msg.GroupId))
+		}
+
+		r.service.logger.Info("Updated group join approval", "group_id", req.Msg.GroupId, "join_requires_approval", *req.Msg.JoinRequiresApproval)
+	}
+
+	configResp, err := r.GetGroupConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetGroupConfigRequest{
+		GroupId: req.Msg.GroupId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&snitchv1.DatabaseServiceUpdateGroupConfigResponse{
+		Config: configResp.Msg.Config,
+	}), nil
+}
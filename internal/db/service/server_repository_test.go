@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+
+	"snitch/internal/db/sqlc/gen/metadata"
+	snitchv1 "snitch/pkg/proto/gen/snitch/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSelectGroup(t *testing.T) {
+	regional := metadata.ListServerGroupsRow{GroupID: "group-1", GroupName: "Regional"}
+	topic := metadata.ListServerGroupsRow{GroupID: "group-2", GroupName: "Topic"}
+	topicCopy := metadata.ListServerGroupsRow{GroupID: "group-3", GroupName: "topic"}
+
+	tests := []struct {
+		name        string
+		memberships []metadata.ListServerGroupsRow
+		selector    string
+		groupID     string
+		code        connect.Code
+	}{
+		{name: "only group", memberships: []metadata.ListServerGroupsRow{regional}, groupID: "group-1"},
+		{name: "several groups need a selector", memberships: []metadata.ListServerGroupsRow{regional, topic}, code: connect.CodeFailedPrecondition},
+		{name: "by ID", memberships: []metadata.ListServerGroupsRow{regional, topic}, selector: "group-2", groupID: "group-2"},
+		{name: "by name ignoring case", memberships: []metadata.ListServerGroupsRow{regional, topic}, selector: "regional", groupID: "group-1"},
+		{name: "ambiguous name", memberships: []metadata.ListServerGroupsRow{regional, topic, topicCopy}, selector: "Topic", code: connect.CodeFailedPrecondition},
+		{name: "ID beats shared name", memberships: []metadata.ListServerGroupsRow{topic, topicCopy}, selector: "group-3", groupID: "group-3"},
+		{name: "unknown group", memberships: []metadata.ListServerGroupsRow{regional}, selector: "Topic", code: connect.CodeNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			membership, err := selectGroup(test.memberships, test.selector)
+			if test.code != 0 {
+				if connect.CodeOf(err) != test.code {
+					t.Fatalf("got error %v, want code %s", err, test.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if membership.GroupID != test.groupID {
+				t.Errorf("got group %s, want %s", membership.GroupID, test.groupID)
+			}
+		})
+	}
+}
+
+func TestServerRepository_UpdateOutputChannel(t *testing.T) {
+	service, _ := newTestDatabaseService(t)
+	ctx := t.Context()
+	createTestGroup(t, service, "group-1", "Regional", "server-1")
+
+	updateOutputChannel := func(channelID string) (*snitchv1.ServerConfig, error) {
+		t.Helper()
+		updateResp, err := service.UpdateServerConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceUpdateServerConfigRequest{
+			ServerId:        "server-1",
+			OutputChannelId: proto.String(channelID),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return updateResp.Msg.Config, nil
+	}
+
+	getResp, err := service.GetServerConfig(ctx, connect.NewRequest(&snitchv1.DatabaseServiceGetServerConfigRequest{ServerId: "server-1"}))
+	if err != nil {
+		t.Fatalf("GetServerConfig failed: %v", err)
+	}
+	if getResp.Msg.Config.OutputChannelId != nil {
+		t.Errorf("Expected a new member to have no output channel, got %s", *getResp.Msg.Config.OutputChannelId)
+	}
+
+	config, err := updateOutputChannel("123456789012345678")
+	if err != nil {
+		t.Fatalf("UpdateServerConfig failed: %v", err)
+	}
+	if config.GetOutputChannelId() != "123456789012345678" {
+		t.Errorf("Expected output channel 123456789012345678, got %v", config.OutputChannelId)
+	}
+
+	config, err = updateOutputChannel("")
+	if err != nil {
+		t.Fatalf("UpdateServerConfig failed: %v", err)
+	}
+	if config.OutputChannelId != nil {
+		t.Errorf("Expected the output channel to be cleared, got %s", *config.OutputChannelId)
+	}
+
+	if _, err := updateOutputChannel("not-a-channel"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument for a malformed channel ID, got %v", err)
+	}
+}
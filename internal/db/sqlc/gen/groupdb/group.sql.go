@@ -10,16 +10,78 @@ import (
 	"database/sql"
 )
 
+const appendEvent = `-- name: AppendEvent :one
+INSERT INTO events (event_type, payload, idempotency_key) 
+VALUES (?, ?, ?) 
+ON CONFLICT(idempotency_key) DO NOTHING 
+RETURNING sequence
+`
+
+type AppendEventParams struct {
+	EventType      int64          `json:"event_type"`
+	Payload        []byte         `json:"payload"`
+	IdempotencyKey sql.NullString `json:"idempotency_key"`
+}
+
+// Event log queries
+func (q *Queries) AppendEvent(ctx context.Context, arg AppendEventParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, appendEvent, arg.EventType, arg.Payload, arg.IdempotencyKey)
+	var sequence int64
+	err := row.Scan(&sequence)
+	return sequence, err
+}
+
+const countUserBans = `-- name: CountUserBans :one
+SELECT COUNT(*) FROM bans WHERE user_id = ?
+`
+
+func (q *Queries) CountUserBans(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserBans, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createBan = `-- name: CreateBan :one
+INSERT INTO bans (user_id, server_id, reason) 
+VALUES (?, ?, ?) RETURNING ban_id
+`
+
+type CreateBanParams struct {
+	UserID   string         `json:"user_id"`
+	ServerID string         `json:"server_id"`
+	Reason   sql.NullString `json:"reason"`
+}
+
+// Ban queries
+func (q *Queries) CreateBan(ctx context.Context, arg CreateBanParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createBan, arg.UserID, arg.ServerID, arg.Reason)
+	var ban_id int64
+	err := row.Scan(&ban_id)
+	return ban_id, err
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO event_outbox (payload) VALUES (?)
+`
+
+// Event outbox queries
+func (q *Queries) CreateOutboxEvent(ctx context.Context, payload []byte) error {
+	_, err := q.db.ExecContext(ctx, createOutboxEvent, payload)
+	return err
+}
+
 const createReport = `-- name: CreateReport :one
-INSERT INTO reports (report_text, reporter_id, reported_user_id, origin_server_id) 
-VALUES (?, ?, ?, ?) RETURNING report_id
+INSERT INTO reports (report_text, reporter_id, reported_user_id, origin_server_id, category) 
+VALUES (?, ?, ?, ?, ?) RETURNING report_id
 `
 
 type CreateReportParams struct {
-	ReportText     string `json:"report_text"`
-	ReporterID     string `json:"reporter_id"`
-	ReportedUserID string `json:"reported_user_id"`
-	OriginServerID string `json:"origin_server_id"`
+	ReportText     string         `json:"report_text"`
+	ReporterID     string         `json:"reporter_id"`
+	ReportedUserID string         `json:"reported_user_id"`
+	OriginServerID string         `json:"origin_server_id"`
+	Category       sql.NullString `json:"category"`
 }
 
 func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (int64, error) {
@@ -28,12 +90,71 @@ func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (int
 		arg.ReporterID,
 		arg.ReportedUserID,
 		arg.OriginServerID,
+		arg.Category,
 	)
 	var report_id int64
 	err := row.Scan(&report_id)
 	return report_id, err
 }
 
+const createReportAuditEntry = `-- name: CreateReportAuditEntry :exec
+INSERT INTO report_audit_log (report_id, action, actor_server_id, actor_user_id, origin_server_id, reported_user_id, details) 
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateReportAuditEntryParams struct {
+	ReportID       int64          `json:"report_id"`
+	Action         string         `json:"action"`
+	ActorServerID  string         `json:"actor_server_id"`
+	ActorUserID    string         `json:"actor_user_id"`
+	OriginServerID string         `json:"origin_server_id"`
+	ReportedUserID string         `json:"reported_user_id"`
+	Details        sql.NullString `json:"details"`
+}
+
+// Report audit queries
+func (q *Queries) CreateReportAuditEntry(ctx context.Context, arg CreateReportAuditEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createReportAuditEntry,
+		arg.ReportID,
+		arg.Action,
+		arg.ActorServerID,
+		arg.ActorUserID,
+		arg.OriginServerID,
+		arg.ReportedUserID,
+		arg.Details,
+	)
+	return err
+}
+
+const createReportEvidence = `-- name: CreateReportEvidence :exec
+INSERT INTO report_evidence (report_id, url, content_type, message_link, message_content, message_author_id, channel_id) 
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateReportEvidenceParams struct {
+	ReportID        int64          `json:"report_id"`
+	Url             string         `json:"url"`
+	ContentType     sql.NullString `json:"content_type"`
+	MessageLink     sql.NullString `json:"message_link"`
+	MessageContent  sql.NullString `json:"message_content"`
+	MessageAuthorID sql.NullString `json:"message_author_id"`
+	ChannelID       sql.NullString `json:"channel_id"`
+}
+
+// Report evidence queries
+func (q *Queries) CreateReportEvidence(ctx context.Context, arg CreateReportEvidenceParams) error {
+	_, err := q.db.ExecContext(ctx, createReportEvidence,
+		arg.ReportID,
+		arg.Url,
+		arg.ContentType,
+		arg.MessageLink,
+		arg.MessageContent,
+		arg.MessageAuthorID,
+		arg.ChannelID,
+	)
+	return err
+}
+
 const createUserHistory = `-- name: CreateUserHistory :one
 INSERT INTO user_history (user_id, server_id, action, reason, evidence_url) 
 VALUES (?, ?, ?, ?, ?) RETURNING history_id
@@ -61,6 +182,15 @@ func (q *Queries) CreateUserHistory(ctx context.Context, arg CreateUserHistoryPa
 	return history_id, err
 }
 
+const deleteOutboxEvent = `-- name: DeleteOutboxEvent :exec
+DELETE FROM event_outbox WHERE outbox_id = ?
+`
+
+func (q *Queries) DeleteOutboxEvent(ctx context.Context, outboxID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteOutboxEvent, outboxID)
+	return err
+}
+
 const deleteReport = `-- name: DeleteReport :execrows
 DELETE FROM reports WHERE report_id = ?
 `
@@ -73,6 +203,15 @@ func (q *Queries) DeleteReport(ctx context.Context, reportID int64) (int64, erro
 	return result.RowsAffected()
 }
 
+const deleteReportEvidence = `-- name: DeleteReportEvidence :exec
+DELETE FROM report_evidence WHERE report_id = ?
+`
+
+func (q *Queries) DeleteReportEvidence(ctx context.Context, reportID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteReportEvidence, reportID)
+	return err
+}
+
 const ensureServerExists = `-- name: EnsureServerExists :exec
 INSERT OR IGNORE INTO servers (server_id) VALUES (?)
 `
@@ -93,8 +232,30 @@ func (q *Queries) EnsureUserExists(ctx context.Context, userID string) error {
 	return err
 }
 
+const getEventSequenceByKey = `-- name: GetEventSequenceByKey :one
+SELECT sequence FROM events WHERE idempotency_key = ?
+`
+
+func (q *Queries) GetEventSequenceByKey(ctx context.Context, idempotencyKey sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getEventSequenceByKey, idempotencyKey)
+	var sequence int64
+	err := row.Scan(&sequence)
+	return sequence, err
+}
+
+const getLatestEventSequence = `-- name: GetLatestEventSequence :one
+SELECT CAST(COALESCE(MAX(sequence), 0) AS INTEGER) AS sequence FROM events
+`
+
+func (q *Queries) GetLatestEventSequence(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getLatestEventSequence)
+	var sequence int64
+	err := row.Scan(&sequence)
+	return sequence, err
+}
+
 const getReport = `-- name: GetReport :one
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
+SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at, status, updated_at, category 
 FROM reports WHERE report_id = ?
 `
 
@@ -108,6 +269,9 @@ func (q *Queries) GetReport(ctx context.Context, reportID int64) (Report, error)
 		&i.ReportedUserID,
 		&i.OriginServerID,
 		&i.CreatedAt,
+		&i.Status,
+		&i.UpdatedAt,
+		&i.Category,
 	)
 	return i, err
 }
@@ -150,27 +314,130 @@ func (q *Queries) GetUserHistory(ctx context.Context, userID string) ([]UserHist
 	return items, nil
 }
 
-const listReports = `-- name: ListReports :many
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
-FROM reports
-ORDER BY created_at DESC
+const getUserReportSummary = `-- name: GetUserReportSummary :one
+SELECT COUNT(*) AS report_count,
+       COUNT(CASE WHEN status = 'open' THEN 1 END) AS open_report_count,
+       COUNT(DISTINCT origin_server_id) AS server_count,
+       CAST(COALESCE(MAX(created_at), '') AS TEXT) AS last_reported_at
+FROM reports WHERE reported_user_id = ?
+`
+
+type GetUserReportSummaryRow struct {
+	ReportCount     int64  `json:"report_count"`
+	OpenReportCount int64  `json:"open_report_count"`
+	ServerCount     int64  `json:"server_count"`
+	LastReportedAt  string `json:"last_reported_at"`
+}
+
+func (q *Queries) GetUserReportSummary(ctx context.Context, reportedUserID string) (GetUserReportSummaryRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserReportSummary, reportedUserID)
+	var i GetUserReportSummaryRow
+	err := row.Scan(
+		&i.ReportCount,
+		&i.OpenReportCount,
+		&i.ServerCount,
+		&i.LastReportedAt,
+	)
+	return i, err
+}
+
+const listEventsAfter = `-- name: ListEventsAfter :many
+SELECT sequence, event_type, payload, created_at, idempotency_key 
+FROM events 
+WHERE sequence > ? 
+ORDER BY sequence 
+LIMIT ?
 `
 
-func (q *Queries) ListReports(ctx context.Context) ([]Report, error) {
-	rows, err := q.db.QueryContext(ctx, listReports)
+type ListEventsAfterParams struct {
+	Sequence int64 `json:"sequence"`
+	Limit    int64 `json:"limit"`
+}
+
+func (q *Queries) ListEventsAfter(ctx context.Context, arg ListEventsAfterParams) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsAfter, arg.Sequence, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Report{}
+	items := []Event{}
 	for rows.Next() {
-		var i Report
+		var i Event
 		if err := rows.Scan(
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.IdempotencyKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutboxEvents = `-- name: ListOutboxEvents :many
+SELECT outbox_id, payload, created_at 
+FROM event_outbox 
+ORDER BY outbox_id 
+LIMIT ?
+`
+
+func (q *Queries) ListOutboxEvents(ctx context.Context, limit int64) ([]EventOutbox, error) {
+	rows, err := q.db.QueryContext(ctx, listOutboxEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EventOutbox{}
+	for rows.Next() {
+		var i EventOutbox
+		if err := rows.Scan(&i.OutboxID, &i.Payload, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportAuditEntries = `-- name: ListReportAuditEntries :many
+SELECT audit_id, report_id, action, actor_server_id, actor_user_id, origin_server_id, reported_user_id, details, created_at 
+FROM report_audit_log 
+ORDER BY audit_id DESC 
+LIMIT ?
+`
+
+func (q *Queries) ListReportAuditEntries(ctx context.Context, limit int64) ([]ReportAuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, listReportAuditEntries, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportAuditLog{}
+	for rows.Next() {
+		var i ReportAuditLog
+		if err := rows.Scan(
+			&i.AuditID,
 			&i.ReportID,
-			&i.ReportText,
-			&i.ReporterID,
-			&i.ReportedUserID,
+			&i.Action,
+			&i.ActorServerID,
+			&i.ActorUserID,
 			&i.OriginServerID,
+			&i.ReportedUserID,
+			&i.Details,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -186,29 +453,32 @@ func (q *Queries) ListReports(ctx context.Context) ([]Report, error) {
 	return items, nil
 }
 
-const listReportsByUser = `-- name: ListReportsByUser :many
-SELECT report_id, report_text, reporter_id, reported_user_id, origin_server_id, created_at 
-FROM reports 
-WHERE reported_user_id = ?
-ORDER BY created_at DESC
+const listReportEvidence = `-- name: ListReportEvidence :many
+SELECT evidence_id, report_id, url, content_type, message_link, created_at, message_content, message_author_id, channel_id 
+FROM report_evidence 
+WHERE report_id = ? 
+ORDER BY evidence_id
 `
 
-func (q *Queries) ListReportsByUser(ctx context.Context, reportedUserID string) ([]Report, error) {
-	rows, err := q.db.QueryContext(ctx, listReportsByUser, reportedUserID)
+func (q *Queries) ListReportEvidence(ctx context.Context, reportID int64) ([]ReportEvidence, error) {
+	rows, err := q.db.QueryContext(ctx, listReportEvidence, reportID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Report{}
+	items := []ReportEvidence{}
 	for rows.Next() {
-		var i Report
+		var i ReportEvidence
 		if err := rows.Scan(
+			&i.EvidenceID,
 			&i.ReportID,
-			&i.ReportText,
-			&i.ReporterID,
-			&i.ReportedUserID,
-			&i.OriginServerID,
+			&i.Url,
+			&i.ContentType,
+			&i.MessageLink,
 			&i.CreatedAt,
+			&i.MessageContent,
+			&i.MessageAuthorID,
+			&i.ChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -222,3 +492,31 @@ func (q *Queries) ListReportsByUser(ctx context.Context, reportedUserID string)
 	}
 	return items, nil
 }
+
+const pruneEvents = `-- name: PruneEvents :exec
+DELETE FROM events WHERE sequence <= ?
+`
+
+func (q *Queries) PruneEvents(ctx context.Context, sequence int64) error {
+	_, err := q.db.ExecContext(ctx, pruneEvents, sequence)
+	return err
+}
+
+const updateReportStatus = `-- name: UpdateReportStatus :execrows
+UPDATE reports SET status = ?, updated_at = CURRENT_TIMESTAMP
+WHERE report_id = ? AND status = ?
+`
+
+type UpdateReportStatusParams struct {
+	Status         string `json:"status"`
+	ReportID       int64  `json:"report_id"`
+	ExpectedStatus string `json:"expected_status"`
+}
+
+func (q *Queries) UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateReportStatus, arg.Status, arg.ReportID, arg.ExpectedStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
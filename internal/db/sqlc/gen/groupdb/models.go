@@ -8,6 +8,28 @@ import (
 	"database/sql"
 )
 
+type Ban struct {
+	BanID     int64          `json:"ban_id"`
+	UserID    string         `json:"user_id"`
+	ServerID  string         `json:"server_id"`
+	Reason    sql.NullString `json:"reason"`
+	CreatedAt sql.NullString `json:"created_at"`
+}
+
+type Event struct {
+	Sequence       int64          `json:"sequence"`
+	EventType      int64          `json:"event_type"`
+	Payload        []byte         `json:"payload"`
+	CreatedAt      sql.NullString `json:"created_at"`
+	IdempotencyKey sql.NullString `json:"idempotency_key"`
+}
+
+type EventOutbox struct {
+	OutboxID  int64          `json:"outbox_id"`
+	Payload   []byte         `json:"payload"`
+	CreatedAt sql.NullString `json:"created_at"`
+}
+
 type Report struct {
 	ReportID       int64          `json:"report_id"`
 	ReportText     string         `json:"report_text"`
@@ -15,6 +37,33 @@ type Report struct {
 	ReportedUserID string         `json:"reported_user_id"`
 	OriginServerID string         `json:"origin_server_id"`
 	CreatedAt      sql.NullString `json:"created_at"`
+	Status         string         `json:"status"`
+	UpdatedAt      sql.NullString `json:"updated_at"`
+	Category       sql.NullString `json:"category"`
+}
+
+type ReportAuditLog struct {
+	AuditID        int64          `json:"audit_id"`
+	ReportID       int64          `json:"report_id"`
+	Action         string         `json:"action"`
+	ActorServerID  string         `json:"actor_server_id"`
+	ActorUserID    string         `json:"actor_user_id"`
+	OriginServerID string         `json:"origin_server_id"`
+	ReportedUserID string         `json:"reported_user_id"`
+	Details        sql.NullString `json:"details"`
+	CreatedAt      sql.NullString `json:"created_at"`
+}
+
+type ReportEvidence struct {
+	EvidenceID      int64          `json:"evidence_id"`
+	ReportID        int64          `json:"report_id"`
+	Url             string         `json:"url"`
+	ContentType     sql.NullString `json:"content_type"`
+	MessageLink     sql.NullString `json:"message_link"`
+	CreatedAt       sql.NullString `json:"created_at"`
+	MessageContent  sql.NullString `json:"message_content"`
+	MessageAuthorID sql.NullString `json:"message_author_id"`
+	ChannelID       sql.NullString `json:"channel_id"`
 }
 
 type Server struct {
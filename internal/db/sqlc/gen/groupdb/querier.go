@@ -6,20 +6,41 @@ package groupdb
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	// Event log queries
+	AppendEvent(ctx context.Context, arg AppendEventParams) (int64, error)
+	CountUserBans(ctx context.Context, userID string) (int64, error)
+	// Ban queries
+	CreateBan(ctx context.Context, arg CreateBanParams) (int64, error)
+	// Event outbox queries
+	CreateOutboxEvent(ctx context.Context, payload []byte) error
 	CreateReport(ctx context.Context, arg CreateReportParams) (int64, error)
+	// Report audit queries
+	CreateReportAuditEntry(ctx context.Context, arg CreateReportAuditEntryParams) error
+	// Report evidence queries
+	CreateReportEvidence(ctx context.Context, arg CreateReportEvidenceParams) error
 	// User history queries
 	CreateUserHistory(ctx context.Context, arg CreateUserHistoryParams) (int64, error)
+	DeleteOutboxEvent(ctx context.Context, outboxID int64) error
 	DeleteReport(ctx context.Context, reportID int64) (int64, error)
+	DeleteReportEvidence(ctx context.Context, reportID int64) error
 	EnsureServerExists(ctx context.Context, serverID string) error
 	// Group database queries (reports and users)
 	EnsureUserExists(ctx context.Context, userID string) error
+	GetEventSequenceByKey(ctx context.Context, idempotencyKey sql.NullString) (int64, error)
+	GetLatestEventSequence(ctx context.Context) (int64, error)
 	GetReport(ctx context.Context, reportID int64) (Report, error)
 	GetUserHistory(ctx context.Context, userID string) ([]UserHistory, error)
-	ListReports(ctx context.Context) ([]Report, error)
-	ListReportsByUser(ctx context.Context, reportedUserID string) ([]Report, error)
+	GetUserReportSummary(ctx context.Context, reportedUserID string) (GetUserReportSummaryRow, error)
+	ListEventsAfter(ctx context.Context, arg ListEventsAfterParams) ([]Event, error)
+	ListOutboxEvents(ctx context.Context, limit int64) ([]EventOutbox, error)
+	ListReportAuditEntries(ctx context.Context, limit int64) ([]ReportAuditLog, error)
+	ListReportEvidence(ctx context.Context, reportID int64) ([]ReportEvidence, error)
+	PruneEvents(ctx context.Context, sequence int64) error
+	UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
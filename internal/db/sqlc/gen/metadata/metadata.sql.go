@@ -7,17 +7,35 @@ package metadata
 
 import (
 	"context"
+	"database/sql"
 )
 
+const addPermissionRole = `-- name: AddPermissionRole :exec
+INSERT OR IGNORE INTO permission_roles (server_id, permission, role_id) VALUES (?, ?, ?)
+`
+
+type AddPermissionRoleParams struct {
+	ServerID   string `json:"server_id"`
+	Permission string `json:"permission"`
+	RoleID     string `json:"role_id"`
+}
+
+func (q *Queries) AddPermissionRole(ctx context.Context, arg AddPermissionRoleParams) error {
+	_, err := q.db.ExecContext(ctx, addPermissionRole, arg.ServerID, arg.Permission, arg.RoleID)
+	return err
+}
+
 const addServerToGroup = `-- name: AddServerToGroup :exec
-INSERT INTO servers (server_id, output_channel, group_id, permission_level) VALUES (?, ?, ?, ?)
+INSERT INTO servers (server_id, output_channel, group_id, permission_level, ban_policy, watchlist_threshold) VALUES (?, ?, ?, ?, ?, ?)
 `
 
 type AddServerToGroupParams struct {
-	ServerID        string `json:"server_id"`
-	OutputChannel   int64  `json:"output_channel"`
-	GroupID         string `json:"group_id"`
-	PermissionLevel int64  `json:"permission_level"`
+	ServerID           string `json:"server_id"`
+	OutputChannel      int64  `json:"output_channel"`
+	GroupID            string `json:"group_id"`
+	PermissionLevel    int64  `json:"permission_level"`
+	BanPolicy          string `json:"ban_policy"`
+	WatchlistThreshold int64  `json:"watchlist_threshold"`
 }
 
 func (q *Queries) AddServerToGroup(ctx context.Context, arg AddServerToGroupParams) error {
@@ -26,46 +44,548 @@ func (q *Queries) AddServerToGroup(ctx context.Context, arg AddServerToGroupPara
 		arg.OutputChannel,
 		arg.GroupID,
 		arg.PermissionLevel,
+		arg.BanPolicy,
+		arg.WatchlistThreshold,
 	)
 	return err
 }
 
+const consumeInvite = `-- name: ConsumeInvite :one
+UPDATE invites SET uses = uses + 1
+WHERE code = ?
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+  AND (max_uses = 0 OR uses < max_uses)
+  AND group_id IN (SELECT group_id FROM groups WHERE deleted_at IS NULL)
+RETURNING group_id
+`
+
+func (q *Queries) ConsumeInvite(ctx context.Context, code string) (string, error) {
+	row := q.db.QueryRowContext(ctx, consumeInvite, code)
+	var group_id string
+	err := row.Scan(&group_id)
+	return group_id, err
+}
+
+const countPendingJoinRequests = `-- name: CountPendingJoinRequests :one
+SELECT COUNT(*) FROM join_requests WHERE server_id = ? AND group_id = ? AND status = 'pending'
+`
+
+type CountPendingJoinRequestsParams struct {
+	ServerID string `json:"server_id"`
+	GroupID  string `json:"group_id"`
+}
+
+func (q *Queries) CountPendingJoinRequests(ctx context.Context, arg CountPendingJoinRequestsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPendingJoinRequests, arg.ServerID, arg.GroupID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :exec
+INSERT INTO api_keys (key_id, name, key_hash) VALUES (?, ?, ?)
+`
+
+type CreateAPIKeyParams struct {
+	KeyID   string `json:"key_id"`
+	Name    string `json:"name"`
+	KeyHash string `json:"key_hash"`
+}
+
+// API key queries
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createAPIKey, arg.KeyID, arg.Name, arg.KeyHash)
+	return err
+}
+
 const createGroup = `-- name: CreateGroup :exec
 
-INSERT INTO groups (group_id, group_name) VALUES (?, ?)
+INSERT INTO groups (group_id, group_name, owner_server_id) VALUES (?, ?, ?)
 `
 
 type CreateGroupParams struct {
-	GroupID   string `json:"group_id"`
-	GroupName string `json:"group_name"`
+	GroupID       string         `json:"group_id"`
+	GroupName     string         `json:"group_name"`
+	OwnerServerID sql.NullString `json:"owner_server_id"`
 }
 
 // Metadata database queries (groups and servers)
 func (q *Queries) CreateGroup(ctx context.Context, arg CreateGroupParams) error {
-	_, err := q.db.ExecContext(ctx, createGroup, arg.GroupID, arg.GroupName)
+	_, err := q.db.ExecContext(ctx, createGroup, arg.GroupID, arg.GroupName, arg.OwnerServerID)
+	return err
+}
+
+const createInvite = `-- name: CreateInvite :exec
+INSERT INTO invites (code, group_id, created_by, max_uses, expires_at) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateInviteParams struct {
+	Code      string         `json:"code"`
+	GroupID   string         `json:"group_id"`
+	CreatedBy string         `json:"created_by"`
+	MaxUses   int64          `json:"max_uses"`
+	ExpiresAt sql.NullString `json:"expires_at"`
+}
+
+// Invite queries
+func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) error {
+	_, err := q.db.ExecContext(ctx, createInvite,
+		arg.Code,
+		arg.GroupID,
+		arg.CreatedBy,
+		arg.MaxUses,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createJoinRequest = `-- name: CreateJoinRequest :exec
+INSERT INTO join_requests (request_id, group_id, server_id, requested_by, invite_code) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateJoinRequestParams struct {
+	RequestID   string         `json:"request_id"`
+	GroupID     string         `json:"group_id"`
+	ServerID    string         `json:"server_id"`
+	RequestedBy string         `json:"requested_by"`
+	InviteCode  sql.NullString `json:"invite_code"`
+}
+
+// Join request queries
+func (q *Queries) CreateJoinRequest(ctx context.Context, arg CreateJoinRequestParams) error {
+	_, err := q.db.ExecContext(ctx, createJoinRequest,
+		arg.RequestID,
+		arg.GroupID,
+		arg.ServerID,
+		arg.RequestedBy,
+		arg.InviteCode,
+	)
 	return err
 }
 
-const findGroupByServer = `-- name: FindGroupByServer :one
-SELECT group_id FROM servers WHERE server_id = ?
+const decideJoinRequest = `-- name: DecideJoinRequest :one
+UPDATE join_requests SET status = ?, decided_by = ?, decided_at = CURRENT_TIMESTAMP
+WHERE request_id = ? AND group_id = ? AND status = 'pending'
+RETURNING server_id
 `
 
-func (q *Queries) FindGroupByServer(ctx context.Context, serverID string) (string, error) {
-	row := q.db.QueryRowContext(ctx, findGroupByServer, serverID)
+type DecideJoinRequestParams struct {
+	Status    string         `json:"status"`
+	DecidedBy sql.NullString `json:"decided_by"`
+	RequestID string         `json:"request_id"`
+	GroupID   string         `json:"group_id"`
+}
+
+func (q *Queries) DecideJoinRequest(ctx context.Context, arg DecideJoinRequestParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, decideJoinRequest,
+		arg.Status,
+		arg.DecidedBy,
+		arg.RequestID,
+		arg.GroupID,
+	)
+	var server_id string
+	err := row.Scan(&server_id)
+	return server_id, err
+}
+
+const deleteGroupInvites = `-- name: DeleteGroupInvites :exec
+DELETE FROM invites WHERE group_id = ?
+`
+
+func (q *Queries) DeleteGroupInvites(ctx context.Context, groupID string) error {
+	_, err := q.db.ExecContext(ctx, deleteGroupInvites, groupID)
+	return err
+}
+
+const deleteGroupJoinRequests = `-- name: DeleteGroupJoinRequests :exec
+DELETE FROM join_requests WHERE group_id = ?
+`
+
+func (q *Queries) DeleteGroupJoinRequests(ctx context.Context, groupID string) error {
+	_, err := q.db.ExecContext(ctx, deleteGroupJoinRequests, groupID)
+	return err
+}
+
+const deleteGroupServers = `-- name: DeleteGroupServers :exec
+DELETE FROM servers WHERE group_id = ?
+`
+
+func (q *Queries) DeleteGroupServers(ctx context.Context, groupID string) error {
+	_, err := q.db.ExecContext(ctx, deleteGroupServers, groupID)
+	return err
+}
+
+const deletePendingJoinRequest = `-- name: DeletePendingJoinRequest :one
+DELETE FROM join_requests WHERE request_id = ? AND group_id = ? AND status = 'pending'
+RETURNING invite_code
+`
+
+type DeletePendingJoinRequestParams struct {
+	RequestID string `json:"request_id"`
+	GroupID   string `json:"group_id"`
+}
+
+func (q *Queries) DeletePendingJoinRequest(ctx context.Context, arg DeletePendingJoinRequestParams) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, deletePendingJoinRequest, arg.RequestID, arg.GroupID)
+	var invite_code sql.NullString
+	err := row.Scan(&invite_code)
+	return invite_code, err
+}
+
+const deletePermissionRoles = `-- name: DeletePermissionRoles :exec
+DELETE FROM permission_roles WHERE server_id = ? AND permission = ?
+`
+
+type DeletePermissionRolesParams struct {
+	ServerID   string `json:"server_id"`
+	Permission string `json:"permission"`
+}
+
+func (q *Queries) DeletePermissionRoles(ctx context.Context, arg DeletePermissionRolesParams) error {
+	_, err := q.db.ExecContext(ctx, deletePermissionRoles, arg.ServerID, arg.Permission)
+	return err
+}
+
+const findDeletedGroupByOwner = `-- name: FindDeletedGroupByOwner :one
+SELECT group_id FROM groups
+WHERE owner_server_id = ? AND deleted_at IS NOT NULL AND deleted_at > ?
+ORDER BY deleted_at DESC
+LIMIT 1
+`
+
+type FindDeletedGroupByOwnerParams struct {
+	OwnerServerID sql.NullString `json:"owner_server_id"`
+	DeletedAt     sql.NullString `json:"deleted_at"`
+}
+
+func (q *Queries) FindDeletedGroupByOwner(ctx context.Context, arg FindDeletedGroupByOwnerParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, findDeletedGroupByOwner, arg.OwnerServerID, arg.DeletedAt)
 	var group_id string
 	err := row.Scan(&group_id)
 	return group_id, err
 }
 
-const listServers = `-- name: ListServers :many
-SELECT server_id, group_id FROM servers WHERE group_id = ?
+const getAPIKey = `-- name: GetAPIKey :one
+SELECT key_id, name, key_hash, created_at, revoked_at FROM api_keys WHERE key_id = ?
 `
 
-type ListServersRow struct {
+func (q *Queries) GetAPIKey(ctx context.Context, keyID string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKey, keyID)
+	var i ApiKey
+	err := row.Scan(
+		&i.KeyID,
+		&i.Name,
+		&i.KeyHash,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getGroup = `-- name: GetGroup :one
+SELECT group_id, group_name, join_requires_approval, owner_server_id, deleted_at FROM groups WHERE group_id = ?
+`
+
+func (q *Queries) GetGroup(ctx context.Context, groupID string) (Group, error) {
+	row := q.db.QueryRowContext(ctx, getGroup, groupID)
+	var i Group
+	err := row.Scan(
+		&i.GroupID,
+		&i.GroupName,
+		&i.JoinRequiresApproval,
+		&i.OwnerServerID,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getGroupJoinApproval = `-- name: GetGroupJoinApproval :one
+SELECT join_requires_approval FROM groups WHERE group_id = ?
+`
+
+func (q *Queries) GetGroupJoinApproval(ctx context.Context, groupID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getGroupJoinApproval, groupID)
+	var join_requires_approval int64
+	err := row.Scan(&join_requires_approval)
+	return join_requires_approval, err
+}
+
+const getInvite = `-- name: GetInvite :one
+SELECT code, group_id, created_by, max_uses, uses, expires_at, created_at, revoked_at FROM invites WHERE code = ?
+`
+
+func (q *Queries) GetInvite(ctx context.Context, code string) (Invite, error) {
+	row := q.db.QueryRowContext(ctx, getInvite, code)
+	var i Invite
+	err := row.Scan(
+		&i.Code,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.MaxUses,
+		&i.Uses,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getJoinRequest = `-- name: GetJoinRequest :one
+SELECT request_id, group_id, server_id, requested_by, invite_code, status, decided_by, created_at, decided_at FROM join_requests WHERE request_id = ?
+`
+
+func (q *Queries) GetJoinRequest(ctx context.Context, requestID string) (JoinRequest, error) {
+	row := q.db.QueryRowContext(ctx, getJoinRequest, requestID)
+	var i JoinRequest
+	err := row.Scan(
+		&i.RequestID,
+		&i.GroupID,
+		&i.ServerID,
+		&i.RequestedBy,
+		&i.InviteCode,
+		&i.Status,
+		&i.DecidedBy,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const getServerConfig = `-- name: GetServerConfig :one
+SELECT ban_policy, output_channel, watchlist_threshold FROM servers WHERE server_id = ?
+`
+
+type GetServerConfigRow struct {
+	BanPolicy          string `json:"ban_policy"`
+	OutputChannel      int64  `json:"output_channel"`
+	WatchlistThreshold int64  `json:"watchlist_threshold"`
+}
+
+func (q *Queries) GetServerConfig(ctx context.Context, serverID string) (GetServerConfigRow, error) {
+	row := q.db.QueryRowContext(ctx, getServerConfig, serverID)
+	var i GetServerConfigRow
+	err := row.Scan(&i.BanPolicy, &i.OutputChannel, &i.WatchlistThreshold)
+	return i, err
+}
+
+const getServerRole = `-- name: GetServerRole :one
+SELECT permission_level FROM servers WHERE server_id = ? AND group_id = ?
+`
+
+type GetServerRoleParams struct {
 	ServerID string `json:"server_id"`
 	GroupID  string `json:"group_id"`
 }
 
+func (q *Queries) GetServerRole(ctx context.Context, arg GetServerRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getServerRole, arg.ServerID, arg.GroupID)
+	var permission_level int64
+	err := row.Scan(&permission_level)
+	return permission_level, err
+}
+
+const listAPIKeys = `-- name: ListAPIKeys :many
+SELECT key_id, name, key_hash, created_at, revoked_at FROM api_keys ORDER BY created_at, key_id
+`
+
+func (q *Queries) ListAPIKeys(ctx context.Context) ([]ApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKey{}
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.KeyID,
+			&i.Name,
+			&i.KeyHash,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveGroupIDs = `-- name: ListActiveGroupIDs :many
+SELECT group_id FROM groups WHERE deleted_at IS NULL ORDER BY group_id
+`
+
+func (q *Queries) ListActiveGroupIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var group_id string
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvites = `-- name: ListInvites :many
+SELECT code, group_id, created_by, max_uses, uses, expires_at, created_at, revoked_at FROM invites WHERE group_id = ? ORDER BY created_at, code
+`
+
+func (q *Queries) ListInvites(ctx context.Context, groupID string) ([]Invite, error) {
+	rows, err := q.db.QueryContext(ctx, listInvites, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Invite{}
+	for rows.Next() {
+		var i Invite
+		if err := rows.Scan(
+			&i.Code,
+			&i.GroupID,
+			&i.CreatedBy,
+			&i.MaxUses,
+			&i.Uses,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPermissionRoles = `-- name: ListPermissionRoles :many
+SELECT permission, role_id FROM permission_roles WHERE server_id = ? ORDER BY permission, role_id
+`
+
+type ListPermissionRolesRow struct {
+	Permission string `json:"permission"`
+	RoleID     string `json:"role_id"`
+}
+
+func (q *Queries) ListPermissionRoles(ctx context.Context, serverID string) ([]ListPermissionRolesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPermissionRoles, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPermissionRolesRow{}
+	for rows.Next() {
+		var i ListPermissionRolesRow
+		if err := rows.Scan(&i.Permission, &i.RoleID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPurgeableGroups = `-- name: ListPurgeableGroups :many
+SELECT group_id FROM groups WHERE deleted_at IS NOT NULL AND deleted_at <= ?
+`
+
+func (q *Queries) ListPurgeableGroups(ctx context.Context, deletedAt sql.NullString) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listPurgeableGroups, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var group_id string
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServerGroups = `-- name: ListServerGroups :many
+SELECT servers.group_id, groups.group_name, servers.permission_level FROM servers
+JOIN groups ON groups.group_id = servers.group_id
+WHERE servers.server_id = ? AND groups.deleted_at IS NULL
+ORDER BY groups.group_name, servers.group_id
+`
+
+type ListServerGroupsRow struct {
+	GroupID         string `json:"group_id"`
+	GroupName       string `json:"group_name"`
+	PermissionLevel int64  `json:"permission_level"`
+}
+
+func (q *Queries) ListServerGroups(ctx context.Context, serverID string) ([]ListServerGroupsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listServerGroups, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListServerGroupsRow{}
+	for rows.Next() {
+		var i ListServerGroupsRow
+		if err := rows.Scan(&i.GroupID, &i.GroupName, &i.PermissionLevel); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServers = `-- name: ListServers :many
+SELECT server_id, group_id, permission_level FROM servers WHERE group_id = ? ORDER BY permission_level DESC, server_id
+`
+
+type ListServersRow struct {
+	ServerID        string `json:"server_id"`
+	GroupID         string `json:"group_id"`
+	PermissionLevel int64  `json:"permission_level"`
+}
+
 func (q *Queries) ListServers(ctx context.Context, groupID string) ([]ListServersRow, error) {
 	rows, err := q.db.QueryContext(ctx, listServers, groupID)
 	if err != nil {
@@ -75,7 +595,7 @@ func (q *Queries) ListServers(ctx context.Context, groupID string) ([]ListServer
 	items := []ListServersRow{}
 	for rows.Next() {
 		var i ListServersRow
-		if err := rows.Scan(&i.ServerID, &i.GroupID); err != nil {
+		if err := rows.Scan(&i.ServerID, &i.GroupID, &i.PermissionLevel); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -88,3 +608,203 @@ func (q *Queries) ListServers(ctx context.Context, groupID string) ([]ListServer
 	}
 	return items, nil
 }
+
+const purgeGroup = `-- name: PurgeGroup :execrows
+DELETE FROM groups WHERE group_id = ? AND deleted_at IS NOT NULL AND deleted_at <= ?
+`
+
+type PurgeGroupParams struct {
+	GroupID   string         `json:"group_id"`
+	DeletedAt sql.NullString `json:"deleted_at"`
+}
+
+func (q *Queries) PurgeGroup(ctx context.Context, arg PurgeGroupParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeGroup, arg.GroupID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseInvite = `-- name: ReleaseInvite :exec
+UPDATE invites SET uses = uses - 1 WHERE code = ? AND uses > 0
+`
+
+func (q *Queries) ReleaseInvite(ctx context.Context, code string) error {
+	_, err := q.db.ExecContext(ctx, releaseInvite, code)
+	return err
+}
+
+const removeServerFromGroup = `-- name: RemoveServerFromGroup :execrows
+DELETE FROM servers WHERE server_id = ? AND group_id = ?
+`
+
+type RemoveServerFromGroupParams struct {
+	ServerID string `json:"server_id"`
+	GroupID  string `json:"group_id"`
+}
+
+func (q *Queries) RemoveServerFromGroup(ctx context.Context, arg RemoveServerFromGroupParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeServerFromGroup, arg.ServerID, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreGroup = `-- name: RestoreGroup :execrows
+UPDATE groups SET deleted_at = NULL WHERE group_id = ? AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreGroup(ctx context.Context, groupID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, restoreGroup, groupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE key_id = ? AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, keyID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeAPIKey, keyID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeInvite = `-- name: RevokeInvite :execrows
+UPDATE invites SET revoked_at = CURRENT_TIMESTAMP WHERE code = ? AND group_id = ? AND revoked_at IS NULL
+`
+
+type RevokeInviteParams struct {
+	Code    string `json:"code"`
+	GroupID string `json:"group_id"`
+}
+
+func (q *Queries) RevokeInvite(ctx context.Context, arg RevokeInviteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeInvite, arg.Code, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const softDeleteGroup = `-- name: SoftDeleteGroup :execrows
+UPDATE groups SET deleted_at = CURRENT_TIMESTAMP WHERE group_id = ? AND deleted_at IS NULL
+`
+
+// Group deletion queries
+func (q *Queries) SoftDeleteGroup(ctx context.Context, groupID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteGroup, groupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateGroupJoinApproval = `-- name: UpdateGroupJoinApproval :execrows
+UPDATE groups SET join_requires_approval = ? WHERE group_id = ?
+`
+
+type UpdateGroupJoinApprovalParams struct {
+	JoinRequiresApproval int64  `json:"join_requires_approval"`
+	GroupID              string `json:"group_id"`
+}
+
+func (q *Queries) UpdateGroupJoinApproval(ctx context.Context, arg UpdateGroupJoinApprovalParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateGroupJoinApproval, arg.JoinRequiresApproval, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateGroupOwner = `-- name: UpdateGroupOwner :execrows
+UPDATE groups SET owner_server_id = ? WHERE group_id = ?
+`
+
+type UpdateGroupOwnerParams struct {
+	OwnerServerID sql.NullString `json:"owner_server_id"`
+	GroupID       string         `json:"group_id"`
+}
+
+func (q *Queries) UpdateGroupOwner(ctx context.Context, arg UpdateGroupOwnerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateGroupOwner, arg.OwnerServerID, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateServerBanPolicy = `-- name: UpdateServerBanPolicy :execrows
+UPDATE servers SET ban_policy = ? WHERE server_id = ?
+`
+
+type UpdateServerBanPolicyParams struct {
+	BanPolicy string `json:"ban_policy"`
+	ServerID  string `json:"server_id"`
+}
+
+func (q *Queries) UpdateServerBanPolicy(ctx context.Context, arg UpdateServerBanPolicyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateServerBanPolicy, arg.BanPolicy, arg.ServerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateServerOutputChannel = `-- name: UpdateServerOutputChannel :execrows
+UPDATE servers SET output_channel = ? WHERE server_id = ?
+`
+
+type UpdateServerOutputChannelParams struct {
+	OutputChannel int64  `json:"output_channel"`
+	ServerID      string `json:"server_id"`
+}
+
+func (q *Queries) UpdateServerOutputChannel(ctx context.Context, arg UpdateServerOutputChannelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateServerOutputChannel, arg.OutputChannel, arg.ServerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateServerRole = `-- name: UpdateServerRole :execrows
+UPDATE servers SET permission_level = ? WHERE server_id = ? AND group_id = ?
+`
+
+type UpdateServerRoleParams struct {
+	PermissionLevel int64  `json:"permission_level"`
+	ServerID        string `json:"server_id"`
+	GroupID         string `json:"group_id"`
+}
+
+func (q *Queries) UpdateServerRole(ctx context.Context, arg UpdateServerRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateServerRole, arg.PermissionLevel, arg.ServerID, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateServerWatchlistThreshold = `-- name: UpdateServerWatchlistThreshold :execrows
+UPDATE servers SET watchlist_threshold = ? WHERE server_id = ?
+`
+
+type UpdateServerWatchlistThresholdParams struct {
+	WatchlistThreshold int64  `json:"watchlist_threshold"`
+	ServerID           string `json:"server_id"`
+}
+
+func (q *Queries) UpdateServerWatchlistThreshold(ctx context.Context, arg UpdateServerWatchlistThresholdParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateServerWatchlistThreshold, arg.WatchlistThreshold, arg.ServerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -4,14 +4,60 @@
 
 package metadata
 
+import (
+	"database/sql"
+)
+
+type ApiKey struct {
+	KeyID     string         `json:"key_id"`
+	Name      string         `json:"name"`
+	KeyHash   string         `json:"key_hash"`
+	CreatedAt sql.NullString `json:"created_at"`
+	RevokedAt sql.NullString `json:"revoked_at"`
+}
+
 type Group struct {
-	GroupID   string `json:"group_id"`
-	GroupName string `json:"group_name"`
+	GroupID              string         `json:"group_id"`
+	GroupName            string         `json:"group_name"`
+	JoinRequiresApproval int64          `json:"join_requires_approval"`
+	OwnerServerID        sql.NullString `json:"owner_server_id"`
+	DeletedAt            sql.NullString `json:"deleted_at"`
+}
+
+type Invite struct {
+	Code      string         `json:"code"`
+	GroupID   string         `json:"group_id"`
+	CreatedBy string         `json:"created_by"`
+	MaxUses   int64          `json:"max_uses"`
+	Uses      int64          `json:"uses"`
+	ExpiresAt sql.NullString `json:"expires_at"`
+	CreatedAt sql.NullString `json:"created_at"`
+	RevokedAt sql.NullString `json:"revoked_at"`
+}
+
+type JoinRequest struct {
+	RequestID   string         `json:"request_id"`
+	GroupID     string         `json:"group_id"`
+	ServerID    string         `json:"server_id"`
+	RequestedBy string         `json:"requested_by"`
+	InviteCode  sql.NullString `json:"invite_code"`
+	Status      string         `json:"status"`
+	DecidedBy   sql.NullString `json:"decided_by"`
+	CreatedAt   sql.NullString `json:"created_at"`
+	DecidedAt   sql.NullString `json:"decided_at"`
+}
+
+type PermissionRole struct {
+	ServerID   string `json:"server_id"`
+	Permission string `json:"permission"`
+	RoleID     string `json:"role_id"`
 }
 
 type Server struct {
-	ServerID        string `json:"server_id"`
-	OutputChannel   int64  `json:"output_channel"`
-	GroupID         string `json:"group_id"`
-	PermissionLevel int64  `json:"permission_level"`
+	ServerID           string `json:"server_id"`
+	OutputChannel      int64  `json:"output_channel"`
+	GroupID            string `json:"group_id"`
+	PermissionLevel    int64  `json:"permission_level"`
+	BanPolicy          string `json:"ban_policy"`
+	WatchlistThreshold int64  `json:"watchlist_threshold"`
 }
@@ -6,14 +6,57 @@ package metadata
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	AddPermissionRole(ctx context.Context, arg AddPermissionRoleParams) error
 	AddServerToGroup(ctx context.Context, arg AddServerToGroupParams) error
+	ConsumeInvite(ctx context.Context, code string) (string, error)
+	CountPendingJoinRequests(ctx context.Context, arg CountPendingJoinRequestsParams) (int64, error)
+	// API key queries
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error
 	// Metadata database queries (groups and servers)
 	CreateGroup(ctx context.Context, arg CreateGroupParams) error
-	FindGroupByServer(ctx context.Context, serverID string) (string, error)
+	// Invite queries
+	CreateInvite(ctx context.Context, arg CreateInviteParams) error
+	// Join request queries
+	CreateJoinRequest(ctx context.Context, arg CreateJoinRequestParams) error
+	DecideJoinRequest(ctx context.Context, arg DecideJoinRequestParams) (string, error)
+	DeleteGroupInvites(ctx context.Context, groupID string) error
+	DeleteGroupJoinRequests(ctx context.Context, groupID string) error
+	DeleteGroupServers(ctx context.Context, groupID string) error
+	DeletePendingJoinRequest(ctx context.Context, arg DeletePendingJoinRequestParams) (sql.NullString, error)
+	DeletePermissionRoles(ctx context.Context, arg DeletePermissionRolesParams) error
+	FindDeletedGroupByOwner(ctx context.Context, arg FindDeletedGroupByOwnerParams) (string, error)
+	GetAPIKey(ctx context.Context, keyID string) (ApiKey, error)
+	GetGroup(ctx context.Context, groupID string) (Group, error)
+	GetGroupJoinApproval(ctx context.Context, groupID string) (int64, error)
+	GetInvite(ctx context.Context, code string) (Invite, error)
+	GetJoinRequest(ctx context.Context, requestID string) (JoinRequest, error)
+	GetServerConfig(ctx context.Context, serverID string) (GetServerConfigRow, error)
+	GetServerRole(ctx context.Context, arg GetServerRoleParams) (int64, error)
+	ListAPIKeys(ctx context.Context) ([]ApiKey, error)
+	ListActiveGroupIDs(ctx context.Context) ([]string, error)
+	ListInvites(ctx context.Context, groupID string) ([]Invite, error)
+	ListPermissionRoles(ctx context.Context, serverID string) ([]ListPermissionRolesRow, error)
+	ListPurgeableGroups(ctx context.Context, deletedAt sql.NullString) ([]string, error)
+	ListServerGroups(ctx context.Context, serverID string) ([]ListServerGroupsRow, error)
 	ListServers(ctx context.Context, groupID string) ([]ListServersRow, error)
+	PurgeGroup(ctx context.Context, arg PurgeGroupParams) (int64, error)
+	ReleaseInvite(ctx context.Context, code string) error
+	RemoveServerFromGroup(ctx context.Context, arg RemoveServerFromGroupParams) (int64, error)
+	RestoreGroup(ctx context.Context, groupID string) (int64, error)
+	RevokeAPIKey(ctx context.Context, keyID string) (int64, error)
+	RevokeInvite(ctx context.Context, arg RevokeInviteParams) (int64, error)
+	// Group deletion queries
+	SoftDeleteGroup(ctx context.Context, groupID string) (int64, error)
+	UpdateGroupJoinApproval(ctx context.Context, arg UpdateGroupJoinApprovalParams) (int64, error)
+	UpdateGroupOwner(ctx context.Context, arg UpdateGroupOwnerParams) (int64, error)
+	UpdateServerBanPolicy(ctx context.Context, arg UpdateServerBanPolicyParams) (int64, error)
+	UpdateServerOutputChannel(ctx context.Context, arg UpdateServerOutputChannelParams) (int64, error)
+	UpdateServerRole(ctx context.Context, arg UpdateServerRoleParams) (int64, error)
+	UpdateServerWatchlistThreshold(ctx context.Context, arg UpdateServerWatchlistThresholdParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,111 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Prefix starts every API key so leaked keys are easy to recognise
+const Prefix = "snitch"
+
+const (
+	separator   = "_"
+	idBytes     = 8
+	secretBytes = 32
+)
+
+// AuthorizationHeader carries the API key as a bearer token
+const AuthorizationHeader = "Authorization"
+
+const bearerPrefix = "Bearer "
+
+var ErrMalformedKey = errors.New("malformed API key")
+
+// Key is an API key made of a public ID used to look it up and a secret that is only stored hashed
+type Key struct {
+	ID     string
+	Secret string
+}
+
+// Generate creates a new random API key
+func Generate() (Key, error) {
+	id := make([]byte, idBytes)
+	if _, err := rand.Read(id); err != nil {
+		return Key{}, err
+	}
+
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return Key{}, err
+	}
+
+	return Key{ID: hex.EncodeToString(id), Secret: hex.EncodeToString(secret)}, nil
+}
+
+// Parse splits a key built by Key.String back into its ID and secret
+func Parse(key string) (Key, error) {
+	parts := strings.Split(key, separator)
+	if len(parts) != 3 || parts[0] != Prefix {
+		return Key{}, ErrMalformedKey
+	}
+
+	id, secret := parts[1], parts[2]
+	if !isHex(id, idBytes) || !isHex(secret, secretBytes) {
+		return Key{}, ErrMalformedKey
+	}
+
+	return Key{ID: id, Secret: secret}, nil
+}
+
+func isHex(value string, size int) bool {
+	decoded, err := hex.DecodeString(value)
+	return err == nil && len(decoded) == size
+}
+
+// String formats the key the way it is handed to the bot
+func (k Key) String() string {
+	return strings.Join([]string{Prefix, k.ID, k.Secret}, separator)
+}
+
+// Hash returns the value stored for the key's secret
+func (k Key) Hash() string {
+	sum := sha256.Sum256([]byte(k.Secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches reports whether the key's secret hashes to a stored hash
+func (k Key) Matches(hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(k.Hash()), []byte(hash)) == 1
+}
+
+// FromHeader returns the bearer token of a request, if it has one
+func FromHeader(header http.Header) (string, bool) {
+	value := header.Get(AuthorizationHeader)
+	if !strings.HasPrefix(value, bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, bearerPrefix), true
+}
+
+// Transport adds an API key to every request sent through Base
+type Transport struct {
+	Key  string
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set(AuthorizationHeader, bearerPrefix+t.Key)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,78 @@
+package apikey
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGenerateAndParse(t *testing.T) {
+	key, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	parsed, err := Parse(key.String())
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed != key {
+		t.Errorf("Parse() = %+v, want %+v", parsed, key)
+	}
+
+	if !parsed.Matches(key.Hash()) {
+		t.Error("parsed key should match its own hash")
+	}
+
+	other, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if other.Matches(key.Hash()) {
+		t.Error("a different key should not match the hash")
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []string{
+		"",
+		"snitch",
+		"other_0011223344556677_" + "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff",
+		"snitch_0011_" + "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff",
+		"snitch_0011223344556677_not-hex",
+		"snitch_0011223344556677_00_extra",
+	}
+
+	for _, key := range tests {
+		if _, err := Parse(key); err != ErrMalformedKey {
+			t.Errorf("Parse(%q) error = %v, want %v", key, err, ErrMalformedKey)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = FromHeader(r.Header)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &Transport{Key: "snitch_key"}}
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if received != "snitch_key" {
+		t.Errorf("server received key %q, want %q", received, "snitch_key")
+	}
+	if req.Header.Get(AuthorizationHeader) != "" {
+		t.Error("Transport should not modify the caller's request")
+	}
+}
@@ -0,0 +1,20 @@
+package dbtime
+
+import "time"
+
+// Layout is the format CURRENT_TIMESTAMP stores timestamps in
+const Layout = "2006-01-02 15:04:05"
+
+// Parse parses a timestamp column. The database driver reads stored timestamps back as RFC 3339
+// even though CURRENT_TIMESTAMP stores them in Layout, so both formats are accepted.
+func Parse(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse(Layout, value)
+}
+
+// Format formats a time the way CURRENT_TIMESTAMP stores it, so it compares correctly with stored timestamps
+func Format(t time.Time) string {
+	return t.UTC().Format(Layout)
+}
@@ -0,0 +1,31 @@
+package dbtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	expected := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	for _, value := range []string{"2025-03-04 05:06:07", "2025-03-04T05:06:07Z"} {
+		parsed, err := Parse(value)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", value, err)
+		}
+		if !parsed.Equal(expected) {
+			t.Errorf("Parse(%q) = %v, want %v", value, parsed, expected)
+		}
+	}
+
+	if _, err := Parse(""); err == nil {
+		t.Error("Parse(\"\") expected an error")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	value := time.Date(2025, 3, 4, 7, 6, 7, 0, time.FixedZone("CEST", 2*60*60))
+	if got := Format(value); got != "2025-03-04 05:06:07" {
+		t.Errorf("Format() = %q, want %q", got, "2025-03-04 05:06:07")
+	}
+}
@@ -0,0 +1,1028 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: snitch/v1/config.proto
+
+package snitchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BanPolicy decides what a server does with bans propagated from the rest of its group
+type BanPolicy int32
+
+const (
+	BanPolicy_BAN_POLICY_UNSPECIFIED BanPolicy = 0
+	BanPolicy_BAN_POLICY_ANNOUNCE    BanPolicy = 1
+	BanPolicy_BAN_POLICY_APPROVE     BanPolicy = 2
+	BanPolicy_BAN_POLICY_AUTO        BanPolicy = 3
+)
+
+// Enum value maps for BanPolicy.
+var (
+	BanPolicy_name = map[int32]string{
+		0: "BAN_POLICY_UNSPECIFIED",
+		1: "BAN_POLICY_ANNOUNCE",
+		2: "BAN_POLICY_APPROVE",
+		3: "BAN_POLICY_AUTO",
+	}
+	BanPolicy_value = map[string]int32{
+		"BAN_POLICY_UNSPECIFIED": 0,
+		"BAN_POLICY_ANNOUNCE":    1,
+		"BAN_POLICY_APPROVE":     2,
+		"BAN_POLICY_AUTO":        3,
+	}
+)
+
+func (x BanPolicy) Enum() *BanPolicy {
+	p := new(BanPolicy)
+	*p = x
+	return p
+}
+
+func (x BanPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BanPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_snitch_v1_config_proto_enumTypes[0].Descriptor()
+}
+
+func (BanPolicy) Type() protoreflect.EnumType {
+	return &file_snitch_v1_config_proto_enumTypes[0]
+}
+
+func (x BanPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BanPolicy.Descriptor instead.
+func (BanPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{0}
+}
+
+// BotPermission is a set of bot commands a guild can restrict to some of its Discord roles
+type BotPermission int32
+
+const (
+	BotPermission_BOT_PERMISSION_UNSPECIFIED     BotPermission = 0
+	BotPermission_BOT_PERMISSION_CREATE_REPORTS  BotPermission = 1
+	BotPermission_BOT_PERMISSION_LIST_REPORTS    BotPermission = 2
+	BotPermission_BOT_PERMISSION_DELETE_REPORTS  BotPermission = 3
+	BotPermission_BOT_PERMISSION_CONFIGURE_GROUP BotPermission = 4
+)
+
+// Enum value maps for BotPermission.
+var (
+	BotPermission_name = map[int32]string{
+		0: "BOT_PERMISSION_UNSPECIFIED",
+		1: "BOT_PERMISSION_CREATE_REPORTS",
+		2: "BOT_PERMISSION_LIST_REPORTS",
+		3: "BOT_PERMISSION_DELETE_REPORTS",
+		4: "BOT_PERMISSION_CONFIGURE_GROUP",
+	}
+	BotPermission_value = map[string]int32{
+		"BOT_PERMISSION_UNSPECIFIED":     0,
+		"BOT_PERMISSION_CREATE_REPORTS":  1,
+		"BOT_PERMISSION_LIST_REPORTS":    2,
+		"BOT_PERMISSION_DELETE_REPORTS":  3,
+		"BOT_PERMISSION_CONFIGURE_GROUP": 4,
+	}
+)
+
+func (x BotPermission) Enum() *BotPermission {
+	p := new(BotPermission)
+	*p = x
+	return p
+}
+
+func (x BotPermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BotPermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_snitch_v1_config_proto_enumTypes[1].Descriptor()
+}
+
+func (BotPermission) Type() protoreflect.EnumType {
+	return &file_snitch_v1_config_proto_enumTypes[1]
+}
+
+func (x BotPermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BotPermission.Descriptor instead.
+func (BotPermission) EnumDescriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+type ServerConfig struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BanPolicy BanPolicy              `protobuf:"varint,1,opt,name=ban_policy,json=banPolicy,proto3,enum=snitch.v1.BanPolicy" json:"ban_policy,omitempty"`
+	// Channel group events are posted to; unset until configured
+	OutputChannelId *string `protobuf:"bytes,2,opt,name=output_channel_id,json=outputChannelId,proto3,oneof" json:"output_channel_id,omitempty"`
+	// Reports a joining member needs before a watchlist alert is posted; 0 disables alerts
+	WatchlistThreshold int32 `protobuf:"varint,3,opt,name=watchlist_threshold,json=watchlistThreshold,proto3" json:"watchlist_threshold,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ServerConfig) Reset() {
+	*x = ServerConfig{}
+	mi := &file_snitch_v1_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig) ProtoMessage() {}
+
+func (x *ServerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig.ProtoReflect.Descriptor instead.
+func (*ServerConfig) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerConfig) GetBanPolicy() BanPolicy {
+	if x != nil {
+		return x.BanPolicy
+	}
+	return BanPolicy_BAN_POLICY_UNSPECIFIED
+}
+
+func (x *ServerConfig) GetOutputChannelId() string {
+	if x != nil && x.OutputChannelId != nil {
+		return *x.OutputChannelId
+	}
+	return ""
+}
+
+func (x *ServerConfig) GetWatchlistThreshold() int32 {
+	if x != nil {
+		return x.WatchlistThreshold
+	}
+	return 0
+}
+
+type GetServerConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerConfigRequest) Reset() {
+	*x = GetServerConfigRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerConfigRequest) ProtoMessage() {}
+
+func (x *GetServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+type GetServerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ServerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerConfigResponse) Reset() {
+	*x = GetServerConfigResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerConfigResponse) ProtoMessage() {}
+
+func (x *GetServerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetServerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetServerConfigResponse) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateServerConfigRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BanPolicy          *BanPolicy             `protobuf:"varint,1,opt,name=ban_policy,json=banPolicy,proto3,enum=snitch.v1.BanPolicy,oneof" json:"ban_policy,omitempty"`
+	OutputChannelId    *string                `protobuf:"bytes,2,opt,name=output_channel_id,json=outputChannelId,proto3,oneof" json:"output_channel_id,omitempty"`
+	WatchlistThreshold *int32                 `protobuf:"varint,3,opt,name=watchlist_threshold,json=watchlistThreshold,proto3,oneof" json:"watchlist_threshold,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateServerConfigRequest) Reset() {
+	*x = UpdateServerConfigRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerConfigRequest) ProtoMessage() {}
+
+func (x *UpdateServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateServerConfigRequest) GetBanPolicy() BanPolicy {
+	if x != nil && x.BanPolicy != nil {
+		return *x.BanPolicy
+	}
+	return BanPolicy_BAN_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateServerConfigRequest) GetOutputChannelId() string {
+	if x != nil && x.OutputChannelId != nil {
+		return *x.OutputChannelId
+	}
+	return ""
+}
+
+func (x *UpdateServerConfigRequest) GetWatchlistThreshold() int32 {
+	if x != nil && x.WatchlistThreshold != nil {
+		return *x.WatchlistThreshold
+	}
+	return 0
+}
+
+type UpdateServerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ServerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerConfigResponse) Reset() {
+	*x = UpdateServerConfigResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerConfigResponse) ProtoMessage() {}
+
+func (x *UpdateServerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateServerConfigResponse) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GroupConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Servers joining with an invite wait for a member server to approve them
+	JoinRequiresApproval bool `protobuf:"varint,1,opt,name=join_requires_approval,json=joinRequiresApproval,proto3" json:"join_requires_approval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GroupConfig) Reset() {
+	*x = GroupConfig{}
+	mi := &file_snitch_v1_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupConfig) ProtoMessage() {}
+
+func (x *GroupConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupConfig.ProtoReflect.Descriptor instead.
+func (*GroupConfig) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GroupConfig) GetJoinRequiresApproval() bool {
+	if x != nil {
+		return x.JoinRequiresApproval
+	}
+	return false
+}
+
+type GetGroupConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupConfigRequest) Reset() {
+	*x = GetGroupConfigRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupConfigRequest) ProtoMessage() {}
+
+func (x *GetGroupConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+type GetGroupConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *GroupConfig           `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupConfigResponse) Reset() {
+	*x = GetGroupConfigResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupConfigResponse) ProtoMessage() {}
+
+func (x *GetGroupConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGroupConfigResponse) GetConfig() *GroupConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateGroupConfigRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequiresApproval *bool                  `protobuf:"varint,1,opt,name=join_requires_approval,json=joinRequiresApproval,proto3,oneof" json:"join_requires_approval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateGroupConfigRequest) Reset() {
+	*x = UpdateGroupConfigRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupConfigRequest) ProtoMessage() {}
+
+func (x *UpdateGroupConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateGroupConfigRequest) GetJoinRequiresApproval() bool {
+	if x != nil && x.JoinRequiresApproval != nil {
+		return *x.JoinRequiresApproval
+	}
+	return false
+}
+
+type UpdateGroupConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *GroupConfig           `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupConfigResponse) Reset() {
+	*x = UpdateGroupConfigResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupConfigResponse) ProtoMessage() {}
+
+func (x *UpdateGroupConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateGroupConfigResponse) GetConfig() *GroupConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PermissionRule struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Permission BotPermission          `protobuf:"varint,1,opt,name=permission,proto3,enum=snitch.v1.BotPermission" json:"permission,omitempty"`
+	// Discord roles allowed to use the commands; empty falls back to the Manage Server permission
+	RoleIds       []string `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionRule) Reset() {
+	*x = PermissionRule{}
+	mi := &file_snitch_v1_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionRule) ProtoMessage() {}
+
+func (x *PermissionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionRule.ProtoReflect.Descriptor instead.
+func (*PermissionRule) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PermissionRule) GetPermission() BotPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return BotPermission_BOT_PERMISSION_UNSPECIFIED
+}
+
+func (x *PermissionRule) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+type PermissionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One rule per permission, including those without roles
+	Rules         []*PermissionRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionPolicy) Reset() {
+	*x = PermissionPolicy{}
+	mi := &file_snitch_v1_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionPolicy) ProtoMessage() {}
+
+func (x *PermissionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionPolicy.ProtoReflect.Descriptor instead.
+func (*PermissionPolicy) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PermissionPolicy) GetRules() []*PermissionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetPermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionPolicyRequest) Reset() {
+	*x = GetPermissionPolicyRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionPolicyRequest) ProtoMessage() {}
+
+func (x *GetPermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PermissionPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionPolicyResponse) Reset() {
+	*x = GetPermissionPolicyResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionPolicyResponse) ProtoMessage() {}
+
+func (x *GetPermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPermissionPolicyResponse) GetPolicy() *PermissionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdatePermissionPolicyRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Permission BotPermission          `protobuf:"varint,1,opt,name=permission,proto3,enum=snitch.v1.BotPermission" json:"permission,omitempty"`
+	// Replaces the roles allowed to use the permission; empty restores the default
+	RoleIds       []string `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePermissionPolicyRequest) Reset() {
+	*x = UpdatePermissionPolicyRequest{}
+	mi := &file_snitch_v1_config_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdatePermissionPolicyRequest) GetPermission() BotPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return BotPermission_BOT_PERMISSION_UNSPECIFIED
+}
+
+func (x *UpdatePermissionPolicyRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+type UpdatePermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PermissionPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePermissionPolicyResponse) Reset() {
+	*x = UpdatePermissionPolicyResponse{}
+	mi := &file_snitch_v1_config_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePermissionPolicyResponse) ProtoMessage() {}
+
+func (x *UpdatePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_config_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePermissionPolicyResponse) GetPolicy() *PermissionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+var File_snitch_v1_config_proto protoreflect.FileDescriptor
+
+const file_snitch_v1_config_proto_rawDesc = "" +
+	"\n" +
+	"\x16snitch/v1/config.proto\x12\tsnitch.v1\"\xbb\x01\n" +
+	"\fServerConfig\x123\n" +
+	"\n" +
+	"ban_policy\x18\x01 \x01(\x0e2\x14.snitch.v1.BanPolicyR\tbanPolicy\x12/\n" +
+	"\x11output_channel_id\x18\x02 \x01(\tH\x00R\x0foutputChannelId\x88\x01\x01\x12/\n" +
+	"\x13watchlist_threshold\x18\x03 \x01(\x05R\x12watchlistThresholdB\x14\n" +
+	"\x12_output_channel_id\"\x18\n" +
+	"\x16GetServerConfigRequest\"J\n" +
+	"\x17GetServerConfigResponse\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.snitch.v1.ServerConfigR\x06config\"\xf9\x01\n" +
+	"\x19UpdateServerConfigRequest\x128\n" +
+	"\n" +
+	"ban_policy\x18\x01 \x01(\x0e2\x14.snitch.v1.BanPolicyH\x00R\tbanPolicy\x88\x01\x01\x12/\n" +
+	"\x11output_channel_id\x18\x02 \x01(\tH\x01R\x0foutputChannelId\x88\x01\x01\x124\n" +
+	"\x13watchlist_threshold\x18\x03 \x01(\x05H\x02R\x12watchlistThreshold\x88\x01\x01B\r\n" +
+	"\v_ban_policyB\x14\n" +
+	"\x12_output_channel_idB\x16\n" +
+	"\x14_watchlist_threshold\"M\n" +
+	"\x1aUpdateServerConfigResponse\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.snitch.v1.ServerConfigR\x06config\"C\n" +
+	"\vGroupConfig\x124\n" +
+	"\x16join_requires_approval\x18\x01 \x01(\bR\x14joinRequiresApproval\"\x17\n" +
+	"\x15GetGroupConfigRequest\"H\n" +
+	"\x16GetGroupConfigResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.snitch.v1.GroupConfigR\x06config\"p\n" +
+	"\x18UpdateGroupConfigRequest\x129\n" +
+	"\x16join_requires_approval\x18\x01 \x01(\bH\x00R\x14joinRequiresApproval\x88\x01\x01B\x19\n" +
+	"\x17_join_requires_approval\"K\n" +
+	"\x19UpdateGroupConfigResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.snitch.v1.GroupConfigR\x06config\"e\n" +
+	"\x0ePermissionRule\x128\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\x0e2\x18.snitch.v1.BotPermissionR\n" +
+	"permission\x12\x19\n" +
+	"\brole_ids\x18\x02 \x03(\tR\aroleIds\"C\n" +
+	"\x10PermissionPolicy\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.snitch.v1.PermissionRuleR\x05rules\"\x1c\n" +
+	"\x1aGetPermissionPolicyRequest\"R\n" +
+	"\x1bGetPermissionPolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.snitch.v1.PermissionPolicyR\x06policy\"t\n" +
+	"\x1dUpdatePermissionPolicyRequest\x128\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\x0e2\x18.snitch.v1.BotPermissionR\n" +
+	"permission\x12\x19\n" +
+	"\brole_ids\x18\x02 \x03(\tR\aroleIds\"U\n" +
+	"\x1eUpdatePermissionPolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.snitch.v1.PermissionPolicyR\x06policy*m\n" +
+	"\tBanPolicy\x12\x1a\n" +
+	"\x16BAN_POLICY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BAN_POLICY_ANNOUNCE\x10\x01\x12\x16\n" +
+	"\x12BAN_POLICY_APPROVE\x10\x02\x12\x13\n" +
+	"\x0fBAN_POLICY_AUTO\x10\x03*\xba\x01\n" +
+	"\rBotPermission\x12\x1e\n" +
+	"\x1aBOT_PERMISSION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBOT_PERMISSION_CREATE_REPORTS\x10\x01\x12\x1f\n" +
+	"\x1bBOT_PERMISSION_LIST_REPORTS\x10\x02\x12!\n" +
+	"\x1dBOT_PERMISSION_DELETE_REPORTS\x10\x03\x12\"\n" +
+	"\x1eBOT_PERMISSION_CONFIGURE_GROUP\x10\x042\xe4\x04\n" +
+	"\rConfigService\x12Z\n" +
+	"\x0fGetServerConfig\x12!.snitch.v1.GetServerConfigRequest\x1a\".snitch.v1.GetServerConfigResponse\"\x00\x12c\n" +
+	"\x12UpdateServerConfig\x12$.snitch.v1.UpdateServerConfigRequest\x1a%.snitch.v1.UpdateServerConfigResponse\"\x00\x12W\n" +
+	"\x0eGetGroupConfig\x12 .snitch.v1.GetGroupConfigRequest\x1a!.snitch.v1.GetGroupConfigResponse\"\x00\x12`\n" +
+	"\x11UpdateGroupConfig\x12#.snitch.v1.UpdateGroupConfigRequest\x1a$.snitch.v1.UpdateGroupConfigResponse\"\x00\x12f\n" +
+	"\x13GetPermissionPolicy\x12%.snitch.v1.GetPermissionPolicyRequest\x1a&.snitch.v1.GetPermissionPolicyResponse\"\x00\x12o\n" +
+	"\x16UpdatePermissionPolicy\x12(.snitch.v1.UpdatePermissionPolicyRequest\x1a).snitch.v1.UpdatePermissionPolicyResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+
+var (
+	file_snitch_v1_config_proto_rawDescOnce sync.Once
+	file_snitch_v1_config_proto_rawDescData []byte
+)
+
+func file_snitch_v1_config_proto_rawDescGZIP() []byte {
+	file_snitch_v1_config_proto_rawDescOnce.Do(func() {
+		file_snitch_v1_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_snitch_v1_config_proto_rawDesc), len(file_snitch_v1_config_proto_rawDesc)))
+	})
+	return file_snitch_v1_config_proto_rawDescData
+}
+
+var file_snitch_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_snitch_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_snitch_v1_config_proto_goTypes = []any{
+	(BanPolicy)(0),                         // 0: snitch.v1.BanPolicy
+	(BotPermission)(0),                     // 1: snitch.v1.BotPermission
+	(*ServerConfig)(nil),                   // 2: snitch.v1.ServerConfig
+	(*GetServerConfigRequest)(nil),         // 3: snitch.v1.GetServerConfigRequest
+	(*GetServerConfigResponse)(nil),        // 4: snitch.v1.GetServerConfigResponse
+	(*UpdateServerConfigRequest)(nil),      // 5: snitch.v1.UpdateServerConfigRequest
+	(*UpdateServerConfigResponse)(nil),     // 6: snitch.v1.UpdateServerConfigResponse
+	(*GroupConfig)(nil),                    // 7: snitch.v1.GroupConfig
+	(*GetGroupConfigRequest)(nil),          // 8: snitch.v1.GetGroupConfigRequest
+	(*GetGroupConfigResponse)(nil),         // 9: snitch.v1.GetGroupConfigResponse
+	(*UpdateGroupConfigRequest)(nil),       // 10: snitch.v1.UpdateGroupConfigRequest
+	(*UpdateGroupConfigResponse)(nil),      // 11: snitch.v1.UpdateGroupConfigResponse
+	(*PermissionRule)(nil),                 // 12: snitch.v1.PermissionRule
+	(*PermissionPolicy)(nil),               // 13: snitch.v1.PermissionPolicy
+	(*GetPermissionPolicyRequest)(nil),     // 14: snitch.v1.GetPermissionPolicyRequest
+	(*GetPermissionPolicyResponse)(nil),    // 15: snitch.v1.GetPermissionPolicyResponse
+	(*UpdatePermissionPolicyRequest)(nil),  // 16: snitch.v1.UpdatePermissionPolicyRequest
+	(*UpdatePermissionPolicyResponse)(nil), // 17: snitch.v1.UpdatePermissionPolicyResponse
+}
+var file_snitch_v1_config_proto_depIdxs = []int32{
+	0,  // 0: snitch.v1.ServerConfig.ban_policy:type_name -> snitch.v1.BanPolicy
+	2,  // 1: snitch.v1.GetServerConfigResponse.config:type_name -> snitch.v1.ServerConfig
+	0,  // 2: snitch.v1.UpdateServerConfigRequest.ban_policy:type_name -> snitch.v1.BanPolicy
+	2,  // 3: snitch.v1.UpdateServerConfigResponse.config:type_name -> snitch.v1.ServerConfig
+	7,  // 4: snitch.v1.GetGroupConfigResponse.config:type_name -> snitch.v1.GroupConfig
+	7,  // 5: snitch.v1.UpdateGroupConfigResponse.config:type_name -> snitch.v1.GroupConfig
+	1,  // 6: snitch.v1.PermissionRule.permission:type_name -> snitch.v1.BotPermission
+	12, // 7: snitch.v1.PermissionPolicy.rules:type_name -> snitch.v1.PermissionRule
+	13, // 8: snitch.v1.GetPermissionPolicyResponse.policy:type_name -> snitch.v1.PermissionPolicy
+	1,  // 9: snitch.v1.UpdatePermissionPolicyRequest.permission:type_name -> snitch.v1.BotPermission
+	13, // 10: snitch.v1.UpdatePermissionPolicyResponse.policy:type_name -> snitch.v1.PermissionPolicy
+	3,  // 11: snitch.v1.ConfigService.GetServerConfig:input_type -> snitch.v1.GetServerConfigRequest
+	5,  // 12: snitch.v1.ConfigService.UpdateServerConfig:input_type -> snitch.v1.UpdateServerConfigRequest
+	8,  // 13: snitch.v1.ConfigService.GetGroupConfig:input_type -> snitch.v1.GetGroupConfigRequest
+	10, // 14: snitch.v1.ConfigService.UpdateGroupConfig:input_type -> snitch.v1.UpdateGroupConfigRequest
+	14, // 15: snitch.v1.ConfigService.GetPermissionPolicy:input_type -> snitch.v1.GetPermissionPolicyRequest
+	16, // 16: snitch.v1.ConfigService.UpdatePermissionPolicy:input_type -> snitch.v1.UpdatePermissionPolicyRequest
+	4,  // 17: snitch.v1.ConfigService.GetServerConfig:output_type -> snitch.v1.GetServerConfigResponse
+	6,  // 18: snitch.v1.ConfigService.UpdateServerConfig:output_type -> snitch.v1.UpdateServerConfigResponse
+	9,  // 19: snitch.v1.ConfigService.GetGroupConfig:output_type -> snitch.v1.GetGroupConfigResponse
+	11, // 20: snitch.v1.ConfigService.UpdateGroupConfig:output_type -> snitch.v1.UpdateGroupConfigResponse
+	15, // 21: snitch.v1.ConfigService.GetPermissionPolicy:output_type -> snitch.v1.GetPermissionPolicyResponse
+	17, // 22: snitch.v1.ConfigService.UpdatePermissionPolicy:output_type -> snitch.v1.UpdatePermissionPolicyResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_snitch_v1_config_proto_init() }
+func file_snitch_v1_config_proto_init() {
+	if File_snitch_v1_config_proto != nil {
+		return
+	}
+	file_snitch_v1_config_proto_msgTypes[0].OneofWrappers = []any{}
+	file_snitch_v1_config_proto_msgTypes[3].OneofWrappers = []any{}
+	file_snitch_v1_config_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_config_proto_rawDesc), len(file_snitch_v1_config_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_snitch_v1_config_proto_goTypes,
+		DependencyIndexes: file_snitch_v1_config_proto_depIdxs,
+		EnumInfos:         file_snitch_v1_config_proto_enumTypes,
+		MessageInfos:      file_snitch_v1_config_proto_msgTypes,
+	}.Build()
+	File_snitch_v1_config_proto = out.File
+	file_snitch_v1_config_proto_goTypes = nil
+	file_snitch_v1_config_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package snitchv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,9 +24,11 @@ const (
 
 // Metadata database operations
 type CreateGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId   string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	// The server that created the group, the only one allowed to delete it
+	OwnerServerId string `protobuf:"bytes,3,opt,name=owner_server_id,json=ownerServerId,proto3" json:"owner_server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,6 +77,13 @@ func (x *CreateGroupRequest) GetGroupName() string {
 	return ""
 }
 
+func (x *CreateGroupRequest) GetOwnerServerId() string {
+	if x != nil {
+		return x.OwnerServerId
+	}
+	return ""
+}
+
 type CreateGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -119,8 +129,10 @@ func (x *CreateGroupResponse) GetGroupId() string {
 }
 
 type FindGroupByServerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// ID or name of one of the server's groups; required when the server is in more than one
+	GroupSelector *string `protobuf:"bytes,2,opt,name=group_selector,json=groupSelector,proto3,oneof" json:"group_selector,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,9 +174,18 @@ func (x *FindGroupByServerRequest) GetServerId() string {
 	return ""
 }
 
+func (x *FindGroupByServerRequest) GetGroupSelector() string {
+	if x != nil && x.GroupSelector != nil {
+		return *x.GroupSelector
+	}
+	return ""
+}
+
 type FindGroupByServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Role          GroupRole              `protobuf:"varint,2,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	GroupName     string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -206,28 +227,41 @@ func (x *FindGroupByServerResponse) GetGroupId() string {
 	return ""
 }
 
-type AddServerToGroupRequest struct {
+func (x *FindGroupByServerResponse) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+func (x *FindGroupByServerResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type DatabaseServiceListServerGroupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddServerToGroupRequest) Reset() {
-	*x = AddServerToGroupRequest{}
+func (x *DatabaseServiceListServerGroupsRequest) Reset() {
+	*x = DatabaseServiceListServerGroupsRequest{}
 	mi := &file_snitch_v1_database_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddServerToGroupRequest) String() string {
+func (x *DatabaseServiceListServerGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServerToGroupRequest) ProtoMessage() {}
+func (*DatabaseServiceListServerGroupsRequest) ProtoMessage() {}
 
-func (x *AddServerToGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *DatabaseServiceListServerGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -239,46 +273,39 @@ func (x *AddServerToGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServerToGroupRequest.ProtoReflect.Descriptor instead.
-func (*AddServerToGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatabaseServiceListServerGroupsRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListServerGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddServerToGroupRequest) GetServerId() string {
+func (x *DatabaseServiceListServerGroupsRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *AddServerToGroupRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-type AddServerToGroupResponse struct {
+type DatabaseServiceListServerGroupsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Groups        []*ServerGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddServerToGroupResponse) Reset() {
-	*x = AddServerToGroupResponse{}
+func (x *DatabaseServiceListServerGroupsResponse) Reset() {
+	*x = DatabaseServiceListServerGroupsResponse{}
 	mi := &file_snitch_v1_database_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddServerToGroupResponse) String() string {
+func (x *DatabaseServiceListServerGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServerToGroupResponse) ProtoMessage() {}
+func (*DatabaseServiceListServerGroupsResponse) ProtoMessage() {}
 
-func (x *AddServerToGroupResponse) ProtoReflect() protoreflect.Message {
+func (x *DatabaseServiceListServerGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -290,40 +317,42 @@ func (x *AddServerToGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServerToGroupResponse.ProtoReflect.Descriptor instead.
-func (*AddServerToGroupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatabaseServiceListServerGroupsResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListServerGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AddServerToGroupResponse) GetServerId() string {
+func (x *DatabaseServiceListServerGroupsResponse) GetGroups() []*ServerGroup {
 	if x != nil {
-		return x.ServerId
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-// Group database operations
-type CreateGroupDatabaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+type AddServerToGroupRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	GroupId  string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Defaults to member
+	Role          GroupRole `protobuf:"varint,3,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupDatabaseRequest) Reset() {
-	*x = CreateGroupDatabaseRequest{}
+func (x *AddServerToGroupRequest) Reset() {
+	*x = AddServerToGroupRequest{}
 	mi := &file_snitch_v1_database_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupDatabaseRequest) String() string {
+func (x *AddServerToGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupDatabaseRequest) ProtoMessage() {}
+func (*AddServerToGroupRequest) ProtoMessage() {}
 
-func (x *CreateGroupDatabaseRequest) ProtoReflect() protoreflect.Message {
+func (x *AddServerToGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,39 +364,53 @@ func (x *CreateGroupDatabaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupDatabaseRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupDatabaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddServerToGroupRequest.ProtoReflect.Descriptor instead.
+func (*AddServerToGroupRequest) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateGroupDatabaseRequest) GetGroupId() string {
+func (x *AddServerToGroupRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AddServerToGroupRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-type CreateGroupDatabaseResponse struct {
+func (x *AddServerToGroupRequest) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type AddServerToGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupDatabaseResponse) Reset() {
-	*x = CreateGroupDatabaseResponse{}
+func (x *AddServerToGroupResponse) Reset() {
+	*x = AddServerToGroupResponse{}
 	mi := &file_snitch_v1_database_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupDatabaseResponse) String() string {
+func (x *AddServerToGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupDatabaseResponse) ProtoMessage() {}
+func (*AddServerToGroupResponse) ProtoMessage() {}
 
-func (x *CreateGroupDatabaseResponse) ProtoReflect() protoreflect.Message {
+func (x *AddServerToGroupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,44 +422,40 @@ func (x *CreateGroupDatabaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupDatabaseResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupDatabaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddServerToGroupResponse.ProtoReflect.Descriptor instead.
+func (*AddServerToGroupResponse) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateGroupDatabaseResponse) GetGroupId() string {
+func (x *AddServerToGroupResponse) GetServerId() string {
 	if x != nil {
-		return x.GroupId
+		return x.ServerId
 	}
 	return ""
 }
 
-type DatabaseServiceCreateReportRequest struct {
+type RemoveServerFromGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ReporterId    string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceCreateReportRequest) Reset() {
-	*x = DatabaseServiceCreateReportRequest{}
+func (x *RemoveServerFromGroupRequest) Reset() {
+	*x = RemoveServerFromGroupRequest{}
 	mi := &file_snitch_v1_database_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceCreateReportRequest) String() string {
+func (x *RemoveServerFromGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceCreateReportRequest) ProtoMessage() {}
+func (*RemoveServerFromGroupRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceCreateReportRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveServerFromGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,74 +467,46 @@ func (x *DatabaseServiceCreateReportRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceCreateReportRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceCreateReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServerFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveServerFromGroupRequest) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DatabaseServiceCreateReportRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *DatabaseServiceCreateReportRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *DatabaseServiceCreateReportRequest) GetReporterId() string {
-	if x != nil {
-		return x.ReporterId
-	}
-	return ""
-}
-
-func (x *DatabaseServiceCreateReportRequest) GetServerId() string {
+func (x *RemoveServerFromGroupRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceCreateReportRequest) GetReason() string {
+func (x *RemoveServerFromGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *DatabaseServiceCreateReportRequest) GetEvidenceUrl() string {
-	if x != nil && x.EvidenceUrl != nil {
-		return *x.EvidenceUrl
+		return x.GroupId
 	}
 	return ""
 }
 
-type DatabaseServiceCreateReportResponse struct {
+type RemoveServerFromGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceCreateReportResponse) Reset() {
-	*x = DatabaseServiceCreateReportResponse{}
+func (x *RemoveServerFromGroupResponse) Reset() {
+	*x = RemoveServerFromGroupResponse{}
 	mi := &file_snitch_v1_database_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceCreateReportResponse) String() string {
+func (x *RemoveServerFromGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceCreateReportResponse) ProtoMessage() {}
+func (*RemoveServerFromGroupResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceCreateReportResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveServerFromGroupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,40 +518,40 @@ func (x *DatabaseServiceCreateReportResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceCreateReportResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceCreateReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServerFromGroupResponse.ProtoReflect.Descriptor instead.
+func (*RemoveServerFromGroupResponse) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DatabaseServiceCreateReportResponse) GetReportId() int64 {
+func (x *RemoveServerFromGroupResponse) GetServerId() string {
 	if x != nil {
-		return x.ReportId
+		return x.ServerId
 	}
-	return 0
+	return ""
 }
 
-type DatabaseServiceGetReportRequest struct {
+// Group database operations
+type CreateGroupDatabaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceGetReportRequest) Reset() {
-	*x = DatabaseServiceGetReportRequest{}
+func (x *CreateGroupDatabaseRequest) Reset() {
+	*x = CreateGroupDatabaseRequest{}
 	mi := &file_snitch_v1_database_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceGetReportRequest) String() string {
+func (x *CreateGroupDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceGetReportRequest) ProtoMessage() {}
+func (*CreateGroupDatabaseRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceGetReportRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateGroupDatabaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,52 +563,39 @@ func (x *DatabaseServiceGetReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceGetReportRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceGetReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGroupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupDatabaseRequest) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DatabaseServiceGetReportRequest) GetGroupId() string {
+func (x *CreateGroupDatabaseRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetReportRequest) GetReportId() int64 {
-	if x != nil {
-		return x.ReportId
-	}
-	return 0
-}
-
-type DatabaseServiceGetReportResponse struct {
+type CreateGroupDatabaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ReporterId    string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceGetReportResponse) Reset() {
-	*x = DatabaseServiceGetReportResponse{}
+func (x *CreateGroupDatabaseResponse) Reset() {
+	*x = CreateGroupDatabaseResponse{}
 	mi := &file_snitch_v1_database_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceGetReportResponse) String() string {
+func (x *CreateGroupDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceGetReportResponse) ProtoMessage() {}
+func (*CreateGroupDatabaseResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceGetReportResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateGroupDatabaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_snitch_v1_database_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,85 +607,275 @@ func (x *DatabaseServiceGetReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceGetReportResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceGetReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGroupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupDatabaseResponse) Descriptor() ([]byte, []int) {
 	return file_snitch_v1_database_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DatabaseServiceGetReportResponse) GetId() int64 {
+func (x *CreateGroupDatabaseResponse) GetGroupId() string {
 	if x != nil {
-		return x.Id
+		return x.GroupId
 	}
-	return 0
+	return ""
 }
 
-func (x *DatabaseServiceGetReportResponse) GetUserId() string {
+type DatabaseServiceDeleteGroupRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ServerId string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// Must match the group's name
+	ConfirmGroupName string `protobuf:"bytes,3,opt,name=confirm_group_name,json=confirmGroupName,proto3" json:"confirm_group_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteGroupRequest) Reset() {
+	*x = DatabaseServiceDeleteGroupRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteGroupRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[12]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DatabaseServiceGetReportResponse) GetReporterId() string {
+// Deprecated: Use DatabaseServiceDeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DatabaseServiceDeleteGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.ReporterId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetReportResponse) GetServerId() string {
+func (x *DatabaseServiceDeleteGroupRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetReportResponse) GetReason() string {
+func (x *DatabaseServiceDeleteGroupRequest) GetConfirmGroupName() string {
 	if x != nil {
-		return x.Reason
+		return x.ConfirmGroupName
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetReportResponse) GetEvidenceUrl() string {
-	if x != nil && x.EvidenceUrl != nil {
-		return *x.EvidenceUrl
+type DatabaseServiceDeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteGroupResponse) Reset() {
+	*x = DatabaseServiceDeleteGroupResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteGroupResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DatabaseServiceDeleteGroupResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetReportResponse) GetCreatedAt() string {
+func (x *DatabaseServiceDeleteGroupResponse) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DatabaseServiceDeleteGroupResponse) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+type DatabaseServiceSetServerRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceSetServerRoleRequest) Reset() {
+	*x = DatabaseServiceSetServerRoleRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceSetServerRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceSetServerRoleRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceSetServerRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceSetServerRoleRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceSetServerRoleRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DatabaseServiceSetServerRoleRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-type DatabaseServiceListReportsRequest struct {
+func (x *DatabaseServiceSetServerRoleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceSetServerRoleRequest) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type DatabaseServiceSetServerRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Role          GroupRole              `protobuf:"varint,2,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceSetServerRoleResponse) Reset() {
+	*x = DatabaseServiceSetServerRoleResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceSetServerRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceSetServerRoleResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceSetServerRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceSetServerRoleResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceSetServerRoleResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DatabaseServiceSetServerRoleResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceSetServerRoleResponse) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type DatabaseServiceTransferGroupOwnershipRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	FromServerId  string                 `protobuf:"bytes,2,opt,name=from_server_id,json=fromServerId,proto3" json:"from_server_id,omitempty"`
+	ToServerId    string                 `protobuf:"bytes,3,opt,name=to_server_id,json=toServerId,proto3" json:"to_server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceListReportsRequest) Reset() {
-	*x = DatabaseServiceListReportsRequest{}
-	mi := &file_snitch_v1_database_proto_msgTypes[12]
+func (x *DatabaseServiceTransferGroupOwnershipRequest) Reset() {
+	*x = DatabaseServiceTransferGroupOwnershipRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceListReportsRequest) String() string {
+func (x *DatabaseServiceTransferGroupOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceListReportsRequest) ProtoMessage() {}
+func (*DatabaseServiceTransferGroupOwnershipRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[12]
+func (x *DatabaseServiceTransferGroupOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,61 +886,98 @@ func (x *DatabaseServiceListReportsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceListReportsRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DatabaseServiceTransferGroupOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceTransferGroupOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DatabaseServiceListReportsRequest) GetGroupId() string {
+func (x *DatabaseServiceTransferGroupOwnershipRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceListReportsRequest) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+func (x *DatabaseServiceTransferGroupOwnershipRequest) GetFromServerId() string {
+	if x != nil {
+		return x.FromServerId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceListReportsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *DatabaseServiceTransferGroupOwnershipRequest) GetToServerId() string {
+	if x != nil {
+		return x.ToServerId
 	}
-	return 0
+	return ""
 }
 
-func (x *DatabaseServiceListReportsRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+type DatabaseServiceTransferGroupOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerServerId string                 `protobuf:"bytes,1,opt,name=owner_server_id,json=ownerServerId,proto3" json:"owner_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceTransferGroupOwnershipResponse) Reset() {
+	*x = DatabaseServiceTransferGroupOwnershipResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceTransferGroupOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceTransferGroupOwnershipResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceTransferGroupOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type DatabaseServiceDeleteReportResponse struct {
+// Deprecated: Use DatabaseServiceTransferGroupOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceTransferGroupOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DatabaseServiceTransferGroupOwnershipResponse) GetOwnerServerId() string {
+	if x != nil {
+		return x.OwnerServerId
+	}
+	return ""
+}
+
+type DatabaseServiceRestoreGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceDeleteReportResponse) Reset() {
-	*x = DatabaseServiceDeleteReportResponse{}
-	mi := &file_snitch_v1_database_proto_msgTypes[13]
+func (x *DatabaseServiceRestoreGroupRequest) Reset() {
+	*x = DatabaseServiceRestoreGroupRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceDeleteReportResponse) String() string {
+func (x *DatabaseServiceRestoreGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceDeleteReportResponse) ProtoMessage() {}
+func (*DatabaseServiceRestoreGroupRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceDeleteReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[13]
+func (x *DatabaseServiceRestoreGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,40 +988,41 @@ func (x *DatabaseServiceDeleteReportResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceDeleteReportResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceDeleteReportResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DatabaseServiceRestoreGroupRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRestoreGroupRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DatabaseServiceDeleteReportResponse) GetReportId() int64 {
+func (x *DatabaseServiceRestoreGroupRequest) GetServerId() string {
 	if x != nil {
-		return x.ReportId
+		return x.ServerId
 	}
-	return 0
+	return ""
 }
 
-type DatabaseServiceListReportsResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Reports       []*DatabaseServiceGetReportResponse `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+type DatabaseServiceRestoreGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceListReportsResponse) Reset() {
-	*x = DatabaseServiceListReportsResponse{}
-	mi := &file_snitch_v1_database_proto_msgTypes[14]
+func (x *DatabaseServiceRestoreGroupResponse) Reset() {
+	*x = DatabaseServiceRestoreGroupResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceListReportsResponse) String() string {
+func (x *DatabaseServiceRestoreGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceListReportsResponse) ProtoMessage() {}
+func (*DatabaseServiceRestoreGroupResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[14]
+func (x *DatabaseServiceRestoreGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,41 +1033,3463 @@ func (x *DatabaseServiceListReportsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceListReportsResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DatabaseServiceRestoreGroupResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRestoreGroupResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DatabaseServiceListReportsResponse) GetReports() []*DatabaseServiceGetReportResponse {
+func (x *DatabaseServiceRestoreGroupResponse) GetGroupId() string {
 	if x != nil {
-		return x.Reports
+		return x.GroupId
 	}
-	return nil
+	return ""
 }
 
-type DatabaseServiceDeleteReportRequest struct {
+func (x *DatabaseServiceRestoreGroupResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type DatabaseServiceCreateReportRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GroupId     string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReporterId  string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	ServerId    string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Reason      string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceUrl *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	Evidence    []*ReportEvidence      `protobuf:"bytes,7,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Category    *string                `protobuf:"bytes,8,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// event is written to the group's outbox in the report's transaction, with the new report ID filled in
+	Event         *SubscribeResponse `protobuf:"bytes,9,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateReportRequest) Reset() {
+	*x = DatabaseServiceCreateReportRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateReportRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateReportRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetEvidenceUrl() string {
+	if x != nil && x.EvidenceUrl != nil {
+		return *x.EvidenceUrl
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateReportRequest) GetEvent() *SubscribeResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DatabaseServiceCreateReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateReportResponse) Reset() {
+	*x = DatabaseServiceCreateReportResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateReportResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateReportResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DatabaseServiceCreateReportResponse) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type DatabaseServiceGetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetReportRequest) Reset() {
+	*x = DatabaseServiceGetReportRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetReportRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetReportRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DatabaseServiceGetReportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type DatabaseServiceGetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	UpdatedAt     *string                `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	Evidence      []*ReportEvidence      `protobuf:"bytes,10,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Category      *string                `protobuf:"bytes,11,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetReportResponse) Reset() {
+	*x = DatabaseServiceGetReportResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetReportResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetReportResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DatabaseServiceGetReportResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DatabaseServiceGetReportResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetEvidenceUrl() string {
+	if x != nil && x.EvidenceUrl != nil {
+		return *x.EvidenceUrl
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *DatabaseServiceGetReportResponse) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetReportResponse) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *DatabaseServiceGetReportResponse) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+type DatabaseServiceListReportsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId         *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Limit          *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset         *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Status         *ReportStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=snitch.v1.ReportStatus,oneof" json:"status,omitempty"`
+	ReporterId     *string                `protobuf:"bytes,6,opt,name=reporter_id,json=reporterId,proto3,oneof" json:"reporter_id,omitempty"`
+	OriginServerId *string                `protobuf:"bytes,7,opt,name=origin_server_id,json=originServerId,proto3,oneof" json:"origin_server_id,omitempty"`
+	// Reports created at or after this time
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	// Reports created before this time
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListReportsRequest) Reset() {
+	*x = DatabaseServiceListReportsRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListReportsRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListReportsRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DatabaseServiceListReportsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListReportsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListReportsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DatabaseServiceListReportsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *DatabaseServiceListReportsRequest) GetStatus() ReportStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *DatabaseServiceListReportsRequest) GetReporterId() string {
+	if x != nil && x.ReporterId != nil {
+		return *x.ReporterId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListReportsRequest) GetOriginServerId() string {
+	if x != nil && x.OriginServerId != nil {
+		return *x.OriginServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListReportsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *DatabaseServiceListReportsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type DatabaseServiceGetUserReportSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetUserReportSummaryRequest) Reset() {
+	*x = DatabaseServiceGetUserReportSummaryRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetUserReportSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetUserReportSummaryRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetUserReportSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetUserReportSummaryRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetUserReportSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DatabaseServiceGetUserReportSummaryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetUserReportSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DatabaseServiceGetUserReportSummaryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReportCount     int64                  `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	OpenReportCount int64                  `protobuf:"varint,2,opt,name=open_report_count,json=openReportCount,proto3" json:"open_report_count,omitempty"`
+	ServerCount     int64                  `protobuf:"varint,3,opt,name=server_count,json=serverCount,proto3" json:"server_count,omitempty"`
+	LastReportedAt  *string                `protobuf:"bytes,4,opt,name=last_reported_at,json=lastReportedAt,proto3,oneof" json:"last_reported_at,omitempty"`
+	BanCount        int64                  `protobuf:"varint,5,opt,name=ban_count,json=banCount,proto3" json:"ban_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) Reset() {
+	*x = DatabaseServiceGetUserReportSummaryResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetUserReportSummaryResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetUserReportSummaryResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetUserReportSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) GetOpenReportCount() int64 {
+	if x != nil {
+		return x.OpenReportCount
+	}
+	return 0
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) GetServerCount() int64 {
+	if x != nil {
+		return x.ServerCount
+	}
+	return 0
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) GetLastReportedAt() string {
+	if x != nil && x.LastReportedAt != nil {
+		return *x.LastReportedAt
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetUserReportSummaryResponse) GetBanCount() int64 {
+	if x != nil {
+		return x.BanCount
+	}
+	return 0
+}
+
+type DatabaseServiceDeleteReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteReportResponse) Reset() {
+	*x = DatabaseServiceDeleteReportResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteReportResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDeleteReportResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DatabaseServiceDeleteReportResponse) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type DatabaseServiceListReportsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Reports       []*DatabaseServiceGetReportResponse `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListReportsResponse) Reset() {
+	*x = DatabaseServiceListReportsResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListReportsResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListReportsResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DatabaseServiceListReportsResponse) GetReports() []*DatabaseServiceGetReportResponse {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type DatabaseServiceDeleteReportRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReportId int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// The server and Discord user deleting the report, for the audit log
+	ServerId string `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// event is written to the group outbox in the deletion's transaction
+	Event         *SubscribeResponse `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteReportRequest) Reset() {
+	*x = DatabaseServiceDeleteReportRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteReportRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDeleteReportRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DatabaseServiceDeleteReportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDeleteReportRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *DatabaseServiceDeleteReportRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDeleteReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDeleteReportRequest) GetEvent() *SubscribeResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DatabaseServiceUpdateReportStatusRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReportId int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Status   ReportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	// The server and Discord user changing the status, for the audit log
+	ServerId string `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId   string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The status the change was validated against; the update fails if the report has moved on since
+	ExpectedStatus ReportStatus `protobuf:"varint,6,opt,name=expected_status,json=expectedStatus,proto3,enum=snitch.v1.ReportStatus" json:"expected_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) Reset() {
+	*x = DatabaseServiceUpdateReportStatusRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdateReportStatusRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceUpdateReportStatusRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateReportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceUpdateReportStatusRequest) GetExpectedStatus() ReportStatus {
+	if x != nil {
+		return x.ExpectedStatus
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+type DatabaseServiceUpdateReportStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceUpdateReportStatusResponse) Reset() {
+	*x = DatabaseServiceUpdateReportStatusResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceUpdateReportStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdateReportStatusResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdateReportStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceUpdateReportStatusResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateReportStatusResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DatabaseServiceUpdateReportStatusResponse) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *DatabaseServiceUpdateReportStatusResponse) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+type DatabaseServiceReportAuditEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuditId        int64                  `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	ReportId       int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Action         ReportAuditAction      `protobuf:"varint,3,opt,name=action,proto3,enum=snitch.v1.ReportAuditAction" json:"action,omitempty"`
+	ActorServerId  string                 `protobuf:"bytes,4,opt,name=actor_server_id,json=actorServerId,proto3" json:"actor_server_id,omitempty"`
+	ActorUserId    string                 `protobuf:"bytes,5,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	OriginServerId string                 `protobuf:"bytes,6,opt,name=origin_server_id,json=originServerId,proto3" json:"origin_server_id,omitempty"`
+	ReportedUserId string                 `protobuf:"bytes,7,opt,name=reported_user_id,json=reportedUserId,proto3" json:"reported_user_id,omitempty"`
+	Details        *string                `protobuf:"bytes,8,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceReportAuditEntry) Reset() {
+	*x = DatabaseServiceReportAuditEntry{}
+	mi := &file_snitch_v1_database_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceReportAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceReportAuditEntry) ProtoMessage() {}
+
+func (x *DatabaseServiceReportAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceReportAuditEntry.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceReportAuditEntry) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetAuditId() int64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetAction() ReportAuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return ReportAuditAction_REPORT_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetActorServerId() string {
+	if x != nil {
+		return x.ActorServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetOriginServerId() string {
+	if x != nil {
+		return x.OriginServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetReportedUserId() string {
+	if x != nil {
+		return x.ReportedUserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+func (x *DatabaseServiceReportAuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DatabaseServiceListReportAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListReportAuditLogRequest) Reset() {
+	*x = DatabaseServiceListReportAuditLogRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListReportAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListReportAuditLogRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListReportAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListReportAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListReportAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DatabaseServiceListReportAuditLogRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListReportAuditLogRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type DatabaseServiceListReportAuditLogResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Entries       []*DatabaseServiceReportAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListReportAuditLogResponse) Reset() {
+	*x = DatabaseServiceListReportAuditLogResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListReportAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListReportAuditLogResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListReportAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListReportAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListReportAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DatabaseServiceListReportAuditLogResponse) GetEntries() []*DatabaseServiceReportAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DatabaseServiceCreateUserHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) Reset() {
+	*x = DatabaseServiceCreateUserHistoryRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateUserHistoryRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateUserHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateUserHistoryRequest) GetEvidenceUrl() string {
+	if x != nil && x.EvidenceUrl != nil {
+		return *x.EvidenceUrl
+	}
+	return ""
+}
+
+type DatabaseServiceCreateUserHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HistoryId     int64                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateUserHistoryResponse) Reset() {
+	*x = DatabaseServiceCreateUserHistoryResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateUserHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateUserHistoryResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateUserHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateUserHistoryResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DatabaseServiceCreateUserHistoryResponse) GetHistoryId() int64 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+type DatabaseServiceGetUserHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) Reset() {
+	*x = DatabaseServiceGetUserHistoryRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetUserHistoryRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetUserHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetUserHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DatabaseServiceGetUserHistoryRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type DbUserHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbUserHistoryEntry) Reset() {
+	*x = DbUserHistoryEntry{}
+	mi := &file_snitch_v1_database_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbUserHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbUserHistoryEntry) ProtoMessage() {}
+
+func (x *DbUserHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbUserHistoryEntry.ProtoReflect.Descriptor instead.
+func (*DbUserHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DbUserHistoryEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DbUserHistoryEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DbUserHistoryEntry) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DbUserHistoryEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DbUserHistoryEntry) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *DbUserHistoryEntry) GetEvidenceUrl() string {
+	if x != nil && x.EvidenceUrl != nil {
+		return *x.EvidenceUrl
+	}
+	return ""
+}
+
+func (x *DbUserHistoryEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DatabaseServiceGetUserHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DbUserHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetUserHistoryResponse) Reset() {
+	*x = DatabaseServiceGetUserHistoryResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetUserHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetUserHistoryResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetUserHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetUserHistoryResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DatabaseServiceGetUserHistoryResponse) GetEntries() []*DbUserHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DatabaseServiceCreateBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateBanRequest) Reset() {
+	*x = DatabaseServiceCreateBanRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateBanRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateBanRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateBanRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DatabaseServiceCreateBanRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateBanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateBanRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateBanRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type DatabaseServiceCreateBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BanId         int64                  `protobuf:"varint,1,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateBanResponse) Reset() {
+	*x = DatabaseServiceCreateBanResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateBanResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateBanResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateBanResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DatabaseServiceCreateBanResponse) GetBanId() int64 {
+	if x != nil {
+		return x.BanId
+	}
+	return 0
+}
+
+type DatabaseServiceAppendEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Event         *SubscribeResponse     `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceAppendEventRequest) Reset() {
+	*x = DatabaseServiceAppendEventRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceAppendEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceAppendEventRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceAppendEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceAppendEventRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceAppendEventRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DatabaseServiceAppendEventRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceAppendEventRequest) GetEvent() *SubscribeResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DatabaseServiceAppendEventResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// duplicate is set when an event with the same idempotency key was already appended; its sequence is returned
+	Duplicate     bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceAppendEventResponse) Reset() {
+	*x = DatabaseServiceAppendEventResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceAppendEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceAppendEventResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceAppendEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceAppendEventResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceAppendEventResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DatabaseServiceAppendEventResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DatabaseServiceAppendEventResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type DatabaseServiceListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AfterSequence int64                  `protobuf:"varint,2,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListEventsRequest) Reset() {
+	*x = DatabaseServiceListEventsRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListEventsRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListEventsRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DatabaseServiceListEventsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceListEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *DatabaseServiceListEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DatabaseServiceListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SubscribeResponse   `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListEventsResponse) Reset() {
+	*x = DatabaseServiceListEventsResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListEventsResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListEventsResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DatabaseServiceListEventsResponse) GetEvents() []*SubscribeResponse {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DbOutboxEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	OutboxId      int64                  `protobuf:"varint,2,opt,name=outbox_id,json=outboxId,proto3" json:"outbox_id,omitempty"`
+	Event         *SubscribeResponse     `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbOutboxEvent) Reset() {
+	*x = DbOutboxEvent{}
+	mi := &file_snitch_v1_database_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbOutboxEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbOutboxEvent) ProtoMessage() {}
+
+func (x *DbOutboxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbOutboxEvent.ProtoReflect.Descriptor instead.
+func (*DbOutboxEvent) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DbOutboxEvent) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DbOutboxEvent) GetOutboxId() int64 {
+	if x != nil {
+		return x.OutboxId
+	}
+	return 0
+}
+
+func (x *DbOutboxEvent) GetEvent() *SubscribeResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DatabaseServiceListOutboxEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListOutboxEventsRequest) Reset() {
+	*x = DatabaseServiceListOutboxEventsRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListOutboxEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListOutboxEventsRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListOutboxEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListOutboxEventsRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListOutboxEventsRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DatabaseServiceListOutboxEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DatabaseServiceListOutboxEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*DbOutboxEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListOutboxEventsResponse) Reset() {
+	*x = DatabaseServiceListOutboxEventsResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListOutboxEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListOutboxEventsResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListOutboxEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListOutboxEventsResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListOutboxEventsResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DatabaseServiceListOutboxEventsResponse) GetEvents() []*DbOutboxEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DatabaseServiceDeleteOutboxEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	OutboxId      int64                  `protobuf:"varint,2,opt,name=outbox_id,json=outboxId,proto3" json:"outbox_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteOutboxEventRequest) Reset() {
+	*x = DatabaseServiceDeleteOutboxEventRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteOutboxEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteOutboxEventRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteOutboxEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDeleteOutboxEventRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteOutboxEventRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DatabaseServiceDeleteOutboxEventRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDeleteOutboxEventRequest) GetOutboxId() int64 {
+	if x != nil {
+		return x.OutboxId
+	}
+	return 0
+}
+
+type DatabaseServiceDeleteOutboxEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDeleteOutboxEventResponse) Reset() {
+	*x = DatabaseServiceDeleteOutboxEventResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDeleteOutboxEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDeleteOutboxEventResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceDeleteOutboxEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDeleteOutboxEventResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDeleteOutboxEventResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{50}
+}
+
+type DatabaseServiceGetLatestEventSequenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceRequest) Reset() {
+	*x = DatabaseServiceGetLatestEventSequenceRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetLatestEventSequenceRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetLatestEventSequenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetLatestEventSequenceRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetLatestEventSequenceRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DatabaseServiceGetLatestEventSequenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceResponse) Reset() {
+	*x = DatabaseServiceGetLatestEventSequenceResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetLatestEventSequenceResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetLatestEventSequenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetLatestEventSequenceResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetLatestEventSequenceResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DatabaseServiceGetLatestEventSequenceResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type DatabaseServiceGetServerConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetServerConfigRequest) Reset() {
+	*x = DatabaseServiceGetServerConfigRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetServerConfigRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DatabaseServiceGetServerConfigRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type DatabaseServiceGetServerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ServerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetServerConfigResponse) Reset() {
+	*x = DatabaseServiceGetServerConfigResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetServerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetServerConfigResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetServerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetServerConfigResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetServerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DatabaseServiceGetServerConfigResponse) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DatabaseServiceUpdateServerConfigRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ServerId           string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	BanPolicy          *BanPolicy             `protobuf:"varint,2,opt,name=ban_policy,json=banPolicy,proto3,enum=snitch.v1.BanPolicy,oneof" json:"ban_policy,omitempty"`
+	OutputChannelId    *string                `protobuf:"bytes,3,opt,name=output_channel_id,json=outputChannelId,proto3,oneof" json:"output_channel_id,omitempty"`
+	WatchlistThreshold *int32                 `protobuf:"varint,4,opt,name=watchlist_threshold,json=watchlistThreshold,proto3,oneof" json:"watchlist_threshold,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) Reset() {
+	*x = DatabaseServiceUpdateServerConfigRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdateServerConfigRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceUpdateServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) GetBanPolicy() BanPolicy {
+	if x != nil && x.BanPolicy != nil {
+		return *x.BanPolicy
+	}
+	return BanPolicy_BAN_POLICY_UNSPECIFIED
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) GetOutputChannelId() string {
+	if x != nil && x.OutputChannelId != nil {
+		return *x.OutputChannelId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceUpdateServerConfigRequest) GetWatchlistThreshold() int32 {
+	if x != nil && x.WatchlistThreshold != nil {
+		return *x.WatchlistThreshold
+	}
+	return 0
+}
+
+type DatabaseServiceUpdateServerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ServerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceUpdateServerConfigResponse) Reset() {
+	*x = DatabaseServiceUpdateServerConfigResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceUpdateServerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdateServerConfigResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdateServerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceUpdateServerConfigResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateServerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DatabaseServiceUpdateServerConfigResponse) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *string                `protobuf:"bytes,4,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_snitch_v1_database_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevokedAt() string {
+	if x != nil && x.RevokedAt != nil {
+		return *x.RevokedAt
+	}
+	return ""
+}
+
+type DatabaseServiceCreateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// SHA-256 of the key secret; the secret itself is never stored
+	KeyHash       string `protobuf:"bytes,3,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) Reset() {
+	*x = DatabaseServiceCreateAPIKeyRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateAPIKeyRequest) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+type DatabaseServiceCreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateAPIKeyResponse) Reset() {
+	*x = DatabaseServiceCreateAPIKeyResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DatabaseServiceCreateAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type DatabaseServiceGetAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetAPIKeyRequest) Reset() {
+	*x = DatabaseServiceGetAPIKeyRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetAPIKeyRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceGetAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DatabaseServiceGetAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type DatabaseServiceGetAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KeyHash       string                 `protobuf:"bytes,2,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceGetAPIKeyResponse) Reset() {
+	*x = DatabaseServiceGetAPIKeyResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceGetAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceGetAPIKeyResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceGetAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceGetAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DatabaseServiceGetAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *DatabaseServiceGetAPIKeyResponse) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+type DatabaseServiceListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListAPIKeysRequest) Reset() {
+	*x = DatabaseServiceListAPIKeysRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListAPIKeysRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{62}
+}
+
+type DatabaseServiceListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*APIKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListAPIKeysResponse) Reset() {
+	*x = DatabaseServiceListAPIKeysResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListAPIKeysResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DatabaseServiceListAPIKeysResponse) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DatabaseServiceRevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRevokeAPIKeyRequest) Reset() {
+	*x = DatabaseServiceRevokeAPIKeyRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceRevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DatabaseServiceRevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type DatabaseServiceRevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRevokeAPIKeyResponse) Reset() {
+	*x = DatabaseServiceRevokeAPIKeyResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceRevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DatabaseServiceRevokeAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type DbInvite struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Code      string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// 0 means the invite can be used any number of times
+	MaxUses       int64   `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int64   `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt     string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *string `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbInvite) Reset() {
+	*x = DbInvite{}
+	mi := &file_snitch_v1_database_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbInvite) ProtoMessage() {}
+
+func (x *DbInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbInvite.ProtoReflect.Descriptor instead.
+func (*DbInvite) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DbInvite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DbInvite) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DbInvite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *DbInvite) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *DbInvite) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *DbInvite) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *DbInvite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DbInvite) GetRevokedAt() string {
+	if x != nil && x.RevokedAt != nil {
+		return *x.RevokedAt
+	}
+	return ""
+}
+
+type DatabaseServiceCreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MaxUses       int64                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateInviteRequest) Reset() {
+	*x = DatabaseServiceCreateInviteRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateInviteRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DatabaseServiceCreateInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateInviteRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateInviteRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCreateInviteRequest) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *DatabaseServiceCreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type DatabaseServiceCreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *DbInvite              `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCreateInviteResponse) Reset() {
+	*x = DatabaseServiceCreateInviteResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCreateInviteResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DatabaseServiceCreateInviteResponse) GetInvite() *DbInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type DatabaseServiceListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListInvitesRequest) Reset() {
+	*x = DatabaseServiceListInvitesRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListInvitesRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DatabaseServiceListInvitesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DatabaseServiceListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*DbInvite            `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceListInvitesResponse) Reset() {
+	*x = DatabaseServiceListInvitesResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceListInvitesResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DatabaseServiceListInvitesResponse) GetInvites() []*DbInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type DatabaseServiceRevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRevokeInviteRequest) Reset() {
+	*x = DatabaseServiceRevokeInviteRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRevokeInviteRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceRevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DatabaseServiceRevokeInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DatabaseServiceRevokeInviteRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DatabaseServiceRevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *DbInvite              `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRevokeInviteResponse) Reset() {
+	*x = DatabaseServiceRevokeInviteResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRevokeInviteResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceRevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DatabaseServiceRevokeInviteResponse) GetInvite() *DbInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type DatabaseServiceRedeemInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRedeemInviteRequest) Reset() {
+	*x = DatabaseServiceRedeemInviteRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRedeemInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRedeemInviteRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceRedeemInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRedeemInviteRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRedeemInviteRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DatabaseServiceRedeemInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DatabaseServiceRedeemInviteRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceRedeemInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DatabaseServiceRedeemInviteResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Set instead of adding the server when the group requires join approval
+	JoinRequestId *string `protobuf:"bytes,2,opt,name=join_request_id,json=joinRequestId,proto3,oneof" json:"join_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceRedeemInviteResponse) Reset() {
+	*x = DatabaseServiceRedeemInviteResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceRedeemInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceRedeemInviteResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceRedeemInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceRedeemInviteResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceRedeemInviteResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DatabaseServiceRedeemInviteResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceRedeemInviteResponse) GetJoinRequestId() string {
+	if x != nil && x.JoinRequestId != nil {
+		return *x.JoinRequestId
+	}
+	return ""
+}
+
+type DatabaseServiceDecideJoinRequestRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Approve   bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	DecidedBy string                 `protobuf:"bytes,4,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// Approving fails while the server is in this many groups; 0 means no limit
+	MaxServerGroups int32 `protobuf:"varint,5,opt,name=max_server_groups,json=maxServerGroups,proto3" json:"max_server_groups,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) Reset() {
+	*x = DatabaseServiceDecideJoinRequestRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDecideJoinRequestRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDecideJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDecideJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *DatabaseServiceDecideJoinRequestRequest) GetMaxServerGroups() int32 {
+	if x != nil {
+		return x.MaxServerGroups
+	}
+	return 0
+}
+
+type DatabaseServiceDecideJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceDecideJoinRequestResponse) Reset() {
+	*x = DatabaseServiceDecideJoinRequestResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceDecideJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceDecideJoinRequestResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceDecideJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceDecideJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceDecideJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DatabaseServiceDecideJoinRequestResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type DatabaseServiceCancelJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCancelJoinRequestRequest) Reset() {
+	*x = DatabaseServiceCancelJoinRequestRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCancelJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCancelJoinRequestRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceCancelJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCancelJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCancelJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DatabaseServiceCancelJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DatabaseServiceCancelJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DatabaseServiceCancelJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseServiceCancelJoinRequestResponse) Reset() {
+	*x = DatabaseServiceCancelJoinRequestResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseServiceCancelJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceCancelJoinRequestResponse) ProtoMessage() {}
+
+func (x *DatabaseServiceCancelJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServiceCancelJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceCancelJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{78}
+}
+
+type DatabaseServiceGetGroupConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceDeleteReportRequest) Reset() {
-	*x = DatabaseServiceDeleteReportRequest{}
-	mi := &file_snitch_v1_database_proto_msgTypes[15]
+func (x *DatabaseServiceGetGroupConfigRequest) Reset() {
+	*x = DatabaseServiceGetGroupConfigRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceDeleteReportRequest) String() string {
+func (x *DatabaseServiceGetGroupConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceDeleteReportRequest) ProtoMessage() {}
+func (*DatabaseServiceGetGroupConfigRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceDeleteReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[15]
+func (x *DatabaseServiceGetGroupConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,52 +4500,40 @@ func (x *DatabaseServiceDeleteReportRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceDeleteReportRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceDeleteReportRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DatabaseServiceGetGroupConfigRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetGroupConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DatabaseServiceDeleteReportRequest) GetGroupId() string {
+func (x *DatabaseServiceGetGroupConfigRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceDeleteReportRequest) GetReportId() int64 {
-	if x != nil {
-		return x.ReportId
-	}
-	return 0
-}
-
-type DatabaseServiceCreateUserHistoryRequest struct {
+type DatabaseServiceGetGroupConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
+	Config        *GroupConfig           `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) Reset() {
-	*x = DatabaseServiceCreateUserHistoryRequest{}
-	mi := &file_snitch_v1_database_proto_msgTypes[16]
+func (x *DatabaseServiceGetGroupConfigResponse) Reset() {
+	*x = DatabaseServiceGetGroupConfigResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) String() string {
+func (x *DatabaseServiceGetGroupConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceCreateUserHistoryRequest) ProtoMessage() {}
+func (*DatabaseServiceGetGroupConfigResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceCreateUserHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[16]
+func (x *DatabaseServiceGetGroupConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,75 +4544,92 @@ func (x *DatabaseServiceCreateUserHistoryRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceCreateUserHistoryRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceCreateUserHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DatabaseServiceGetGroupConfigResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetGroupConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetGroupId() string {
+func (x *DatabaseServiceGetGroupConfigResponse) GetConfig() *GroupConfig {
 	if x != nil {
-		return x.GroupId
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type DatabaseServiceUpdateGroupConfigRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	GroupId              string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	JoinRequiresApproval *bool                  `protobuf:"varint,2,opt,name=join_requires_approval,json=joinRequiresApproval,proto3,oneof" json:"join_requires_approval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetServerId() string {
-	if x != nil {
-		return x.ServerId
-	}
-	return ""
+func (x *DatabaseServiceUpdateGroupConfigRequest) Reset() {
+	*x = DatabaseServiceUpdateGroupConfigRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetAction() string {
+func (x *DatabaseServiceUpdateGroupConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdateGroupConfigRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdateGroupConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[81]
 	if x != nil {
-		return x.Action
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+// Deprecated: Use DatabaseServiceUpdateGroupConfigRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateGroupConfigRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DatabaseServiceUpdateGroupConfigRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceCreateUserHistoryRequest) GetEvidenceUrl() string {
-	if x != nil && x.EvidenceUrl != nil {
-		return *x.EvidenceUrl
+func (x *DatabaseServiceUpdateGroupConfigRequest) GetJoinRequiresApproval() bool {
+	if x != nil && x.JoinRequiresApproval != nil {
+		return *x.JoinRequiresApproval
 	}
-	return ""
+	return false
 }
 
-type DatabaseServiceCreateUserHistoryResponse struct {
+type DatabaseServiceUpdateGroupConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HistoryId     int64                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Config        *GroupConfig           `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceCreateUserHistoryResponse) Reset() {
-	*x = DatabaseServiceCreateUserHistoryResponse{}
-	mi := &file_snitch_v1_database_proto_msgTypes[17]
+func (x *DatabaseServiceUpdateGroupConfigResponse) Reset() {
+	*x = DatabaseServiceUpdateGroupConfigResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceCreateUserHistoryResponse) String() string {
+func (x *DatabaseServiceUpdateGroupConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceCreateUserHistoryResponse) ProtoMessage() {}
+func (*DatabaseServiceUpdateGroupConfigResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceCreateUserHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[17]
+func (x *DatabaseServiceUpdateGroupConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,43 +4640,40 @@ func (x *DatabaseServiceCreateUserHistoryResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceCreateUserHistoryResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceCreateUserHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DatabaseServiceUpdateGroupConfigResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdateGroupConfigResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DatabaseServiceCreateUserHistoryResponse) GetHistoryId() int64 {
+func (x *DatabaseServiceUpdateGroupConfigResponse) GetConfig() *GroupConfig {
 	if x != nil {
-		return x.HistoryId
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-type DatabaseServiceGetUserHistoryRequest struct {
+type DatabaseServiceGetPermissionPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceGetUserHistoryRequest) Reset() {
-	*x = DatabaseServiceGetUserHistoryRequest{}
-	mi := &file_snitch_v1_database_proto_msgTypes[18]
+func (x *DatabaseServiceGetPermissionPolicyRequest) Reset() {
+	*x = DatabaseServiceGetPermissionPolicyRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceGetUserHistoryRequest) String() string {
+func (x *DatabaseServiceGetPermissionPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceGetUserHistoryRequest) ProtoMessage() {}
+func (*DatabaseServiceGetPermissionPolicyRequest) ProtoMessage() {}
 
-func (x *DatabaseServiceGetUserHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[18]
+func (x *DatabaseServiceGetPermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,67 +4684,40 @@ func (x *DatabaseServiceGetUserHistoryRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceGetUserHistoryRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceGetUserHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *DatabaseServiceGetUserHistoryRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
+// Deprecated: Use DatabaseServiceGetPermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetPermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DatabaseServiceGetUserHistoryRequest) GetUserId() string {
+func (x *DatabaseServiceGetPermissionPolicyRequest) GetServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DatabaseServiceGetUserHistoryRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
-}
-
-func (x *DatabaseServiceGetUserHistoryRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
-	}
-	return 0
-}
-
-type DbUserHistoryEntry struct {
+type DatabaseServiceGetPermissionPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	EvidenceUrl   *string                `protobuf:"bytes,6,opt,name=evidence_url,json=evidenceUrl,proto3,oneof" json:"evidence_url,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Policy        *PermissionPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DbUserHistoryEntry) Reset() {
-	*x = DbUserHistoryEntry{}
-	mi := &file_snitch_v1_database_proto_msgTypes[19]
+func (x *DatabaseServiceGetPermissionPolicyResponse) Reset() {
+	*x = DatabaseServiceGetPermissionPolicyResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DbUserHistoryEntry) String() string {
+func (x *DatabaseServiceGetPermissionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DbUserHistoryEntry) ProtoMessage() {}
+func (*DatabaseServiceGetPermissionPolicyResponse) ProtoMessage() {}
 
-func (x *DbUserHistoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[19]
+func (x *DatabaseServiceGetPermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,82 +4728,100 @@ func (x *DbUserHistoryEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DbUserHistoryEntry.ProtoReflect.Descriptor instead.
-func (*DbUserHistoryEntry) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DatabaseServiceGetPermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceGetPermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DbUserHistoryEntry) GetId() int64 {
+func (x *DatabaseServiceGetPermissionPolicyResponse) GetPolicy() *PermissionPolicy {
 	if x != nil {
-		return x.Id
+		return x.Policy
 	}
-	return 0
+	return nil
 }
 
-func (x *DbUserHistoryEntry) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type DatabaseServiceUpdatePermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Permission    BotPermission          `protobuf:"varint,2,opt,name=permission,proto3,enum=snitch.v1.BotPermission" json:"permission,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,3,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DbUserHistoryEntry) GetServerId() string {
-	if x != nil {
-		return x.ServerId
-	}
-	return ""
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) Reset() {
+	*x = DatabaseServiceUpdatePermissionPolicyRequest{}
+	mi := &file_snitch_v1_database_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DbUserHistoryEntry) GetAction() string {
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServiceUpdatePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[85]
 	if x != nil {
-		return x.Action
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DbUserHistoryEntry) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+// Deprecated: Use DatabaseServiceUpdatePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdatePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DbUserHistoryEntry) GetEvidenceUrl() string {
-	if x != nil && x.EvidenceUrl != nil {
-		return *x.EvidenceUrl
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) GetPermission() BotPermission {
+	if x != nil {
+		return x.Permission
 	}
-	return ""
+	return BotPermission_BOT_PERMISSION_UNSPECIFIED
 }
 
-func (x *DbUserHistoryEntry) GetCreatedAt() string {
+func (x *DatabaseServiceUpdatePermissionPolicyRequest) GetRoleIds() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RoleIds
 	}
-	return ""
+	return nil
 }
 
-type DatabaseServiceGetUserHistoryResponse struct {
+type DatabaseServiceUpdatePermissionPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*DbUserHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Policy        *PermissionPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseServiceGetUserHistoryResponse) Reset() {
-	*x = DatabaseServiceGetUserHistoryResponse{}
-	mi := &file_snitch_v1_database_proto_msgTypes[20]
+func (x *DatabaseServiceUpdatePermissionPolicyResponse) Reset() {
+	*x = DatabaseServiceUpdatePermissionPolicyResponse{}
+	mi := &file_snitch_v1_database_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseServiceGetUserHistoryResponse) String() string {
+func (x *DatabaseServiceUpdatePermissionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseServiceGetUserHistoryResponse) ProtoMessage() {}
+func (*DatabaseServiceUpdatePermissionPolicyResponse) ProtoMessage() {}
 
-func (x *DatabaseServiceGetUserHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[20]
+func (x *DatabaseServiceUpdatePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_database_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,14 +4832,14 @@ func (x *DatabaseServiceGetUserHistoryResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseServiceGetUserHistoryResponse.ProtoReflect.Descriptor instead.
-func (*DatabaseServiceGetUserHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DatabaseServiceUpdatePermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseServiceUpdatePermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DatabaseServiceGetUserHistoryResponse) GetEntries() []*DbUserHistoryEntry {
+func (x *DatabaseServiceUpdatePermissionPolicyResponse) GetPolicy() *PermissionPolicy {
 	if x != nil {
-		return x.Entries
+		return x.Policy
 	}
 	return nil
 }
@@ -1212,7 +4853,7 @@ type ListServersRequest struct {
 
 func (x *ListServersRequest) Reset() {
 	*x = ListServersRequest{}
-	mi := &file_snitch_v1_database_proto_msgTypes[21]
+	mi := &file_snitch_v1_database_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1224,7 +4865,7 @@ func (x *ListServersRequest) String() string {
 func (*ListServersRequest) ProtoMessage() {}
 
 func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[21]
+	mi := &file_snitch_v1_database_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +4878,7 @@ func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
 func (*ListServersRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{21}
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ListServersRequest) GetGroupId() string {
@@ -1251,13 +4892,14 @@ type ServerEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ServerEntry) Reset() {
 	*x = ServerEntry{}
-	mi := &file_snitch_v1_database_proto_msgTypes[22]
+	mi := &file_snitch_v1_database_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1269,7 +4911,7 @@ func (x *ServerEntry) String() string {
 func (*ServerEntry) ProtoMessage() {}
 
 func (x *ServerEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[22]
+	mi := &file_snitch_v1_database_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,7 +4924,7 @@ func (x *ServerEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerEntry.ProtoReflect.Descriptor instead.
 func (*ServerEntry) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{22}
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ServerEntry) GetServerId() string {
@@ -1299,6 +4941,13 @@ func (x *ServerEntry) GetGroupId() string {
 	return ""
 }
 
+func (x *ServerEntry) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
 type ListServersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Servers       []*ServerEntry         `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
@@ -1308,7 +4957,7 @@ type ListServersResponse struct {
 
 func (x *ListServersResponse) Reset() {
 	*x = ListServersResponse{}
-	mi := &file_snitch_v1_database_proto_msgTypes[23]
+	mi := &file_snitch_v1_database_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1320,7 +4969,7 @@ func (x *ListServersResponse) String() string {
 func (*ListServersResponse) ProtoMessage() {}
 
 func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_database_proto_msgTypes[23]
+	mi := &file_snitch_v1_database_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +4982,7 @@ func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
 func (*ListServersResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_database_proto_rawDescGZIP(), []int{23}
+	return file_snitch_v1_database_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ListServersResponse) GetServers() []*ServerEntry {
@@ -1347,26 +4996,72 @@ var File_snitch_v1_database_proto protoreflect.FileDescriptor
 
 const file_snitch_v1_database_proto_rawDesc = "" +
 	"\n" +
-	"\x18snitch/v1/database.proto\x12\tsnitch.v1\"N\n" +
+	"\x18snitch/v1/database.proto\x12\tsnitch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16snitch/v1/config.proto\x1a\x16snitch/v1/events.proto\x1a\x1csnitch/v1/registration.proto\x1a\x16snitch/v1/report.proto\"v\n" +
 	"\x12CreateGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
 	"\n" +
-	"group_name\x18\x02 \x01(\tR\tgroupName\"0\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12&\n" +
+	"\x0fowner_server_id\x18\x03 \x01(\tR\rownerServerId\"0\n" +
 	"\x13CreateGroupResponse\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"7\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"v\n" +
 	"\x18FindGroupByServerRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\"6\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12*\n" +
+	"\x0egroup_selector\x18\x02 \x01(\tH\x00R\rgroupSelector\x88\x01\x01B\x11\n" +
+	"\x0f_group_selector\"\x7f\n" +
 	"\x19FindGroupByServerResponse\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"Q\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12(\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\"E\n" +
+	"&DatabaseServiceListServerGroupsRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"Y\n" +
+	"'DatabaseServiceListServerGroupsResponse\x12.\n" +
+	"\x06groups\x18\x01 \x03(\v2\x16.snitch.v1.ServerGroupR\x06groups\"{\n" +
 	"\x17AddServerToGroupRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
-	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"7\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"7\n" +
 	"\x18AddServerToGroupResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"V\n" +
+	"\x1cRemoveServerFromGroupRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"<\n" +
+	"\x1dRemoveServerFromGroupResponse\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\"7\n" +
 	"\x1aCreateGroupDatabaseRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"8\n" +
 	"\x1bCreateGroupDatabaseResponse\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\xe7\x01\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\x89\x01\n" +
+	"!DatabaseServiceDeleteGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12,\n" +
+	"\x12confirm_group_name\x18\x03 \x01(\tR\x10confirmGroupName\"\xb7\x01\n" +
+	"\"DatabaseServiceDeleteGroupResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12;\n" +
+	"\vpurge_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\"\x87\x01\n" +
+	"#DatabaseServiceSetServerRoleRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"m\n" +
+	"$DatabaseServiceSetServerRoleResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12(\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"\x91\x01\n" +
+	",DatabaseServiceTransferGroupOwnershipRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12$\n" +
+	"\x0efrom_server_id\x18\x02 \x01(\tR\ffromServerId\x12 \n" +
+	"\fto_server_id\x18\x03 \x01(\tR\n" +
+	"toServerId\"W\n" +
+	"-DatabaseServiceTransferGroupOwnershipResponse\x12&\n" +
+	"\x0fowner_server_id\x18\x01 \x01(\tR\rownerServerId\"A\n" +
+	"\"DatabaseServiceRestoreGroupRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"_\n" +
+	"#DatabaseServiceRestoreGroupResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\"\x80\x03\n" +
 	"\"DatabaseServiceCreateReportRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
@@ -1374,13 +5069,17 @@ const file_snitch_v1_database_proto_rawDesc = "" +
 	"reporterId\x12\x1b\n" +
 	"\tserver_id\x18\x04 \x01(\tR\bserverId\x12\x16\n" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x12&\n" +
-	"\fevidence_url\x18\x06 \x01(\tH\x00R\vevidenceUrl\x88\x01\x01B\x0f\n" +
-	"\r_evidence_url\"B\n" +
+	"\fevidence_url\x18\x06 \x01(\tH\x00R\vevidenceUrl\x88\x01\x01\x125\n" +
+	"\bevidence\x18\a \x03(\v2\x19.snitch.v1.ReportEvidenceR\bevidence\x12\x1f\n" +
+	"\bcategory\x18\b \x01(\tH\x01R\bcategory\x88\x01\x01\x122\n" +
+	"\x05event\x18\t \x01(\v2\x1c.snitch.v1.SubscribeResponseR\x05eventB\x0f\n" +
+	"\r_evidence_urlB\v\n" +
+	"\t_category\"B\n" +
 	"#DatabaseServiceCreateReportResponse\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\x03R\breportId\"Y\n" +
 	"\x1fDatabaseServiceGetReportRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
-	"\treport_id\x18\x02 \x01(\x03R\breportId\"\xf9\x01\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\"\xc2\x03\n" +
 	" DatabaseServiceGetReportResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
@@ -1390,24 +5089,85 @@ const file_snitch_v1_database_proto_rawDesc = "" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x12&\n" +
 	"\fevidence_url\x18\x06 \x01(\tH\x00R\vevidenceUrl\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAtB\x0f\n" +
-	"\r_evidence_url\"\xb5\x01\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12/\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\x12\"\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tH\x01R\tupdatedAt\x88\x01\x01\x125\n" +
+	"\bevidence\x18\n" +
+	" \x03(\v2\x19.snitch.v1.ReportEvidenceR\bevidence\x12\x1f\n" +
+	"\bcategory\x18\v \x01(\tH\x02R\bcategory\x88\x01\x01B\x0f\n" +
+	"\r_evidence_urlB\r\n" +
+	"\v_updated_atB\v\n" +
+	"\t_category\"\xa3\x04\n" +
 	"!DatabaseServiceListReportsRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x19\n" +
 	"\x05limit\x18\x03 \x01(\x05H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x04 \x01(\x05H\x02R\x06offset\x88\x01\x01B\n" +
+	"\x06offset\x18\x04 \x01(\x05H\x02R\x06offset\x88\x01\x01\x124\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.snitch.v1.ReportStatusH\x03R\x06status\x88\x01\x01\x12$\n" +
+	"\vreporter_id\x18\x06 \x01(\tH\x04R\n" +
+	"reporterId\x88\x01\x01\x12-\n" +
+	"\x10origin_server_id\x18\a \x01(\tH\x05R\x0eoriginServerId\x88\x01\x01\x12D\n" +
+	"\rcreated_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x06R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\aR\rcreatedBefore\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\b\n" +
 	"\x06_limitB\t\n" +
-	"\a_offset\"B\n" +
+	"\a_offsetB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_reporter_idB\x13\n" +
+	"\x11_origin_server_idB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_before\"`\n" +
+	"*DatabaseServiceGetUserReportSummaryRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x80\x02\n" +
+	"+DatabaseServiceGetUserReportSummaryResponse\x12!\n" +
+	"\freport_count\x18\x01 \x01(\x03R\vreportCount\x12*\n" +
+	"\x11open_report_count\x18\x02 \x01(\x03R\x0fopenReportCount\x12!\n" +
+	"\fserver_count\x18\x03 \x01(\x03R\vserverCount\x12-\n" +
+	"\x10last_reported_at\x18\x04 \x01(\tH\x00R\x0elastReportedAt\x88\x01\x01\x12\x1b\n" +
+	"\tban_count\x18\x05 \x01(\x03R\bbanCountB\x13\n" +
+	"\x11_last_reported_at\"B\n" +
 	"#DatabaseServiceDeleteReportResponse\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\x03R\breportId\"k\n" +
 	"\"DatabaseServiceListReportsResponse\x12E\n" +
-	"\areports\x18\x01 \x03(\v2+.snitch.v1.DatabaseServiceGetReportResponseR\areports\"\\\n" +
+	"\areports\x18\x01 \x03(\v2+.snitch.v1.DatabaseServiceGetReportResponseR\areports\"\xc6\x01\n" +
 	"\"DatabaseServiceDeleteReportRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
-	"\treport_id\x18\x02 \x01(\x03R\breportId\"\xf3\x01\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x122\n" +
+	"\x05event\x18\x05 \x01(\v2\x1c.snitch.v1.SubscribeResponseR\x05event\"\x8b\x02\n" +
+	"(DatabaseServiceUpdateReportStatusRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\x12\x1b\n" +
+	"\tserver_id\x18\x04 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12@\n" +
+	"\x0fexpected_status\x18\x06 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x0eexpectedStatus\"y\n" +
+	")DatabaseServiceUpdateReportStatusResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\"\xf9\x02\n" +
+	"\x1fDatabaseServiceReportAuditEntry\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x03R\aauditId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x124\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1c.snitch.v1.ReportAuditActionR\x06action\x12&\n" +
+	"\x0factor_server_id\x18\x04 \x01(\tR\ractorServerId\x12\"\n" +
+	"\ractor_user_id\x18\x05 \x01(\tR\vactorUserId\x12(\n" +
+	"\x10origin_server_id\x18\x06 \x01(\tR\x0eoriginServerId\x12(\n" +
+	"\x10reported_user_id\x18\a \x01(\tR\x0ereportedUserId\x12\x1d\n" +
+	"\adetails\x18\b \x01(\tH\x00R\adetails\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAtB\n" +
+	"\n" +
+	"\b_details\"j\n" +
+	"(DatabaseServiceListReportAuditLogRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"q\n" +
+	")DatabaseServiceListReportAuditLogResponse\x12D\n" +
+	"\aentries\x18\x01 \x03(\v2*.snitch.v1.DatabaseServiceReportAuditEntryR\aentries\"\xf3\x01\n" +
 	"'DatabaseServiceCreateUserHistoryRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
@@ -1439,26 +5199,216 @@ const file_snitch_v1_database_proto_rawDesc = "" +
 	"\a_reasonB\x0f\n" +
 	"\r_evidence_url\"`\n" +
 	"%DatabaseServiceGetUserHistoryResponse\x127\n" +
-	"\aentries\x18\x01 \x03(\v2\x1d.snitch.v1.DbUserHistoryEntryR\aentries\"/\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.snitch.v1.DbUserHistoryEntryR\aentries\"\x9a\x01\n" +
+	"\x1fDatabaseServiceCreateBanRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"9\n" +
+	" DatabaseServiceCreateBanResponse\x12\x15\n" +
+	"\x06ban_id\x18\x01 \x01(\x03R\x05banId\"r\n" +
+	"!DatabaseServiceAppendEventRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x122\n" +
+	"\x05event\x18\x02 \x01(\v2\x1c.snitch.v1.SubscribeResponseR\x05event\"^\n" +
+	"\"DatabaseServiceAppendEventResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\"z\n" +
+	" DatabaseServiceListEventsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12%\n" +
+	"\x0eafter_sequence\x18\x02 \x01(\x03R\rafterSequence\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Y\n" +
+	"!DatabaseServiceListEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.snitch.v1.SubscribeResponseR\x06events\"{\n" +
+	"\rDbOutboxEvent\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\toutbox_id\x18\x02 \x01(\x03R\boutboxId\x122\n" +
+	"\x05event\x18\x03 \x01(\v2\x1c.snitch.v1.SubscribeResponseR\x05event\">\n" +
+	"&DatabaseServiceListOutboxEventsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"[\n" +
+	"'DatabaseServiceListOutboxEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.snitch.v1.DbOutboxEventR\x06events\"a\n" +
+	"'DatabaseServiceDeleteOutboxEventRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\toutbox_id\x18\x02 \x01(\x03R\boutboxId\"*\n" +
+	"(DatabaseServiceDeleteOutboxEventResponse\"I\n" +
+	",DatabaseServiceGetLatestEventSequenceRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"K\n" +
+	"-DatabaseServiceGetLatestEventSequenceResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\"D\n" +
+	"%DatabaseServiceGetServerConfigRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"Y\n" +
+	"&DatabaseServiceGetServerConfigResponse\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.snitch.v1.ServerConfigR\x06config\"\xa5\x02\n" +
+	"(DatabaseServiceUpdateServerConfigRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x128\n" +
+	"\n" +
+	"ban_policy\x18\x02 \x01(\x0e2\x14.snitch.v1.BanPolicyH\x00R\tbanPolicy\x88\x01\x01\x12/\n" +
+	"\x11output_channel_id\x18\x03 \x01(\tH\x01R\x0foutputChannelId\x88\x01\x01\x124\n" +
+	"\x13watchlist_threshold\x18\x04 \x01(\x05H\x02R\x12watchlistThreshold\x88\x01\x01B\r\n" +
+	"\v_ban_policyB\x14\n" +
+	"\x12_output_channel_idB\x16\n" +
+	"\x14_watchlist_threshold\"\\\n" +
+	")DatabaseServiceUpdateServerConfigResponse\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.snitch.v1.ServerConfigR\x06config\"\x85\x01\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"revoked_at\x18\x04 \x01(\tH\x00R\trevokedAt\x88\x01\x01B\r\n" +
+	"\v_revoked_at\"j\n" +
+	"\"DatabaseServiceCreateAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bkey_hash\x18\x03 \x01(\tR\akeyHash\"J\n" +
+	"#DatabaseServiceCreateAPIKeyResponse\x12#\n" +
+	"\x03key\x18\x01 \x01(\v2\x11.snitch.v1.APIKeyR\x03key\"8\n" +
+	"\x1fDatabaseServiceGetAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"b\n" +
+	" DatabaseServiceGetAPIKeyResponse\x12#\n" +
+	"\x03key\x18\x01 \x01(\v2\x11.snitch.v1.APIKeyR\x03key\x12\x19\n" +
+	"\bkey_hash\x18\x02 \x01(\tR\akeyHash\"#\n" +
+	"!DatabaseServiceListAPIKeysRequest\"K\n" +
+	"\"DatabaseServiceListAPIKeysResponse\x12%\n" +
+	"\x04keys\x18\x01 \x03(\v2\x11.snitch.v1.APIKeyR\x04keys\";\n" +
+	"\"DatabaseServiceRevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"J\n" +
+	"#DatabaseServiceRevokeAPIKeyResponse\x12#\n" +
+	"\x03key\x18\x01 \x01(\v2\x11.snitch.v1.APIKeyR\x03key\"\x8c\x02\n" +
+	"\bDbInvite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x03R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x03R\x04uses\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tH\x01R\trevokedAt\x88\x01\x01B\r\n" +
+	"\v_expires_atB\r\n" +
+	"\v_revoked_at\"\xdc\x01\n" +
+	"\"DatabaseServiceCreateInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x03R\amaxUses\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"R\n" +
+	"#DatabaseServiceCreateInviteResponse\x12+\n" +
+	"\x06invite\x18\x01 \x01(\v2\x13.snitch.v1.DbInviteR\x06invite\">\n" +
+	"!DatabaseServiceListInvitesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"S\n" +
+	"\"DatabaseServiceListInvitesResponse\x12-\n" +
+	"\ainvites\x18\x01 \x03(\v2\x13.snitch.v1.DbInviteR\ainvites\"S\n" +
+	"\"DatabaseServiceRevokeInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"R\n" +
+	"#DatabaseServiceRevokeInviteResponse\x12+\n" +
+	"\x06invite\x18\x01 \x01(\v2\x13.snitch.v1.DbInviteR\x06invite\"n\n" +
+	"\"DatabaseServiceRedeemInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x81\x01\n" +
+	"#DatabaseServiceRedeemInviteResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12+\n" +
+	"\x0fjoin_request_id\x18\x02 \x01(\tH\x00R\rjoinRequestId\x88\x01\x01B\x12\n" +
+	"\x10_join_request_id\"\xc8\x01\n" +
+	"'DatabaseServiceDecideJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x04 \x01(\tR\tdecidedBy\x12*\n" +
+	"\x11max_server_groups\x18\x05 \x01(\x05R\x0fmaxServerGroups\"G\n" +
+	"(DatabaseServiceDecideJoinRequestResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"c\n" +
+	"'DatabaseServiceCancelJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"*\n" +
+	"(DatabaseServiceCancelJoinRequestResponse\"A\n" +
+	"$DatabaseServiceGetGroupConfigRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"W\n" +
+	"%DatabaseServiceGetGroupConfigResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.snitch.v1.GroupConfigR\x06config\"\x9a\x01\n" +
+	"'DatabaseServiceUpdateGroupConfigRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x129\n" +
+	"\x16join_requires_approval\x18\x02 \x01(\bH\x00R\x14joinRequiresApproval\x88\x01\x01B\x19\n" +
+	"\x17_join_requires_approval\"Z\n" +
+	"(DatabaseServiceUpdateGroupConfigResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.snitch.v1.GroupConfigR\x06config\"H\n" +
+	")DatabaseServiceGetPermissionPolicyRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"a\n" +
+	"*DatabaseServiceGetPermissionPolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.snitch.v1.PermissionPolicyR\x06policy\"\xa0\x01\n" +
+	",DatabaseServiceUpdatePermissionPolicyRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x128\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\x0e2\x18.snitch.v1.BotPermissionR\n" +
+	"permission\x12\x19\n" +
+	"\brole_ids\x18\x03 \x03(\tR\aroleIds\"d\n" +
+	"-DatabaseServiceUpdatePermissionPolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.snitch.v1.PermissionPolicyR\x06policy\"/\n" +
 	"\x12ListServersRequest\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"E\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"o\n" +
 	"\vServerEntry\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
-	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"G\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"G\n" +
 	"\x13ListServersResponse\x120\n" +
-	"\aservers\x18\x01 \x03(\v2\x16.snitch.v1.ServerEntryR\aservers2\x89\t\n" +
+	"\aservers\x18\x01 \x03(\v2\x16.snitch.v1.ServerEntryR\aservers2\xbc&\n" +
 	"\x0fDatabaseService\x12N\n" +
 	"\vCreateGroup\x12\x1d.snitch.v1.CreateGroupRequest\x1a\x1e.snitch.v1.CreateGroupResponse\"\x00\x12`\n" +
-	"\x11FindGroupByServer\x12#.snitch.v1.FindGroupByServerRequest\x1a$.snitch.v1.FindGroupByServerResponse\"\x00\x12]\n" +
-	"\x10AddServerToGroup\x12\".snitch.v1.AddServerToGroupRequest\x1a#.snitch.v1.AddServerToGroupResponse\"\x00\x12f\n" +
-	"\x13CreateGroupDatabase\x12%.snitch.v1.CreateGroupDatabaseRequest\x1a&.snitch.v1.CreateGroupDatabaseResponse\"\x00\x12o\n" +
+	"\x11FindGroupByServer\x12#.snitch.v1.FindGroupByServerRequest\x1a$.snitch.v1.FindGroupByServerResponse\"\x00\x12{\n" +
+	"\x10ListServerGroups\x121.snitch.v1.DatabaseServiceListServerGroupsRequest\x1a2.snitch.v1.DatabaseServiceListServerGroupsResponse\"\x00\x12]\n" +
+	"\x10AddServerToGroup\x12\".snitch.v1.AddServerToGroupRequest\x1a#.snitch.v1.AddServerToGroupResponse\"\x00\x12l\n" +
+	"\x15RemoveServerFromGroup\x12'.snitch.v1.RemoveServerFromGroupRequest\x1a(.snitch.v1.RemoveServerFromGroupResponse\"\x00\x12r\n" +
+	"\rSetServerRole\x12..snitch.v1.DatabaseServiceSetServerRoleRequest\x1a/.snitch.v1.DatabaseServiceSetServerRoleResponse\"\x00\x12\x8d\x01\n" +
+	"\x16TransferGroupOwnership\x127.snitch.v1.DatabaseServiceTransferGroupOwnershipRequest\x1a8.snitch.v1.DatabaseServiceTransferGroupOwnershipResponse\"\x00\x12f\n" +
+	"\x13CreateGroupDatabase\x12%.snitch.v1.CreateGroupDatabaseRequest\x1a&.snitch.v1.CreateGroupDatabaseResponse\"\x00\x12l\n" +
+	"\vDeleteGroup\x12,.snitch.v1.DatabaseServiceDeleteGroupRequest\x1a-.snitch.v1.DatabaseServiceDeleteGroupResponse\"\x00\x12o\n" +
+	"\fRestoreGroup\x12-.snitch.v1.DatabaseServiceRestoreGroupRequest\x1a..snitch.v1.DatabaseServiceRestoreGroupResponse\"\x00\x12o\n" +
 	"\fCreateReport\x12-.snitch.v1.DatabaseServiceCreateReportRequest\x1a..snitch.v1.DatabaseServiceCreateReportResponse\"\x00\x12f\n" +
 	"\tGetReport\x12*.snitch.v1.DatabaseServiceGetReportRequest\x1a+.snitch.v1.DatabaseServiceGetReportResponse\"\x00\x12l\n" +
 	"\vListReports\x12,.snitch.v1.DatabaseServiceListReportsRequest\x1a-.snitch.v1.DatabaseServiceListReportsResponse\"\x00\x12o\n" +
-	"\fDeleteReport\x12-.snitch.v1.DatabaseServiceDeleteReportRequest\x1a..snitch.v1.DatabaseServiceDeleteReportResponse\"\x00\x12~\n" +
+	"\fDeleteReport\x12-.snitch.v1.DatabaseServiceDeleteReportRequest\x1a..snitch.v1.DatabaseServiceDeleteReportResponse\"\x00\x12\x81\x01\n" +
+	"\x12UpdateReportStatus\x123.snitch.v1.DatabaseServiceUpdateReportStatusRequest\x1a4.snitch.v1.DatabaseServiceUpdateReportStatusResponse\"\x00\x12\x81\x01\n" +
+	"\x12ListReportAuditLog\x123.snitch.v1.DatabaseServiceListReportAuditLogRequest\x1a4.snitch.v1.DatabaseServiceListReportAuditLogResponse\"\x00\x12\x87\x01\n" +
+	"\x14GetUserReportSummary\x125.snitch.v1.DatabaseServiceGetUserReportSummaryRequest\x1a6.snitch.v1.DatabaseServiceGetUserReportSummaryResponse\"\x00\x12~\n" +
 	"\x11CreateUserHistory\x122.snitch.v1.DatabaseServiceCreateUserHistoryRequest\x1a3.snitch.v1.DatabaseServiceCreateUserHistoryResponse\"\x00\x12u\n" +
-	"\x0eGetUserHistory\x12/.snitch.v1.DatabaseServiceGetUserHistoryRequest\x1a0.snitch.v1.DatabaseServiceGetUserHistoryResponse\"\x00\x12N\n" +
-	"\vListServers\x12\x1d.snitch.v1.ListServersRequest\x1a\x1e.snitch.v1.ListServersResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+	"\x0eGetUserHistory\x12/.snitch.v1.DatabaseServiceGetUserHistoryRequest\x1a0.snitch.v1.DatabaseServiceGetUserHistoryResponse\"\x00\x12f\n" +
+	"\tCreateBan\x12*.snitch.v1.DatabaseServiceCreateBanRequest\x1a+.snitch.v1.DatabaseServiceCreateBanResponse\"\x00\x12l\n" +
+	"\vAppendEvent\x12,.snitch.v1.DatabaseServiceAppendEventRequest\x1a-.snitch.v1.DatabaseServiceAppendEventResponse\"\x00\x12i\n" +
+	"\n" +
+	"ListEvents\x12+.snitch.v1.DatabaseServiceListEventsRequest\x1a,.snitch.v1.DatabaseServiceListEventsResponse\"\x00\x12\x8d\x01\n" +
+	"\x16GetLatestEventSequence\x127.snitch.v1.DatabaseServiceGetLatestEventSequenceRequest\x1a8.snitch.v1.DatabaseServiceGetLatestEventSequenceResponse\"\x00\x12{\n" +
+	"\x10ListOutboxEvents\x121.snitch.v1.DatabaseServiceListOutboxEventsRequest\x1a2.snitch.v1.DatabaseServiceListOutboxEventsResponse\"\x00\x12~\n" +
+	"\x11DeleteOutboxEvent\x122.snitch.v1.DatabaseServiceDeleteOutboxEventRequest\x1a3.snitch.v1.DatabaseServiceDeleteOutboxEventResponse\"\x00\x12N\n" +
+	"\vListServers\x12\x1d.snitch.v1.ListServersRequest\x1a\x1e.snitch.v1.ListServersResponse\"\x00\x12x\n" +
+	"\x0fGetServerConfig\x120.snitch.v1.DatabaseServiceGetServerConfigRequest\x1a1.snitch.v1.DatabaseServiceGetServerConfigResponse\"\x00\x12\x81\x01\n" +
+	"\x12UpdateServerConfig\x123.snitch.v1.DatabaseServiceUpdateServerConfigRequest\x1a4.snitch.v1.DatabaseServiceUpdateServerConfigResponse\"\x00\x12o\n" +
+	"\fCreateAPIKey\x12-.snitch.v1.DatabaseServiceCreateAPIKeyRequest\x1a..snitch.v1.DatabaseServiceCreateAPIKeyResponse\"\x00\x12f\n" +
+	"\tGetAPIKey\x12*.snitch.v1.DatabaseServiceGetAPIKeyRequest\x1a+.snitch.v1.DatabaseServiceGetAPIKeyResponse\"\x00\x12l\n" +
+	"\vListAPIKeys\x12,.snitch.v1.DatabaseServiceListAPIKeysRequest\x1a-.snitch.v1.DatabaseServiceListAPIKeysResponse\"\x00\x12o\n" +
+	"\fRevokeAPIKey\x12-.snitch.v1.DatabaseServiceRevokeAPIKeyRequest\x1a..snitch.v1.DatabaseServiceRevokeAPIKeyResponse\"\x00\x12o\n" +
+	"\fCreateInvite\x12-.snitch.v1.DatabaseServiceCreateInviteRequest\x1a..snitch.v1.DatabaseServiceCreateInviteResponse\"\x00\x12l\n" +
+	"\vListInvites\x12,.snitch.v1.DatabaseServiceListInvitesRequest\x1a-.snitch.v1.DatabaseServiceListInvitesResponse\"\x00\x12o\n" +
+	"\fRevokeInvite\x12-.snitch.v1.DatabaseServiceRevokeInviteRequest\x1a..snitch.v1.DatabaseServiceRevokeInviteResponse\"\x00\x12o\n" +
+	"\fRedeemInvite\x12-.snitch.v1.DatabaseServiceRedeemInviteRequest\x1a..snitch.v1.DatabaseServiceRedeemInviteResponse\"\x00\x12~\n" +
+	"\x11DecideJoinRequest\x122.snitch.v1.DatabaseServiceDecideJoinRequestRequest\x1a3.snitch.v1.DatabaseServiceDecideJoinRequestResponse\"\x00\x12~\n" +
+	"\x11CancelJoinRequest\x122.snitch.v1.DatabaseServiceCancelJoinRequestRequest\x1a3.snitch.v1.DatabaseServiceCancelJoinRequestResponse\"\x00\x12u\n" +
+	"\x0eGetGroupConfig\x12/.snitch.v1.DatabaseServiceGetGroupConfigRequest\x1a0.snitch.v1.DatabaseServiceGetGroupConfigResponse\"\x00\x12~\n" +
+	"\x11UpdateGroupConfig\x122.snitch.v1.DatabaseServiceUpdateGroupConfigRequest\x1a3.snitch.v1.DatabaseServiceUpdateGroupConfigResponse\"\x00\x12\x84\x01\n" +
+	"\x13GetPermissionPolicy\x124.snitch.v1.DatabaseServiceGetPermissionPolicyRequest\x1a5.snitch.v1.DatabaseServiceGetPermissionPolicyResponse\"\x00\x12\x8d\x01\n" +
+	"\x16UpdatePermissionPolicy\x127.snitch.v1.DatabaseServiceUpdatePermissionPolicyRequest\x1a8.snitch.v1.DatabaseServiceUpdatePermissionPolicyResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
 
 var (
 	file_snitch_v1_database_proto_rawDescOnce sync.Once
@@ -1472,64 +5422,245 @@ func file_snitch_v1_database_proto_rawDescGZIP() []byte {
 	return file_snitch_v1_database_proto_rawDescData
 }
 
-var file_snitch_v1_database_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_snitch_v1_database_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_snitch_v1_database_proto_goTypes = []any{
-	(*CreateGroupRequest)(nil),                       // 0: snitch.v1.CreateGroupRequest
-	(*CreateGroupResponse)(nil),                      // 1: snitch.v1.CreateGroupResponse
-	(*FindGroupByServerRequest)(nil),                 // 2: snitch.v1.FindGroupByServerRequest
-	(*FindGroupByServerResponse)(nil),                // 3: snitch.v1.FindGroupByServerResponse
-	(*AddServerToGroupRequest)(nil),                  // 4: snitch.v1.AddServerToGroupRequest
-	(*AddServerToGroupResponse)(nil),                 // 5: snitch.v1.AddServerToGroupResponse
-	(*CreateGroupDatabaseRequest)(nil),               // 6: snitch.v1.CreateGroupDatabaseRequest
-	(*CreateGroupDatabaseResponse)(nil),              // 7: snitch.v1.CreateGroupDatabaseResponse
-	(*DatabaseServiceCreateReportRequest)(nil),       // 8: snitch.v1.DatabaseServiceCreateReportRequest
-	(*DatabaseServiceCreateReportResponse)(nil),      // 9: snitch.v1.DatabaseServiceCreateReportResponse
-	(*DatabaseServiceGetReportRequest)(nil),          // 10: snitch.v1.DatabaseServiceGetReportRequest
-	(*DatabaseServiceGetReportResponse)(nil),         // 11: snitch.v1.DatabaseServiceGetReportResponse
-	(*DatabaseServiceListReportsRequest)(nil),        // 12: snitch.v1.DatabaseServiceListReportsRequest
-	(*DatabaseServiceDeleteReportResponse)(nil),      // 13: snitch.v1.DatabaseServiceDeleteReportResponse
-	(*DatabaseServiceListReportsResponse)(nil),       // 14: snitch.v1.DatabaseServiceListReportsResponse
-	(*DatabaseServiceDeleteReportRequest)(nil),       // 15: snitch.v1.DatabaseServiceDeleteReportRequest
-	(*DatabaseServiceCreateUserHistoryRequest)(nil),  // 16: snitch.v1.DatabaseServiceCreateUserHistoryRequest
-	(*DatabaseServiceCreateUserHistoryResponse)(nil), // 17: snitch.v1.DatabaseServiceCreateUserHistoryResponse
-	(*DatabaseServiceGetUserHistoryRequest)(nil),     // 18: snitch.v1.DatabaseServiceGetUserHistoryRequest
-	(*DbUserHistoryEntry)(nil),                       // 19: snitch.v1.DbUserHistoryEntry
-	(*DatabaseServiceGetUserHistoryResponse)(nil),    // 20: snitch.v1.DatabaseServiceGetUserHistoryResponse
-	(*ListServersRequest)(nil),                       // 21: snitch.v1.ListServersRequest
-	(*ServerEntry)(nil),                              // 22: snitch.v1.ServerEntry
-	(*ListServersResponse)(nil),                      // 23: snitch.v1.ListServersResponse
+	(*CreateGroupRequest)(nil),                            // 0: snitch.v1.CreateGroupRequest
+	(*CreateGroupResponse)(nil),                           // 1: snitch.v1.CreateGroupResponse
+	(*FindGroupByServerRequest)(nil),                      // 2: snitch.v1.FindGroupByServerRequest
+	(*FindGroupByServerResponse)(nil),                     // 3: snitch.v1.FindGroupByServerResponse
+	(*DatabaseServiceListServerGroupsRequest)(nil),        // 4: snitch.v1.DatabaseServiceListServerGroupsRequest
+	(*DatabaseServiceListServerGroupsResponse)(nil),       // 5: snitch.v1.DatabaseServiceListServerGroupsResponse
+	(*AddServerToGroupRequest)(nil),                       // 6: snitch.v1.AddServerToGroupRequest
+	(*AddServerToGroupResponse)(nil),                      // 7: snitch.v1.AddServerToGroupResponse
+	(*RemoveServerFromGroupRequest)(nil),                  // 8: snitch.v1.RemoveServerFromGroupRequest
+	(*RemoveServerFromGroupResponse)(nil),                 // 9: snitch.v1.RemoveServerFromGroupResponse
+	(*CreateGroupDatabaseRequest)(nil),                    // 10: snitch.v1.CreateGroupDatabaseRequest
+	(*CreateGroupDatabaseResponse)(nil),                   // 11: snitch.v1.CreateGroupDatabaseResponse
+	(*DatabaseServiceDeleteGroupRequest)(nil),             // 12: snitch.v1.DatabaseServiceDeleteGroupRequest
+	(*DatabaseServiceDeleteGroupResponse)(nil),            // 13: snitch.v1.DatabaseServiceDeleteGroupResponse
+	(*DatabaseServiceSetServerRoleRequest)(nil),           // 14: snitch.v1.DatabaseServiceSetServerRoleRequest
+	(*DatabaseServiceSetServerRoleResponse)(nil),          // 15: snitch.v1.DatabaseServiceSetServerRoleResponse
+	(*DatabaseServiceTransferGroupOwnershipRequest)(nil),  // 16: snitch.v1.DatabaseServiceTransferGroupOwnershipRequest
+	(*DatabaseServiceTransferGroupOwnershipResponse)(nil), // 17: snitch.v1.DatabaseServiceTransferGroupOwnershipResponse
+	(*DatabaseServiceRestoreGroupRequest)(nil),            // 18: snitch.v1.DatabaseServiceRestoreGroupRequest
+	(*DatabaseServiceRestoreGroupResponse)(nil),           // 19: snitch.v1.DatabaseServiceRestoreGroupResponse
+	(*DatabaseServiceCreateReportRequest)(nil),            // 20: snitch.v1.DatabaseServiceCreateReportRequest
+	(*DatabaseServiceCreateReportResponse)(nil),           // 21: snitch.v1.DatabaseServiceCreateReportResponse
+	(*DatabaseServiceGetReportRequest)(nil),               // 22: snitch.v1.DatabaseServiceGetReportRequest
+	(*DatabaseServiceGetReportResponse)(nil),              // 23: snitch.v1.DatabaseServiceGetReportResponse
+	(*DatabaseServiceListReportsRequest)(nil),             // 24: snitch.v1.DatabaseServiceListReportsRequest
+	(*DatabaseServiceGetUserReportSummaryRequest)(nil),    // 25: snitch.v1.DatabaseServiceGetUserReportSummaryRequest
+	(*DatabaseServiceGetUserReportSummaryResponse)(nil),   // 26: snitch.v1.DatabaseServiceGetUserReportSummaryResponse
+	(*DatabaseServiceDeleteReportResponse)(nil),           // 27: snitch.v1.DatabaseServiceDeleteReportResponse
+	(*DatabaseServiceListReportsResponse)(nil),            // 28: snitch.v1.DatabaseServiceListReportsResponse
+	(*DatabaseServiceDeleteReportRequest)(nil),            // 29: snitch.v1.DatabaseServiceDeleteReportRequest
+	(*DatabaseServiceUpdateReportStatusRequest)(nil),      // 30: snitch.v1.DatabaseServiceUpdateReportStatusRequest
+	(*DatabaseServiceUpdateReportStatusResponse)(nil),     // 31: snitch.v1.DatabaseServiceUpdateReportStatusResponse
+	(*DatabaseServiceReportAuditEntry)(nil),               // 32: snitch.v1.DatabaseServiceReportAuditEntry
+	(*DatabaseServiceListReportAuditLogRequest)(nil),      // 33: snitch.v1.DatabaseServiceListReportAuditLogRequest
+	(*DatabaseServiceListReportAuditLogResponse)(nil),     // 34: snitch.v1.DatabaseServiceListReportAuditLogResponse
+	(*DatabaseServiceCreateUserHistoryRequest)(nil),       // 35: snitch.v1.DatabaseServiceCreateUserHistoryRequest
+	(*DatabaseServiceCreateUserHistoryResponse)(nil),      // 36: snitch.v1.DatabaseServiceCreateUserHistoryResponse
+	(*DatabaseServiceGetUserHistoryRequest)(nil),          // 37: snitch.v1.DatabaseServiceGetUserHistoryRequest
+	(*DbUserHistoryEntry)(nil),                            // 38: snitch.v1.DbUserHistoryEntry
+	(*DatabaseServiceGetUserHistoryResponse)(nil),         // 39: snitch.v1.DatabaseServiceGetUserHistoryResponse
+	(*DatabaseServiceCreateBanRequest)(nil),               // 40: snitch.v1.DatabaseServiceCreateBanRequest
+	(*DatabaseServiceCreateBanResponse)(nil),              // 41: snitch.v1.DatabaseServiceCreateBanResponse
+	(*DatabaseServiceAppendEventRequest)(nil),             // 42: snitch.v1.DatabaseServiceAppendEventRequest
+	(*DatabaseServiceAppendEventResponse)(nil),            // 43: snitch.v1.DatabaseServiceAppendEventResponse
+	(*DatabaseServiceListEventsRequest)(nil),              // 44: snitch.v1.DatabaseServiceListEventsRequest
+	(*DatabaseServiceListEventsResponse)(nil),             // 45: snitch.v1.DatabaseServiceListEventsResponse
+	(*DbOutboxEvent)(nil),                                 // 46: snitch.v1.DbOutboxEvent
+	(*DatabaseServiceListOutboxEventsRequest)(nil),        // 47: snitch.v1.DatabaseServiceListOutboxEventsRequest
+	(*DatabaseServiceListOutboxEventsResponse)(nil),       // 48: snitch.v1.DatabaseServiceListOutboxEventsResponse
+	(*DatabaseServiceDeleteOutboxEventRequest)(nil),       // 49: snitch.v1.DatabaseServiceDeleteOutboxEventRequest
+	(*DatabaseServiceDeleteOutboxEventResponse)(nil),      // 50: snitch.v1.DatabaseServiceDeleteOutboxEventResponse
+	(*DatabaseServiceGetLatestEventSequenceRequest)(nil),  // 51: snitch.v1.DatabaseServiceGetLatestEventSequenceRequest
+	(*DatabaseServiceGetLatestEventSequenceResponse)(nil), // 52: snitch.v1.DatabaseServiceGetLatestEventSequenceResponse
+	(*DatabaseServiceGetServerConfigRequest)(nil),         // 53: snitch.v1.DatabaseServiceGetServerConfigRequest
+	(*DatabaseServiceGetServerConfigResponse)(nil),        // 54: snitch.v1.DatabaseServiceGetServerConfigResponse
+	(*DatabaseServiceUpdateServerConfigRequest)(nil),      // 55: snitch.v1.DatabaseServiceUpdateServerConfigRequest
+	(*DatabaseServiceUpdateServerConfigResponse)(nil),     // 56: snitch.v1.DatabaseServiceUpdateServerConfigResponse
+	(*APIKey)(nil), // 57: snitch.v1.APIKey
+	(*DatabaseServiceCreateAPIKeyRequest)(nil),  // 58: snitch.v1.DatabaseServiceCreateAPIKeyRequest
+	(*DatabaseServiceCreateAPIKeyResponse)(nil), // 59: snitch.v1.DatabaseServiceCreateAPIKeyResponse
+	(*DatabaseServiceGetAPIKeyRequest)(nil),     // 60: snitch.v1.DatabaseServiceGetAPIKeyRequest
+	(*DatabaseServiceGetAPIKeyResponse)(nil),    // 61: snitch.v1.DatabaseServiceGetAPIKeyResponse
+	(*DatabaseServiceListAPIKeysRequest)(nil),   // 62: snitch.v1.DatabaseServiceListAPIKeysRequest
+	(*DatabaseServiceListAPIKeysResponse)(nil),  // 63: snitch.v1.DatabaseServiceListAPIKeysResponse
+	(*DatabaseServiceRevokeAPIKeyRequest)(nil),  // 64: snitch.v1.DatabaseServiceRevokeAPIKeyRequest
+	(*DatabaseServiceRevokeAPIKeyResponse)(nil), // 65: snitch.v1.DatabaseServiceRevokeAPIKeyResponse
+	(*DbInvite)(nil), // 66: snitch.v1.DbInvite
+	(*DatabaseServiceCreateInviteRequest)(nil),            // 67: snitch.v1.DatabaseServiceCreateInviteRequest
+	(*DatabaseServiceCreateInviteResponse)(nil),           // 68: snitch.v1.DatabaseServiceCreateInviteResponse
+	(*DatabaseServiceListInvitesRequest)(nil),             // 69: snitch.v1.DatabaseServiceListInvitesRequest
+	(*DatabaseServiceListInvitesResponse)(nil),            // 70: snitch.v1.DatabaseServiceListInvitesResponse
+	(*DatabaseServiceRevokeInviteRequest)(nil),            // 71: snitch.v1.DatabaseServiceRevokeInviteRequest
+	(*DatabaseServiceRevokeInviteResponse)(nil),           // 72: snitch.v1.DatabaseServiceRevokeInviteResponse
+	(*DatabaseServiceRedeemInviteRequest)(nil),            // 73: snitch.v1.DatabaseServiceRedeemInviteRequest
+	(*DatabaseServiceRedeemInviteResponse)(nil),           // 74: snitch.v1.DatabaseServiceRedeemInviteResponse
+	(*DatabaseServiceDecideJoinRequestRequest)(nil),       // 75: snitch.v1.DatabaseServiceDecideJoinRequestRequest
+	(*DatabaseServiceDecideJoinRequestResponse)(nil),      // 76: snitch.v1.DatabaseServiceDecideJoinRequestResponse
+	(*DatabaseServiceCancelJoinRequestRequest)(nil),       // 77: snitch.v1.DatabaseServiceCancelJoinRequestRequest
+	(*DatabaseServiceCancelJoinRequestResponse)(nil),      // 78: snitch.v1.DatabaseServiceCancelJoinRequestResponse
+	(*DatabaseServiceGetGroupConfigRequest)(nil),          // 79: snitch.v1.DatabaseServiceGetGroupConfigRequest
+	(*DatabaseServiceGetGroupConfigResponse)(nil),         // 80: snitch.v1.DatabaseServiceGetGroupConfigResponse
+	(*DatabaseServiceUpdateGroupConfigRequest)(nil),       // 81: snitch.v1.DatabaseServiceUpdateGroupConfigRequest
+	(*DatabaseServiceUpdateGroupConfigResponse)(nil),      // 82: snitch.v1.DatabaseServiceUpdateGroupConfigResponse
+	(*DatabaseServiceGetPermissionPolicyRequest)(nil),     // 83: snitch.v1.DatabaseServiceGetPermissionPolicyRequest
+	(*DatabaseServiceGetPermissionPolicyResponse)(nil),    // 84: snitch.v1.DatabaseServiceGetPermissionPolicyResponse
+	(*DatabaseServiceUpdatePermissionPolicyRequest)(nil),  // 85: snitch.v1.DatabaseServiceUpdatePermissionPolicyRequest
+	(*DatabaseServiceUpdatePermissionPolicyResponse)(nil), // 86: snitch.v1.DatabaseServiceUpdatePermissionPolicyResponse
+	(*ListServersRequest)(nil),                            // 87: snitch.v1.ListServersRequest
+	(*ServerEntry)(nil),                                   // 88: snitch.v1.ServerEntry
+	(*ListServersResponse)(nil),                           // 89: snitch.v1.ListServersResponse
+	(GroupRole)(0),                                        // 90: snitch.v1.GroupRole
+	(*ServerGroup)(nil),                                   // 91: snitch.v1.ServerGroup
+	(*timestamppb.Timestamp)(nil),                         // 92: google.protobuf.Timestamp
+	(*ReportEvidence)(nil),                                // 93: snitch.v1.ReportEvidence
+	(*SubscribeResponse)(nil),                             // 94: snitch.v1.SubscribeResponse
+	(ReportStatus)(0),                                     // 95: snitch.v1.ReportStatus
+	(ReportAuditAction)(0),                                // 96: snitch.v1.ReportAuditAction
+	(*ServerConfig)(nil),                                  // 97: snitch.v1.ServerConfig
+	(BanPolicy)(0),                                        // 98: snitch.v1.BanPolicy
+	(*GroupConfig)(nil),                                   // 99: snitch.v1.GroupConfig
+	(*PermissionPolicy)(nil),                              // 100: snitch.v1.PermissionPolicy
+	(BotPermission)(0),                                    // 101: snitch.v1.BotPermission
 }
 var file_snitch_v1_database_proto_depIdxs = []int32{
-	11, // 0: snitch.v1.DatabaseServiceListReportsResponse.reports:type_name -> snitch.v1.DatabaseServiceGetReportResponse
-	19, // 1: snitch.v1.DatabaseServiceGetUserHistoryResponse.entries:type_name -> snitch.v1.DbUserHistoryEntry
-	22, // 2: snitch.v1.ListServersResponse.servers:type_name -> snitch.v1.ServerEntry
-	0,  // 3: snitch.v1.DatabaseService.CreateGroup:input_type -> snitch.v1.CreateGroupRequest
-	2,  // 4: snitch.v1.DatabaseService.FindGroupByServer:input_type -> snitch.v1.FindGroupByServerRequest
-	4,  // 5: snitch.v1.DatabaseService.AddServerToGroup:input_type -> snitch.v1.AddServerToGroupRequest
-	6,  // 6: snitch.v1.DatabaseService.CreateGroupDatabase:input_type -> snitch.v1.CreateGroupDatabaseRequest
-	8,  // 7: snitch.v1.DatabaseService.CreateReport:input_type -> snitch.v1.DatabaseServiceCreateReportRequest
-	10, // 8: snitch.v1.DatabaseService.GetReport:input_type -> snitch.v1.DatabaseServiceGetReportRequest
-	12, // 9: snitch.v1.DatabaseService.ListReports:input_type -> snitch.v1.DatabaseServiceListReportsRequest
-	15, // 10: snitch.v1.DatabaseService.DeleteReport:input_type -> snitch.v1.DatabaseServiceDeleteReportRequest
-	16, // 11: snitch.v1.DatabaseService.CreateUserHistory:input_type -> snitch.v1.DatabaseServiceCreateUserHistoryRequest
-	18, // 12: snitch.v1.DatabaseService.GetUserHistory:input_type -> snitch.v1.DatabaseServiceGetUserHistoryRequest
-	21, // 13: snitch.v1.DatabaseService.ListServers:input_type -> snitch.v1.ListServersRequest
-	1,  // 14: snitch.v1.DatabaseService.CreateGroup:output_type -> snitch.v1.CreateGroupResponse
-	3,  // 15: snitch.v1.DatabaseService.FindGroupByServer:output_type -> snitch.v1.FindGroupByServerResponse
-	5,  // 16: snitch.v1.DatabaseService.AddServerToGroup:output_type -> snitch.v1.AddServerToGroupResponse
-	7,  // 17: snitch.v1.DatabaseService.CreateGroupDatabase:output_type -> snitch.v1.CreateGroupDatabaseResponse
-	9,  // 18: snitch.v1.DatabaseService.CreateReport:output_type -> snitch.v1.DatabaseServiceCreateReportResponse
-	11, // 19: snitch.v1.DatabaseService.GetReport:output_type -> snitch.v1.DatabaseServiceGetReportResponse
-	14, // 20: snitch.v1.DatabaseService.ListReports:output_type -> snitch.v1.DatabaseServiceListReportsResponse
-	13, // 21: snitch.v1.DatabaseService.DeleteReport:output_type -> snitch.v1.DatabaseServiceDeleteReportResponse
-	17, // 22: snitch.v1.DatabaseService.CreateUserHistory:output_type -> snitch.v1.DatabaseServiceCreateUserHistoryResponse
-	20, // 23: snitch.v1.DatabaseService.GetUserHistory:output_type -> snitch.v1.DatabaseServiceGetUserHistoryResponse
-	23, // 24: snitch.v1.DatabaseService.ListServers:output_type -> snitch.v1.ListServersResponse
-	14, // [14:25] is the sub-list for method output_type
-	3,  // [3:14] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	90,  // 0: snitch.v1.FindGroupByServerResponse.role:type_name -> snitch.v1.GroupRole
+	91,  // 1: snitch.v1.DatabaseServiceListServerGroupsResponse.groups:type_name -> snitch.v1.ServerGroup
+	90,  // 2: snitch.v1.AddServerToGroupRequest.role:type_name -> snitch.v1.GroupRole
+	92,  // 3: snitch.v1.DatabaseServiceDeleteGroupResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	92,  // 4: snitch.v1.DatabaseServiceDeleteGroupResponse.purge_after:type_name -> google.protobuf.Timestamp
+	90,  // 5: snitch.v1.DatabaseServiceSetServerRoleRequest.role:type_name -> snitch.v1.GroupRole
+	90,  // 6: snitch.v1.DatabaseServiceSetServerRoleResponse.role:type_name -> snitch.v1.GroupRole
+	93,  // 7: snitch.v1.DatabaseServiceCreateReportRequest.evidence:type_name -> snitch.v1.ReportEvidence
+	94,  // 8: snitch.v1.DatabaseServiceCreateReportRequest.event:type_name -> snitch.v1.SubscribeResponse
+	95,  // 9: snitch.v1.DatabaseServiceGetReportResponse.status:type_name -> snitch.v1.ReportStatus
+	93,  // 10: snitch.v1.DatabaseServiceGetReportResponse.evidence:type_name -> snitch.v1.ReportEvidence
+	95,  // 11: snitch.v1.DatabaseServiceListReportsRequest.status:type_name -> snitch.v1.ReportStatus
+	92,  // 12: snitch.v1.DatabaseServiceListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
+	92,  // 13: snitch.v1.DatabaseServiceListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
+	23,  // 14: snitch.v1.DatabaseServiceListReportsResponse.reports:type_name -> snitch.v1.DatabaseServiceGetReportResponse
+	94,  // 15: snitch.v1.DatabaseServiceDeleteReportRequest.event:type_name -> snitch.v1.SubscribeResponse
+	95,  // 16: snitch.v1.DatabaseServiceUpdateReportStatusRequest.status:type_name -> snitch.v1.ReportStatus
+	95,  // 17: snitch.v1.DatabaseServiceUpdateReportStatusRequest.expected_status:type_name -> snitch.v1.ReportStatus
+	95,  // 18: snitch.v1.DatabaseServiceUpdateReportStatusResponse.status:type_name -> snitch.v1.ReportStatus
+	96,  // 19: snitch.v1.DatabaseServiceReportAuditEntry.action:type_name -> snitch.v1.ReportAuditAction
+	32,  // 20: snitch.v1.DatabaseServiceListReportAuditLogResponse.entries:type_name -> snitch.v1.DatabaseServiceReportAuditEntry
+	38,  // 21: snitch.v1.DatabaseServiceGetUserHistoryResponse.entries:type_name -> snitch.v1.DbUserHistoryEntry
+	94,  // 22: snitch.v1.DatabaseServiceAppendEventRequest.event:type_name -> snitch.v1.SubscribeResponse
+	94,  // 23: snitch.v1.DatabaseServiceListEventsResponse.events:type_name -> snitch.v1.SubscribeResponse
+	94,  // 24: snitch.v1.DbOutboxEvent.event:type_name -> snitch.v1.SubscribeResponse
+	46,  // 25: snitch.v1.DatabaseServiceListOutboxEventsResponse.events:type_name -> snitch.v1.DbOutboxEvent
+	97,  // 26: snitch.v1.DatabaseServiceGetServerConfigResponse.config:type_name -> snitch.v1.ServerConfig
+	98,  // 27: snitch.v1.DatabaseServiceUpdateServerConfigRequest.ban_policy:type_name -> snitch.v1.BanPolicy
+	97,  // 28: snitch.v1.DatabaseServiceUpdateServerConfigResponse.config:type_name -> snitch.v1.ServerConfig
+	57,  // 29: snitch.v1.DatabaseServiceCreateAPIKeyResponse.key:type_name -> snitch.v1.APIKey
+	57,  // 30: snitch.v1.DatabaseServiceGetAPIKeyResponse.key:type_name -> snitch.v1.APIKey
+	57,  // 31: snitch.v1.DatabaseServiceListAPIKeysResponse.keys:type_name -> snitch.v1.APIKey
+	57,  // 32: snitch.v1.DatabaseServiceRevokeAPIKeyResponse.key:type_name -> snitch.v1.APIKey
+	92,  // 33: snitch.v1.DatabaseServiceCreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	66,  // 34: snitch.v1.DatabaseServiceCreateInviteResponse.invite:type_name -> snitch.v1.DbInvite
+	66,  // 35: snitch.v1.DatabaseServiceListInvitesResponse.invites:type_name -> snitch.v1.DbInvite
+	66,  // 36: snitch.v1.DatabaseServiceRevokeInviteResponse.invite:type_name -> snitch.v1.DbInvite
+	99,  // 37: snitch.v1.DatabaseServiceGetGroupConfigResponse.config:type_name -> snitch.v1.GroupConfig
+	99,  // 38: snitch.v1.DatabaseServiceUpdateGroupConfigResponse.config:type_name -> snitch.v1.GroupConfig
+	100, // 39: snitch.v1.DatabaseServiceGetPermissionPolicyResponse.policy:type_name -> snitch.v1.PermissionPolicy
+	101, // 40: snitch.v1.DatabaseServiceUpdatePermissionPolicyRequest.permission:type_name -> snitch.v1.BotPermission
+	100, // 41: snitch.v1.DatabaseServiceUpdatePermissionPolicyResponse.policy:type_name -> snitch.v1.PermissionPolicy
+	90,  // 42: snitch.v1.ServerEntry.role:type_name -> snitch.v1.GroupRole
+	88,  // 43: snitch.v1.ListServersResponse.servers:type_name -> snitch.v1.ServerEntry
+	0,   // 44: snitch.v1.DatabaseService.CreateGroup:input_type -> snitch.v1.CreateGroupRequest
+	2,   // 45: snitch.v1.DatabaseService.FindGroupByServer:input_type -> snitch.v1.FindGroupByServerRequest
+	4,   // 46: snitch.v1.DatabaseService.ListServerGroups:input_type -> snitch.v1.DatabaseServiceListServerGroupsRequest
+	6,   // 47: snitch.v1.DatabaseService.AddServerToGroup:input_type -> snitch.v1.AddServerToGroupRequest
+	8,   // 48: snitch.v1.DatabaseService.RemoveServerFromGroup:input_type -> snitch.v1.RemoveServerFromGroupRequest
+	14,  // 49: snitch.v1.DatabaseService.SetServerRole:input_type -> snitch.v1.DatabaseServiceSetServerRoleRequest
+	16,  // 50: snitch.v1.DatabaseService.TransferGroupOwnership:input_type -> snitch.v1.DatabaseServiceTransferGroupOwnershipRequest
+	10,  // 51: snitch.v1.DatabaseService.CreateGroupDatabase:input_type -> snitch.v1.CreateGroupDatabaseRequest
+	12,  // 52: snitch.v1.DatabaseService.DeleteGroup:input_type -> snitch.v1.DatabaseServiceDeleteGroupRequest
+	18,  // 53: snitch.v1.DatabaseService.RestoreGroup:input_type -> snitch.v1.DatabaseServiceRestoreGroupRequest
+	20,  // 54: snitch.v1.DatabaseService.CreateReport:input_type -> snitch.v1.DatabaseServiceCreateReportRequest
+	22,  // 55: snitch.v1.DatabaseService.GetReport:input_type -> snitch.v1.DatabaseServiceGetReportRequest
+	24,  // 56: snitch.v1.DatabaseService.ListReports:input_type -> snitch.v1.DatabaseServiceListReportsRequest
+	29,  // 57: snitch.v1.DatabaseService.DeleteReport:input_type -> snitch.v1.DatabaseServiceDeleteReportRequest
+	30,  // 58: snitch.v1.DatabaseService.UpdateReportStatus:input_type -> snitch.v1.DatabaseServiceUpdateReportStatusRequest
+	33,  // 59: snitch.v1.DatabaseService.ListReportAuditLog:input_type -> snitch.v1.DatabaseServiceListReportAuditLogRequest
+	25,  // 60: snitch.v1.DatabaseService.GetUserReportSummary:input_type -> snitch.v1.DatabaseServiceGetUserReportSummaryRequest
+	35,  // 61: snitch.v1.DatabaseService.CreateUserHistory:input_type -> snitch.v1.DatabaseServiceCreateUserHistoryRequest
+	37,  // 62: snitch.v1.DatabaseService.GetUserHistory:input_type -> snitch.v1.DatabaseServiceGetUserHistoryRequest
+	40,  // 63: snitch.v1.DatabaseService.CreateBan:input_type -> snitch.v1.DatabaseServiceCreateBanRequest
+	42,  // 64: snitch.v1.DatabaseService.AppendEvent:input_type -> snitch.v1.DatabaseServiceAppendEventRequest
+	44,  // 65: snitch.v1.DatabaseService.ListEvents:input_type -> snitch.v1.DatabaseServiceListEventsRequest
+	51,  // 66: snitch.v1.DatabaseService.GetLatestEventSequence:input_type -> snitch.v1.DatabaseServiceGetLatestEventSequenceRequest
+	47,  // 67: snitch.v1.DatabaseService.ListOutboxEvents:input_type -> snitch.v1.DatabaseServiceListOutboxEventsRequest
+	49,  // 68: snitch.v1.DatabaseService.DeleteOutboxEvent:input_type -> snitch.v1.DatabaseServiceDeleteOutboxEventRequest
+	87,  // 69: snitch.v1.DatabaseService.ListServers:input_type -> snitch.v1.ListServersRequest
+	53,  // 70: snitch.v1.DatabaseService.GetServerConfig:input_type -> snitch.v1.DatabaseServiceGetServerConfigRequest
+	55,  // 71: snitch.v1.DatabaseService.UpdateServerConfig:input_type -> snitch.v1.DatabaseServiceUpdateServerConfigRequest
+	58,  // 72: snitch.v1.DatabaseService.CreateAPIKey:input_type -> snitch.v1.DatabaseServiceCreateAPIKeyRequest
+	60,  // 73: snitch.v1.DatabaseService.GetAPIKey:input_type -> snitch.v1.DatabaseServiceGetAPIKeyRequest
+	62,  // 74: snitch.v1.DatabaseService.ListAPIKeys:input_type -> snitch.v1.DatabaseServiceListAPIKeysRequest
+	64,  // 75: snitch.v1.DatabaseService.RevokeAPIKey:input_type -> snitch.v1.DatabaseServiceRevokeAPIKeyRequest
+	67,  // 76: snitch.v1.DatabaseService.CreateInvite:input_type -> snitch.v1.DatabaseServiceCreateInviteRequest
+	69,  // 77: snitch.v1.DatabaseService.ListInvites:input_type -> snitch.v1.DatabaseServiceListInvitesRequest
+	71,  // 78: snitch.v1.DatabaseService.RevokeInvite:input_type -> snitch.v1.DatabaseServiceRevokeInviteRequest
+	73,  // 79: snitch.v1.DatabaseService.RedeemInvite:input_type -> snitch.v1.DatabaseServiceRedeemInviteRequest
+	75,  // 80: snitch.v1.DatabaseService.DecideJoinRequest:input_type -> snitch.v1.DatabaseServiceDecideJoinRequestRequest
+	77,  // 81: snitch.v1.DatabaseService.CancelJoinRequest:input_type -> snitch.v1.DatabaseServiceCancelJoinRequestRequest
+	79,  // 82: snitch.v1.DatabaseService.GetGroupConfig:input_type -> snitch.v1.DatabaseServiceGetGroupConfigRequest
+	81,  // 83: snitch.v1.DatabaseService.UpdateGroupConfig:input_type -> snitch.v1.DatabaseServiceUpdateGroupConfigRequest
+	83,  // 84: snitch.v1.DatabaseService.GetPermissionPolicy:input_type -> snitch.v1.DatabaseServiceGetPermissionPolicyRequest
+	85,  // 85: snitch.v1.DatabaseService.UpdatePermissionPolicy:input_type -> snitch.v1.DatabaseServiceUpdatePermissionPolicyRequest
+	1,   // 86: snitch.v1.DatabaseService.CreateGroup:output_type -> snitch.v1.CreateGroupResponse
+	3,   // 87: snitch.v1.DatabaseService.FindGroupByServer:output_type -> snitch.v1.FindGroupByServerResponse
+	5,   // 88: snitch.v1.DatabaseService.ListServerGroups:output_type -> snitch.v1.DatabaseServiceListServerGroupsResponse
+	7,   // 89: snitch.v1.DatabaseService.AddServerToGroup:output_type -> snitch.v1.AddServerToGroupResponse
+	9,   // 90: snitch.v1.DatabaseService.RemoveServerFromGroup:output_type -> snitch.v1.RemoveServerFromGroupResponse
+	15,  // 91: snitch.v1.DatabaseService.SetServerRole:output_type -> snitch.v1.DatabaseServiceSetServerRoleResponse
+	17,  // 92: snitch.v1.DatabaseService.TransferGroupOwnership:output_type -> snitch.v1.DatabaseServiceTransferGroupOwnershipResponse
+	11,  // 93: snitch.v1.DatabaseService.CreateGroupDatabase:output_type -> snitch.v1.CreateGroupDatabaseResponse
+	13,  // 94: snitch.v1.DatabaseService.DeleteGroup:output_type -> snitch.v1.DatabaseServiceDeleteGroupResponse
+	19,  // 95: snitch.v1.DatabaseService.RestoreGroup:output_type -> snitch.v1.DatabaseServiceRestoreGroupResponse
+	21,  // 96: snitch.v1.DatabaseService.CreateReport:output_type -> snitch.v1.DatabaseServiceCreateReportResponse
+	23,  // 97: snitch.v1.DatabaseService.GetReport:output_type -> snitch.v1.DatabaseServiceGetReportResponse
+	28,  // 98: snitch.v1.DatabaseService.ListReports:output_type -> snitch.v1.DatabaseServiceListReportsResponse
+	27,  // 99: snitch.v1.DatabaseService.DeleteReport:output_type -> snitch.v1.DatabaseServiceDeleteReportResponse
+	31,  // 100: snitch.v1.DatabaseService.UpdateReportStatus:output_type -> snitch.v1.DatabaseServiceUpdateReportStatusResponse
+	34,  // 101: snitch.v1.DatabaseService.ListReportAuditLog:output_type -> snitch.v1.DatabaseServiceListReportAuditLogResponse
+	26,  // 102: snitch.v1.DatabaseService.GetUserReportSummary:output_type -> snitch.v1.DatabaseServiceGetUserReportSummaryResponse
+	36,  // 103: snitch.v1.DatabaseService.CreateUserHistory:output_type -> snitch.v1.DatabaseServiceCreateUserHistoryResponse
+	39,  // 104: snitch.v1.DatabaseService.GetUserHistory:output_type -> snitch.v1.DatabaseServiceGetUserHistoryResponse
+	41,  // 105: snitch.v1.DatabaseService.CreateBan:output_type -> snitch.v1.DatabaseServiceCreateBanResponse
+	43,  // 106: snitch.v1.DatabaseService.AppendEvent:output_type -> snitch.v1.DatabaseServiceAppendEventResponse
+	45,  // 107: snitch.v1.DatabaseService.ListEvents:output_type -> snitch.v1.DatabaseServiceListEventsResponse
+	52,  // 108: snitch.v1.DatabaseService.GetLatestEventSequence:output_type -> snitch.v1.DatabaseServiceGetLatestEventSequenceResponse
+	48,  // 109: snitch.v1.DatabaseService.ListOutboxEvents:output_type -> snitch.v1.DatabaseServiceListOutboxEventsResponse
+	50,  // 110: snitch.v1.DatabaseService.DeleteOutboxEvent:output_type -> snitch.v1.DatabaseServiceDeleteOutboxEventResponse
+	89,  // 111: snitch.v1.DatabaseService.ListServers:output_type -> snitch.v1.ListServersResponse
+	54,  // 112: snitch.v1.DatabaseService.GetServerConfig:output_type -> snitch.v1.DatabaseServiceGetServerConfigResponse
+	56,  // 113: snitch.v1.DatabaseService.UpdateServerConfig:output_type -> snitch.v1.DatabaseServiceUpdateServerConfigResponse
+	59,  // 114: snitch.v1.DatabaseService.CreateAPIKey:output_type -> snitch.v1.DatabaseServiceCreateAPIKeyResponse
+	61,  // 115: snitch.v1.DatabaseService.GetAPIKey:output_type -> snitch.v1.DatabaseServiceGetAPIKeyResponse
+	63,  // 116: snitch.v1.DatabaseService.ListAPIKeys:output_type -> snitch.v1.DatabaseServiceListAPIKeysResponse
+	65,  // 117: snitch.v1.DatabaseService.RevokeAPIKey:output_type -> snitch.v1.DatabaseServiceRevokeAPIKeyResponse
+	68,  // 118: snitch.v1.DatabaseService.CreateInvite:output_type -> snitch.v1.DatabaseServiceCreateInviteResponse
+	70,  // 119: snitch.v1.DatabaseService.ListInvites:output_type -> snitch.v1.DatabaseServiceListInvitesResponse
+	72,  // 120: snitch.v1.DatabaseService.RevokeInvite:output_type -> snitch.v1.DatabaseServiceRevokeInviteResponse
+	74,  // 121: snitch.v1.DatabaseService.RedeemInvite:output_type -> snitch.v1.DatabaseServiceRedeemInviteResponse
+	76,  // 122: snitch.v1.DatabaseService.DecideJoinRequest:output_type -> snitch.v1.DatabaseServiceDecideJoinRequestResponse
+	78,  // 123: snitch.v1.DatabaseService.CancelJoinRequest:output_type -> snitch.v1.DatabaseServiceCancelJoinRequestResponse
+	80,  // 124: snitch.v1.DatabaseService.GetGroupConfig:output_type -> snitch.v1.DatabaseServiceGetGroupConfigResponse
+	82,  // 125: snitch.v1.DatabaseService.UpdateGroupConfig:output_type -> snitch.v1.DatabaseServiceUpdateGroupConfigResponse
+	84,  // 126: snitch.v1.DatabaseService.GetPermissionPolicy:output_type -> snitch.v1.DatabaseServiceGetPermissionPolicyResponse
+	86,  // 127: snitch.v1.DatabaseService.UpdatePermissionPolicy:output_type -> snitch.v1.DatabaseServiceUpdatePermissionPolicyResponse
+	86,  // [86:128] is the sub-list for method output_type
+	44,  // [44:86] is the sub-list for method input_type
+	44,  // [44:44] is the sub-list for extension type_name
+	44,  // [44:44] is the sub-list for extension extendee
+	0,   // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_snitch_v1_database_proto_init() }
@@ -1537,19 +5668,34 @@ func file_snitch_v1_database_proto_init() {
 	if File_snitch_v1_database_proto != nil {
 		return
 	}
-	file_snitch_v1_database_proto_msgTypes[8].OneofWrappers = []any{}
-	file_snitch_v1_database_proto_msgTypes[11].OneofWrappers = []any{}
-	file_snitch_v1_database_proto_msgTypes[12].OneofWrappers = []any{}
-	file_snitch_v1_database_proto_msgTypes[16].OneofWrappers = []any{}
-	file_snitch_v1_database_proto_msgTypes[18].OneofWrappers = []any{}
-	file_snitch_v1_database_proto_msgTypes[19].OneofWrappers = []any{}
+	file_snitch_v1_config_proto_init()
+	file_snitch_v1_events_proto_init()
+	file_snitch_v1_registration_proto_init()
+	file_snitch_v1_report_proto_init()
+	file_snitch_v1_database_proto_msgTypes[2].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[20].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[23].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[24].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[26].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[32].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[33].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[35].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[37].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[38].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[40].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[55].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[57].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[66].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[67].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[74].OneofWrappers = []any{}
+	file_snitch_v1_database_proto_msgTypes[81].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_database_proto_rawDesc), len(file_snitch_v1_database_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,9 @@ const (
 	EventType_EVENT_TYPE_REPORT_CREATED EventType = 1
 	EventType_EVENT_TYPE_REPORT_DELETED EventType = 2
 	EventType_EVENT_TYPE_USER_BANNED    EventType = 3
+	EventType_EVENT_TYPE_JOIN_REQUESTED EventType = 4
+	EventType_EVENT_TYPE_SERVER_REMOVED EventType = 5
+	EventType_EVENT_TYPE_GROUP_DELETED  EventType = 6
 )
 
 // Enum value maps for EventType.
@@ -38,12 +41,18 @@ var (
 		1: "EVENT_TYPE_REPORT_CREATED",
 		2: "EVENT_TYPE_REPORT_DELETED",
 		3: "EVENT_TYPE_USER_BANNED",
+		4: "EVENT_TYPE_JOIN_REQUESTED",
+		5: "EVENT_TYPE_SERVER_REMOVED",
+		6: "EVENT_TYPE_GROUP_DELETED",
 	}
 	EventType_value = map[string]int32{
 		"EVENT_TYPE_UNSPECIFIED":    0,
 		"EVENT_TYPE_REPORT_CREATED": 1,
 		"EVENT_TYPE_REPORT_DELETED": 2,
 		"EVENT_TYPE_USER_BANNED":    3,
+		"EVENT_TYPE_JOIN_REQUESTED": 4,
+		"EVENT_TYPE_SERVER_REMOVED": 5,
+		"EVENT_TYPE_GROUP_DELETED":  6,
 	}
 )
 
@@ -85,9 +94,16 @@ type SubscribeResponse struct {
 	//	*SubscribeResponse_ReportCreated
 	//	*SubscribeResponse_ReportDeleted
 	//	*SubscribeResponse_UserBanned
-	Data          isSubscribeResponse_Data `protobuf_oneof:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	//	*SubscribeResponse_JoinRequested
+	//	*SubscribeResponse_ServerRemoved
+	//	*SubscribeResponse_GroupDeleted
+	Data isSubscribeResponse_Data `protobuf_oneof:"data"`
+	// sequence orders the events of a group; subscribers resume from the last one they saw
+	Sequence int64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// idempotency_key identifies an event across redeliveries; delivery is at least once
+	IdempotencyKey string `protobuf:"bytes,12,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SubscribeResponse) Reset() {
@@ -182,6 +198,47 @@ func (x *SubscribeResponse) GetUserBanned() *UserBannedEvent {
 	return nil
 }
 
+func (x *SubscribeResponse) GetJoinRequested() *JoinRequestedEvent {
+	if x != nil {
+		if x, ok := x.Data.(*SubscribeResponse_JoinRequested); ok {
+			return x.JoinRequested
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetServerRemoved() *ServerRemovedEvent {
+	if x != nil {
+		if x, ok := x.Data.(*SubscribeResponse_ServerRemoved); ok {
+			return x.ServerRemoved
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetGroupDeleted() *GroupDeletedEvent {
+	if x != nil {
+		if x, ok := x.Data.(*SubscribeResponse_GroupDeleted); ok {
+			return x.GroupDeleted
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SubscribeResponse) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type isSubscribeResponse_Data interface {
 	isSubscribeResponse_Data()
 }
@@ -198,12 +255,30 @@ type SubscribeResponse_UserBanned struct {
 	UserBanned *UserBannedEvent `protobuf:"bytes,7,opt,name=user_banned,json=userBanned,proto3,oneof"`
 }
 
+type SubscribeResponse_JoinRequested struct {
+	JoinRequested *JoinRequestedEvent `protobuf:"bytes,8,opt,name=join_requested,json=joinRequested,proto3,oneof"`
+}
+
+type SubscribeResponse_ServerRemoved struct {
+	ServerRemoved *ServerRemovedEvent `protobuf:"bytes,9,opt,name=server_removed,json=serverRemoved,proto3,oneof"`
+}
+
+type SubscribeResponse_GroupDeleted struct {
+	GroupDeleted *GroupDeletedEvent `protobuf:"bytes,10,opt,name=group_deleted,json=groupDeleted,proto3,oneof"`
+}
+
 func (*SubscribeResponse_ReportCreated) isSubscribeResponse_Data() {}
 
 func (*SubscribeResponse_ReportDeleted) isSubscribeResponse_Data() {}
 
 func (*SubscribeResponse_UserBanned) isSubscribeResponse_Data() {}
 
+func (*SubscribeResponse_JoinRequested) isSubscribeResponse_Data() {}
+
+func (*SubscribeResponse_ServerRemoved) isSubscribeResponse_Data() {}
+
+func (*SubscribeResponse_GroupDeleted) isSubscribeResponse_Data() {}
+
 type ReportCreatedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
@@ -321,6 +396,7 @@ type UserBannedEvent struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	BanId         int64                  `protobuf:"varint,4,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -376,17 +452,202 @@ func (x *UserBannedEvent) GetReason() string {
 	return ""
 }
 
-type SubscribeRequest struct {
+func (x *UserBannedEvent) GetBanId() int64 {
+	if x != nil {
+		return x.BanId
+	}
+	return 0
+}
+
+type JoinRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequestedEvent) Reset() {
+	*x = JoinRequestedEvent{}
+	mi := &file_snitch_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestedEvent) ProtoMessage() {}
+
+func (x *JoinRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestedEvent.ProtoReflect.Descriptor instead.
+func (*JoinRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JoinRequestedEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *JoinRequestedEvent) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *JoinRequestedEvent) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// ServerRemovedEvent is published once a server has left or been kicked from a group
+type ServerRemovedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RemovedBy     string                 `protobuf:"bytes,2,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
+	Kicked        bool                   `protobuf:"varint,3,opt,name=kicked,proto3" json:"kicked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerRemovedEvent) Reset() {
+	*x = ServerRemovedEvent{}
+	mi := &file_snitch_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerRemovedEvent) ProtoMessage() {}
+
+func (x *ServerRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerRemovedEvent.ProtoReflect.Descriptor instead.
+func (*ServerRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServerRemovedEvent) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerRemovedEvent) GetRemovedBy() string {
+	if x != nil {
+		return x.RemovedBy
+	}
+	return ""
+}
+
+func (x *ServerRemovedEvent) GetKicked() bool {
+	if x != nil {
+		return x.Kicked
+	}
+	return false
+}
+
+// GroupDeletedEvent is published when the owning server deletes the group
+type GroupDeletedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventTypes    []EventType            `protobuf:"varint,1,rep,packed,name=event_types,json=eventTypes,proto3,enum=snitch.v1.EventType" json:"event_types,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,1,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *GroupDeletedEvent) Reset() {
+	*x = GroupDeletedEvent{}
+	mi := &file_snitch_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDeletedEvent) ProtoMessage() {}
+
+func (x *GroupDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDeletedEvent.ProtoReflect.Descriptor instead.
+func (*GroupDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroupDeletedEvent) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *GroupDeletedEvent) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// event_types limits the stream to these types; when empty every type is sent
+	EventTypes []EventType `protobuf:"varint,1,rep,packed,name=event_types,json=eventTypes,proto3,enum=snitch.v1.EventType" json:"event_types,omitempty"`
+	// group_id picks one of the server's groups; other groups are refused with PermissionDenied
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// last_seen_sequence replays the events after it before going live; without it only new events are sent
+	LastSeenSequence *int64 `protobuf:"varint,3,opt,name=last_seen_sequence,json=lastSeenSequence,proto3,oneof" json:"last_seen_sequence,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
-	mi := &file_snitch_v1_events_proto_msgTypes[4]
+	mi := &file_snitch_v1_events_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +659,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_events_proto_msgTypes[4]
+	mi := &file_snitch_v1_events_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +672,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_events_proto_rawDescGZIP(), []int{4}
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubscribeRequest) GetEventTypes() []EventType {
@@ -428,11 +689,106 @@ func (x *SubscribeRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *SubscribeRequest) GetLastSeenSequence() int64 {
+	if x != nil && x.LastSeenSequence != nil {
+		return *x.LastSeenSequence
+	}
+	return 0
+}
+
+type GetEventCursorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventCursorRequest) Reset() {
+	*x = GetEventCursorRequest{}
+	mi := &file_snitch_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCursorRequest) ProtoMessage() {}
+
+func (x *GetEventCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCursorRequest.ProtoReflect.Descriptor instead.
+func (*GetEventCursorRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetEventCursorRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetEventCursorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventCursorResponse) Reset() {
+	*x = GetEventCursorResponse{}
+	mi := &file_snitch_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCursorResponse) ProtoMessage() {}
+
+func (x *GetEventCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCursorResponse.ProtoReflect.Descriptor instead.
+func (*GetEventCursorResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetEventCursorResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 var File_snitch_v1_events_proto protoreflect.FileDescriptor
 
 const file_snitch_v1_events_proto_rawDesc = "" +
 	"\n" +
-	"\x16snitch/v1/events.proto\x12\tsnitch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x03\n" +
+	"\x16snitch/v1/events.proto\x12\tsnitch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x05\n" +
 	"\x11SubscribeResponse\x12(\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x14.snitch.v1.EventTypeR\x04type\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n" +
@@ -441,7 +797,13 @@ const file_snitch_v1_events_proto_rawDesc = "" +
 	"\x0ereport_created\x18\x05 \x01(\v2\x1d.snitch.v1.ReportCreatedEventH\x00R\rreportCreated\x12F\n" +
 	"\x0ereport_deleted\x18\x06 \x01(\v2\x1d.snitch.v1.ReportDeletedEventH\x00R\rreportDeleted\x12=\n" +
 	"\vuser_banned\x18\a \x01(\v2\x1a.snitch.v1.UserBannedEventH\x00R\n" +
-	"userBannedB\x06\n" +
+	"userBanned\x12F\n" +
+	"\x0ejoin_requested\x18\b \x01(\v2\x1d.snitch.v1.JoinRequestedEventH\x00R\rjoinRequested\x12F\n" +
+	"\x0eserver_removed\x18\t \x01(\v2\x1d.snitch.v1.ServerRemovedEventH\x00R\rserverRemoved\x12C\n" +
+	"\rgroup_deleted\x18\n" +
+	" \x01(\v2\x1c.snitch.v1.GroupDeletedEventH\x00R\fgroupDeleted\x12\x1a\n" +
+	"\bsequence\x18\v \x01(\x03R\bsequence\x12'\n" +
+	"\x0fidempotency_key\x18\f \x01(\tR\x0eidempotencyKeyB\x06\n" +
 	"\x04data\"\x94\x01\n" +
 	"\x12ReportCreatedEvent\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\x03R\breportId\x12\x1f\n" +
@@ -452,22 +814,48 @@ const file_snitch_v1_events_proto_rawDesc = "" +
 	"\vreport_text\x18\x04 \x01(\tR\n" +
 	"reportText\"1\n" +
 	"\x12ReportDeletedEvent\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x03R\breportId\"_\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\"v\n" +
 	"\x0fUserBannedEvent\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"d\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x15\n" +
+	"\x06ban_id\x18\x04 \x01(\x03R\x05banId\"s\n" +
+	"\x12JoinRequestedEvent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"h\n" +
+	"\x12ServerRemovedEvent\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"removed_by\x18\x02 \x01(\tR\tremovedBy\x12\x16\n" +
+	"\x06kicked\x18\x03 \x01(\bR\x06kicked\"o\n" +
+	"\x11GroupDeletedEvent\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x01 \x01(\tR\tdeletedBy\x12;\n" +
+	"\vpurge_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\"\xae\x01\n" +
 	"\x10SubscribeRequest\x125\n" +
 	"\vevent_types\x18\x01 \x03(\x0e2\x14.snitch.v1.EventTypeR\n" +
 	"eventTypes\x12\x19\n" +
-	"\bgroup_id\x18\x02 \x01(\tR\agroupId*\x81\x01\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x121\n" +
+	"\x12last_seen_sequence\x18\x03 \x01(\x03H\x00R\x10lastSeenSequence\x88\x01\x01B\x15\n" +
+	"\x13_last_seen_sequence\"2\n" +
+	"\x15GetEventCursorRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"4\n" +
+	"\x16GetEventCursorResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence*\xdd\x01\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19EVENT_TYPE_REPORT_CREATED\x10\x01\x12\x1d\n" +
 	"\x19EVENT_TYPE_REPORT_DELETED\x10\x02\x12\x1a\n" +
-	"\x16EVENT_TYPE_USER_BANNED\x10\x032X\n" +
+	"\x16EVENT_TYPE_USER_BANNED\x10\x03\x12\x1d\n" +
+	"\x19EVENT_TYPE_JOIN_REQUESTED\x10\x04\x12\x1d\n" +
+	"\x19EVENT_TYPE_SERVER_REMOVED\x10\x05\x12\x1c\n" +
+	"\x18EVENT_TYPE_GROUP_DELETED\x10\x062\xaf\x01\n" +
 	"\fEventService\x12H\n" +
-	"\tSubscribe\x12\x1b.snitch.v1.SubscribeRequest\x1a\x1c.snitch.v1.SubscribeResponse0\x01B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+	"\tSubscribe\x12\x1b.snitch.v1.SubscribeRequest\x1a\x1c.snitch.v1.SubscribeResponse0\x01\x12U\n" +
+	"\x0eGetEventCursor\x12 .snitch.v1.GetEventCursorRequest\x1a!.snitch.v1.GetEventCursorResponseB)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
 
 var (
 	file_snitch_v1_events_proto_rawDescOnce sync.Once
@@ -482,30 +870,41 @@ func file_snitch_v1_events_proto_rawDescGZIP() []byte {
 }
 
 var file_snitch_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_snitch_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_snitch_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_snitch_v1_events_proto_goTypes = []any{
-	(EventType)(0),                // 0: snitch.v1.EventType
-	(*SubscribeResponse)(nil),     // 1: snitch.v1.SubscribeResponse
-	(*ReportCreatedEvent)(nil),    // 2: snitch.v1.ReportCreatedEvent
-	(*ReportDeletedEvent)(nil),    // 3: snitch.v1.ReportDeletedEvent
-	(*UserBannedEvent)(nil),       // 4: snitch.v1.UserBannedEvent
-	(*SubscribeRequest)(nil),      // 5: snitch.v1.SubscribeRequest
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(EventType)(0),                 // 0: snitch.v1.EventType
+	(*SubscribeResponse)(nil),      // 1: snitch.v1.SubscribeResponse
+	(*ReportCreatedEvent)(nil),     // 2: snitch.v1.ReportCreatedEvent
+	(*ReportDeletedEvent)(nil),     // 3: snitch.v1.ReportDeletedEvent
+	(*UserBannedEvent)(nil),        // 4: snitch.v1.UserBannedEvent
+	(*JoinRequestedEvent)(nil),     // 5: snitch.v1.JoinRequestedEvent
+	(*ServerRemovedEvent)(nil),     // 6: snitch.v1.ServerRemovedEvent
+	(*GroupDeletedEvent)(nil),      // 7: snitch.v1.GroupDeletedEvent
+	(*SubscribeRequest)(nil),       // 8: snitch.v1.SubscribeRequest
+	(*GetEventCursorRequest)(nil),  // 9: snitch.v1.GetEventCursorRequest
+	(*GetEventCursorResponse)(nil), // 10: snitch.v1.GetEventCursorResponse
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
 }
 var file_snitch_v1_events_proto_depIdxs = []int32{
-	0, // 0: snitch.v1.SubscribeResponse.type:type_name -> snitch.v1.EventType
-	6, // 1: snitch.v1.SubscribeResponse.timestamp:type_name -> google.protobuf.Timestamp
-	2, // 2: snitch.v1.SubscribeResponse.report_created:type_name -> snitch.v1.ReportCreatedEvent
-	3, // 3: snitch.v1.SubscribeResponse.report_deleted:type_name -> snitch.v1.ReportDeletedEvent
-	4, // 4: snitch.v1.SubscribeResponse.user_banned:type_name -> snitch.v1.UserBannedEvent
-	0, // 5: snitch.v1.SubscribeRequest.event_types:type_name -> snitch.v1.EventType
-	5, // 6: snitch.v1.EventService.Subscribe:input_type -> snitch.v1.SubscribeRequest
-	1, // 7: snitch.v1.EventService.Subscribe:output_type -> snitch.v1.SubscribeResponse
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: snitch.v1.SubscribeResponse.type:type_name -> snitch.v1.EventType
+	11, // 1: snitch.v1.SubscribeResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 2: snitch.v1.SubscribeResponse.report_created:type_name -> snitch.v1.ReportCreatedEvent
+	3,  // 3: snitch.v1.SubscribeResponse.report_deleted:type_name -> snitch.v1.ReportDeletedEvent
+	4,  // 4: snitch.v1.SubscribeResponse.user_banned:type_name -> snitch.v1.UserBannedEvent
+	5,  // 5: snitch.v1.SubscribeResponse.join_requested:type_name -> snitch.v1.JoinRequestedEvent
+	6,  // 6: snitch.v1.SubscribeResponse.server_removed:type_name -> snitch.v1.ServerRemovedEvent
+	7,  // 7: snitch.v1.SubscribeResponse.group_deleted:type_name -> snitch.v1.GroupDeletedEvent
+	11, // 8: snitch.v1.GroupDeletedEvent.purge_after:type_name -> google.protobuf.Timestamp
+	0,  // 9: snitch.v1.SubscribeRequest.event_types:type_name -> snitch.v1.EventType
+	8,  // 10: snitch.v1.EventService.Subscribe:input_type -> snitch.v1.SubscribeRequest
+	9,  // 11: snitch.v1.EventService.GetEventCursor:input_type -> snitch.v1.GetEventCursorRequest
+	1,  // 12: snitch.v1.EventService.Subscribe:output_type -> snitch.v1.SubscribeResponse
+	10, // 13: snitch.v1.EventService.GetEventCursor:output_type -> snitch.v1.GetEventCursorResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_snitch_v1_events_proto_init() }
@@ -517,14 +916,18 @@ func file_snitch_v1_events_proto_init() {
 		(*SubscribeResponse_ReportCreated)(nil),
 		(*SubscribeResponse_ReportDeleted)(nil),
 		(*SubscribeResponse_UserBanned)(nil),
+		(*SubscribeResponse_JoinRequested)(nil),
+		(*SubscribeResponse_ServerRemoved)(nil),
+		(*SubscribeResponse_GroupDeleted)(nil),
 	}
+	file_snitch_v1_events_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_events_proto_rawDesc), len(file_snitch_v1_events_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
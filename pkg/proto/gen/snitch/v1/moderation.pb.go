@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: snitch/v1/moderation.proto
+
+package snitchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecordBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBanRequest) Reset() {
+	*x = RecordBanRequest{}
+	mi := &file_snitch_v1_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBanRequest) ProtoMessage() {}
+
+func (x *RecordBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBanRequest.ProtoReflect.Descriptor instead.
+func (*RecordBanRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordBanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordBanRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// GroupBan is the record of a ban in one group
+type GroupBan struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	BanId   int64                  `protobuf:"varint,2,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
+	// error says why the ban couldn't be recorded in this group; ban_id is then unset
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBan) Reset() {
+	*x = GroupBan{}
+	mi := &file_snitch_v1_moderation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBan) ProtoMessage() {}
+
+func (x *GroupBan) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_moderation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBan.ProtoReflect.Descriptor instead.
+func (*GroupBan) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupBan) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupBan) GetBanId() int64 {
+	if x != nil {
+		return x.BanId
+	}
+	return 0
+}
+
+func (x *GroupBan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecordBanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the ban in the first group it was recorded in
+	BanId int64 `protobuf:"varint,1,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
+	// One entry per group, more than one when the ban was recorded in all of the server's groups
+	Bans          []*GroupBan `protobuf:"bytes,2,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBanResponse) Reset() {
+	*x = RecordBanResponse{}
+	mi := &file_snitch_v1_moderation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBanResponse) ProtoMessage() {}
+
+func (x *RecordBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_moderation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBanResponse.ProtoReflect.Descriptor instead.
+func (*RecordBanResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordBanResponse) GetBanId() int64 {
+	if x != nil {
+		return x.BanId
+	}
+	return 0
+}
+
+func (x *RecordBanResponse) GetBans() []*GroupBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+var File_snitch_v1_moderation_proto protoreflect.FileDescriptor
+
+const file_snitch_v1_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x1asnitch/v1/moderation.proto\x12\tsnitch.v1\"S\n" +
+	"\x10RecordBanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"R\n" +
+	"\bGroupBan\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x15\n" +
+	"\x06ban_id\x18\x02 \x01(\x03R\x05banId\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"S\n" +
+	"\x11RecordBanResponse\x12\x15\n" +
+	"\x06ban_id\x18\x01 \x01(\x03R\x05banId\x12'\n" +
+	"\x04bans\x18\x02 \x03(\v2\x13.snitch.v1.GroupBanR\x04bans2]\n" +
+	"\x11ModerationService\x12H\n" +
+	"\tRecordBan\x12\x1b.snitch.v1.RecordBanRequest\x1a\x1c.snitch.v1.RecordBanResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+
+var (
+	file_snitch_v1_moderation_proto_rawDescOnce sync.Once
+	file_snitch_v1_moderation_proto_rawDescData []byte
+)
+
+func file_snitch_v1_moderation_proto_rawDescGZIP() []byte {
+	file_snitch_v1_moderation_proto_rawDescOnce.Do(func() {
+		file_snitch_v1_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_snitch_v1_moderation_proto_rawDesc), len(file_snitch_v1_moderation_proto_rawDesc)))
+	})
+	return file_snitch_v1_moderation_proto_rawDescData
+}
+
+var file_snitch_v1_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_snitch_v1_moderation_proto_goTypes = []any{
+	(*RecordBanRequest)(nil),  // 0: snitch.v1.RecordBanRequest
+	(*GroupBan)(nil),          // 1: snitch.v1.GroupBan
+	(*RecordBanResponse)(nil), // 2: snitch.v1.RecordBanResponse
+}
+var file_snitch_v1_moderation_proto_depIdxs = []int32{
+	1, // 0: snitch.v1.RecordBanResponse.bans:type_name -> snitch.v1.GroupBan
+	0, // 1: snitch.v1.ModerationService.RecordBan:input_type -> snitch.v1.RecordBanRequest
+	2, // 2: snitch.v1.ModerationService.RecordBan:output_type -> snitch.v1.RecordBanResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_snitch_v1_moderation_proto_init() }
+func file_snitch_v1_moderation_proto_init() {
+	if File_snitch_v1_moderation_proto != nil {
+		return
+	}
+	file_snitch_v1_moderation_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_moderation_proto_rawDesc), len(file_snitch_v1_moderation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_snitch_v1_moderation_proto_goTypes,
+		DependencyIndexes: file_snitch_v1_moderation_proto_depIdxs,
+		MessageInfos:      file_snitch_v1_moderation_proto_msgTypes,
+	}.Build()
+	File_snitch_v1_moderation_proto = out.File
+	file_snitch_v1_moderation_proto_goTypes = nil
+	file_snitch_v1_moderation_proto_depIdxs = nil
+}
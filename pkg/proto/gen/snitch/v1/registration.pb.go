@@ -9,6 +9,8 @@ package snitchv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,11 +23,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GroupRole is a server's standing in its group; each role can do everything the ones before it can
+type GroupRole int32
+
+const (
+	GroupRole_GROUP_ROLE_UNSPECIFIED GroupRole = 0
+	// Can read reports and receive events but not change anything
+	GroupRole_GROUP_ROLE_OBSERVER GroupRole = 1
+	// Can create reports and bans, and manage its own reports
+	GroupRole_GROUP_ROLE_MEMBER GroupRole = 2
+	// Can manage other servers' reports, invites, join requests, members and group settings
+	GroupRole_GROUP_ROLE_ADMIN GroupRole = 3
+	// Created the group; can also appoint admins, transfer ownership and delete the group
+	GroupRole_GROUP_ROLE_OWNER GroupRole = 4
+)
+
+// Enum value maps for GroupRole.
+var (
+	GroupRole_name = map[int32]string{
+		0: "GROUP_ROLE_UNSPECIFIED",
+		1: "GROUP_ROLE_OBSERVER",
+		2: "GROUP_ROLE_MEMBER",
+		3: "GROUP_ROLE_ADMIN",
+		4: "GROUP_ROLE_OWNER",
+	}
+	GroupRole_value = map[string]int32{
+		"GROUP_ROLE_UNSPECIFIED": 0,
+		"GROUP_ROLE_OBSERVER":    1,
+		"GROUP_ROLE_MEMBER":      2,
+		"GROUP_ROLE_ADMIN":       3,
+		"GROUP_ROLE_OWNER":       4,
+	}
+)
+
+func (x GroupRole) Enum() *GroupRole {
+	p := new(GroupRole)
+	*p = x
+	return p
+}
+
+func (x GroupRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_snitch_v1_registration_proto_enumTypes[0].Descriptor()
+}
+
+func (GroupRole) Type() protoreflect.EnumType {
+	return &file_snitch_v1_registration_proto_enumTypes[0]
+}
+
+func (x GroupRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupRole.Descriptor instead.
+func (GroupRole) EnumDescriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{0}
+}
+
 type RegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GroupId       *string                `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
-	GroupName     *string                `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3,oneof" json:"group_name,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupName *string                `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3,oneof" json:"group_name,omitempty"`
+	// Joins the group the invite belongs to instead of creating a new one
+	InviteCode    *string `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3,oneof" json:"invite_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,24 +130,26 @@ func (x *RegisterRequest) GetUserId() string {
 	return ""
 }
 
-func (x *RegisterRequest) GetGroupId() string {
-	if x != nil && x.GroupId != nil {
-		return *x.GroupId
+func (x *RegisterRequest) GetGroupName() string {
+	if x != nil && x.GroupName != nil {
+		return *x.GroupName
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetGroupName() string {
-	if x != nil && x.GroupName != nil {
-		return *x.GroupName
+func (x *RegisterRequest) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
 	}
 	return ""
 }
 
 type RegisterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	GroupId  string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Set when the group requires approval; the server joins once a member server approves
+	JoinRequestId *string `protobuf:"bytes,3,opt,name=join_request_id,json=joinRequestId,proto3,oneof" json:"join_request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +198,13 @@ func (x *RegisterResponse) GetGroupId() string {
 	return ""
 }
 
+func (x *RegisterResponse) GetJoinRequestId() string {
+	if x != nil && x.JoinRequestId != nil {
+		return *x.JoinRequestId
+	}
+	return ""
+}
+
 type GetGroupForServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
@@ -309,33 +381,1543 @@ func (x *HasGroupResponse) GetHasGroup() bool {
 	return false
 }
 
-var File_snitch_v1_registration_proto protoreflect.FileDescriptor
+type Invite struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Code      string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// 0 means the invite can be used any number of times
+	MaxUses       int64                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int64                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_snitch_v1_registration_proto_rawDesc = "" +
-	"\n" +
-	"\x1csnitch/v1/registration.proto\x12\tsnitch.v1\"\x8a\x01\n" +
-	"\x0fRegisterRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
-	"\bgroup_id\x18\x02 \x01(\tH\x00R\agroupId\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"group_name\x18\x03 \x01(\tH\x01R\tgroupName\x88\x01\x01B\v\n" +
-	"\t_group_idB\r\n" +
-	"\v_group_name\"J\n" +
-	"\x10RegisterResponse\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
-	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"7\n" +
-	"\x18GetGroupForServerRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\"6\n" +
-	"\x19GetGroupForServerResponse\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\".\n" +
-	"\x0fHasGroupRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\"/\n" +
-	"\x10HasGroupResponse\x12\x1b\n" +
-	"\thas_group\x18\x01 \x01(\bR\bhasGroup2\x82\x02\n" +
-	"\x10RegistrarService\x12E\n" +
-	"\bRegister\x12\x1a.snitch.v1.RegisterRequest\x1a\x1b.snitch.v1.RegisterResponse\"\x00\x12`\n" +
-	"\x11GetGroupForServer\x12#.snitch.v1.GetGroupForServerRequest\x1a$.snitch.v1.GetGroupForServerResponse\"\x00\x12E\n" +
-	"\bHasGroup\x12\x1a.snitch.v1.HasGroupRequest\x1a\x1b.snitch.v1.HasGroupResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to a week when unset
+	ExpiresIn     *durationpb.Duration `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
+	MaxUses       int64                `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{9}
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type DecideJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideJoinRequestRequest) Reset() {
+	*x = DecideJoinRequestRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideJoinRequestRequest) ProtoMessage() {}
+
+func (x *DecideJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DecideJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DecideJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DecideJoinRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecideJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DecideJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideJoinRequestResponse) Reset() {
+	*x = DecideJoinRequestResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideJoinRequestResponse) ProtoMessage() {}
+
+func (x *DecideJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*DecideJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DecideJoinRequestResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DecideJoinRequestResponse) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+type LeaveGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupRequest) ProtoMessage() {}
+
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LeaveGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupResponse) Reset() {
+	*x = LeaveGroupResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupResponse) ProtoMessage() {}
+
+func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LeaveGroupResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *LeaveGroupResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type KickServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickServerRequest) Reset() {
+	*x = KickServerRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickServerRequest) ProtoMessage() {}
+
+func (x *KickServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickServerRequest.ProtoReflect.Descriptor instead.
+func (*KickServerRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *KickServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *KickServerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type KickServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickServerResponse) Reset() {
+	*x = KickServerResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickServerResponse) ProtoMessage() {}
+
+func (x *KickServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickServerResponse.ProtoReflect.Descriptor instead.
+func (*KickServerResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *KickServerResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *KickServerResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DeleteGroupRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Must match the group's name, guarding against deleting the wrong group
+	ConfirmGroupName string `protobuf:"bytes,2,opt,name=confirm_group_name,json=confirmGroupName,proto3" json:"confirm_group_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteGroupRequest) GetConfirmGroupName() string {
+	if x != nil {
+		return x.ConfirmGroupName
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The group can be restored until then
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteGroupResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteGroupResponse) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+type RestoreGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGroupRequest) Reset() {
+	*x = RestoreGroupRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGroupRequest) ProtoMessage() {}
+
+func (x *RestoreGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGroupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGroupRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGroupResponse) Reset() {
+	*x = RestoreGroupResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGroupResponse) ProtoMessage() {}
+
+func (x *RestoreGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGroupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGroupResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreGroupResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RestoreGroupResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type GroupServer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Role          GroupRole              `protobuf:"varint,2,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupServer) Reset() {
+	*x = GroupServer{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupServer) ProtoMessage() {}
+
+func (x *GroupServer) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupServer.ProtoReflect.Descriptor instead.
+func (*GroupServer) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GroupServer) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GroupServer) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type ListGroupServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupServersRequest) Reset() {
+	*x = ListGroupServersRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupServersRequest) ProtoMessage() {}
+
+func (x *ListGroupServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupServersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupServersRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{24}
+}
+
+type ListGroupServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Servers       []*GroupServer         `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupServersResponse) Reset() {
+	*x = ListGroupServersResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupServersResponse) ProtoMessage() {}
+
+func (x *ListGroupServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupServersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupServersResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGroupServersResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListGroupServersResponse) GetServers() []*GroupServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type SetServerRoleRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// Ownership can only change through TransferOwnership
+	Role          GroupRole `protobuf:"varint,2,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	UserId        string    `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetServerRoleRequest) Reset() {
+	*x = SetServerRoleRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetServerRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServerRoleRequest) ProtoMessage() {}
+
+func (x *SetServerRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServerRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetServerRoleRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetServerRoleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SetServerRoleRequest) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+func (x *SetServerRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetServerRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	PreviousRole  GroupRole              `protobuf:"varint,2,opt,name=previous_role,json=previousRole,proto3,enum=snitch.v1.GroupRole" json:"previous_role,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetServerRoleResponse) Reset() {
+	*x = SetServerRoleResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetServerRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServerRoleResponse) ProtoMessage() {}
+
+func (x *SetServerRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServerRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetServerRoleResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetServerRoleResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SetServerRoleResponse) GetPreviousRole() GroupRole {
+	if x != nil {
+		return x.PreviousRole
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+func (x *SetServerRoleResponse) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransferOwnershipRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TransferOwnershipResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The previous owner becomes an admin
+	OwnerServerId string `protobuf:"bytes,2,opt,name=owner_server_id,json=ownerServerId,proto3" json:"owner_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransferOwnershipResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipResponse) GetOwnerServerId() string {
+	if x != nil {
+		return x.OwnerServerId
+	}
+	return ""
+}
+
+// ServerGroup is one of the groups a server belongs to
+type ServerGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=snitch.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerGroup) Reset() {
+	*x = ServerGroup{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerGroup) ProtoMessage() {}
+
+func (x *ServerGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerGroup.ProtoReflect.Descriptor instead.
+func (*ServerGroup) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ServerGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ServerGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ServerGroup) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+type ListServerGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServerGroupsRequest) Reset() {
+	*x = ListServerGroupsRequest{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServerGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerGroupsRequest) ProtoMessage() {}
+
+func (x *ListServerGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListServerGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{31}
+}
+
+type ListServerGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*ServerGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServerGroupsResponse) Reset() {
+	*x = ListServerGroupsResponse{}
+	mi := &file_snitch_v1_registration_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServerGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerGroupsResponse) ProtoMessage() {}
+
+func (x *ListServerGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_registration_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListServerGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_registration_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListServerGroupsResponse) GetGroups() []*ServerGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_snitch_v1_registration_proto protoreflect.FileDescriptor
+
+const file_snitch_v1_registration_proto_rawDesc = "" +
+	"\n" +
+	"\x1csnitch/v1/registration.proto\x12\tsnitch.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x01\n" +
+	"\x0fRegisterRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tH\x00R\tgroupName\x88\x01\x01\x12$\n" +
+	"\vinvite_code\x18\x04 \x01(\tH\x01R\n" +
+	"inviteCode\x88\x01\x01B\r\n" +
+	"\v_group_nameB\x0e\n" +
+	"\f_invite_codeJ\x04\b\x02\x10\x03R\bgroup_id\"\x8b\x01\n" +
+	"\x10RegisterResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12+\n" +
+	"\x0fjoin_request_id\x18\x03 \x01(\tH\x00R\rjoinRequestId\x88\x01\x01B\x12\n" +
+	"\x10_join_request_id\"7\n" +
+	"\x18GetGroupForServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"6\n" +
+	"\x19GetGroupForServerResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\".\n" +
+	"\x0fHasGroupRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"/\n" +
+	"\x10HasGroupResponse\x12\x1b\n" +
+	"\thas_group\x18\x01 \x01(\bR\bhasGroup\"\xde\x02\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x03R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x03R\x04uses\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\trevokedAt\x88\x01\x01B\r\n" +
+	"\v_expires_atB\r\n" +
+	"\v_revoked_at\"\x97\x01\n" +
+	"\x13CreateInviteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\texpiresIn\x88\x01\x01\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x03R\amaxUsesB\r\n" +
+	"\v_expires_in\"A\n" +
+	"\x14CreateInviteResponse\x12)\n" +
+	"\x06invite\x18\x01 \x01(\v2\x11.snitch.v1.InviteR\x06invite\"\x14\n" +
+	"\x12ListInvitesRequest\"B\n" +
+	"\x13ListInvitesResponse\x12+\n" +
+	"\ainvites\x18\x01 \x03(\v2\x11.snitch.v1.InviteR\ainvites\")\n" +
+	"\x13RevokeInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"A\n" +
+	"\x14RevokeInviteResponse\x12)\n" +
+	"\x06invite\x18\x01 \x01(\v2\x11.snitch.v1.InviteR\x06invite\"l\n" +
+	"\x18DecideJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"T\n" +
+	"\x19DecideJoinRequestResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved\",\n" +
+	"\x11LeaveGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x12LeaveGroupResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"I\n" +
+	"\x11KickServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x12KickServerResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"[\n" +
+	"\x12DeleteGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
+	"\x12confirm_group_name\x18\x02 \x01(\tR\x10confirmGroupName\"m\n" +
+	"\x13DeleteGroupResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12;\n" +
+	"\vpurge_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\".\n" +
+	"\x13RestoreGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x14RestoreGroupResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\"T\n" +
+	"\vGroupServer\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12(\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"\x19\n" +
+	"\x17ListGroupServersRequest\"g\n" +
+	"\x18ListGroupServersResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x120\n" +
+	"\aservers\x18\x02 \x03(\v2\x16.snitch.v1.GroupServerR\aservers\"v\n" +
+	"\x14SetServerRoleRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12(\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x99\x01\n" +
+	"\x15SetServerRoleResponse\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x129\n" +
+	"\rprevious_role\x18\x02 \x01(\x0e2\x14.snitch.v1.GroupRoleR\fpreviousRole\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"P\n" +
+	"\x18TransferOwnershipRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"^\n" +
+	"\x19TransferOwnershipResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12&\n" +
+	"\x0fowner_server_id\x18\x02 \x01(\tR\rownerServerId\"q\n" +
+	"\vServerGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.snitch.v1.GroupRoleR\x04role\"\x19\n" +
+	"\x17ListServerGroupsRequest\"J\n" +
+	"\x18ListServerGroupsResponse\x12.\n" +
+	"\x06groups\x18\x01 \x03(\v2\x16.snitch.v1.ServerGroupR\x06groups*\x83\x01\n" +
+	"\tGroupRole\x12\x1a\n" +
+	"\x16GROUP_ROLE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13GROUP_ROLE_OBSERVER\x10\x01\x12\x15\n" +
+	"\x11GROUP_ROLE_MEMBER\x10\x02\x12\x14\n" +
+	"\x10GROUP_ROLE_ADMIN\x10\x03\x12\x14\n" +
+	"\x10GROUP_ROLE_OWNER\x10\x042\x8d\n" +
+	"\n" +
+	"\x10RegistrarService\x12E\n" +
+	"\bRegister\x12\x1a.snitch.v1.RegisterRequest\x1a\x1b.snitch.v1.RegisterResponse\"\x00\x12`\n" +
+	"\x11GetGroupForServer\x12#.snitch.v1.GetGroupForServerRequest\x1a$.snitch.v1.GetGroupForServerResponse\"\x00\x12E\n" +
+	"\bHasGroup\x12\x1a.snitch.v1.HasGroupRequest\x1a\x1b.snitch.v1.HasGroupResponse\"\x00\x12Q\n" +
+	"\fCreateInvite\x12\x1e.snitch.v1.CreateInviteRequest\x1a\x1f.snitch.v1.CreateInviteResponse\"\x00\x12N\n" +
+	"\vListInvites\x12\x1d.snitch.v1.ListInvitesRequest\x1a\x1e.snitch.v1.ListInvitesResponse\"\x00\x12Q\n" +
+	"\fRevokeInvite\x12\x1e.snitch.v1.RevokeInviteRequest\x1a\x1f.snitch.v1.RevokeInviteResponse\"\x00\x12`\n" +
+	"\x11DecideJoinRequest\x12#.snitch.v1.DecideJoinRequestRequest\x1a$.snitch.v1.DecideJoinRequestResponse\"\x00\x12K\n" +
+	"\n" +
+	"LeaveGroup\x12\x1c.snitch.v1.LeaveGroupRequest\x1a\x1d.snitch.v1.LeaveGroupResponse\"\x00\x12K\n" +
+	"\n" +
+	"KickServer\x12\x1c.snitch.v1.KickServerRequest\x1a\x1d.snitch.v1.KickServerResponse\"\x00\x12N\n" +
+	"\vDeleteGroup\x12\x1d.snitch.v1.DeleteGroupRequest\x1a\x1e.snitch.v1.DeleteGroupResponse\"\x00\x12Q\n" +
+	"\fRestoreGroup\x12\x1e.snitch.v1.RestoreGroupRequest\x1a\x1f.snitch.v1.RestoreGroupResponse\"\x00\x12]\n" +
+	"\x10ListGroupServers\x12\".snitch.v1.ListGroupServersRequest\x1a#.snitch.v1.ListGroupServersResponse\"\x00\x12T\n" +
+	"\rSetServerRole\x12\x1f.snitch.v1.SetServerRoleRequest\x1a .snitch.v1.SetServerRoleResponse\"\x00\x12`\n" +
+	"\x11TransferOwnership\x12#.snitch.v1.TransferOwnershipRequest\x1a$.snitch.v1.TransferOwnershipResponse\"\x00\x12]\n" +
+	"\x10ListServerGroups\x12\".snitch.v1.ListServerGroupsRequest\x1a#.snitch.v1.ListServerGroupsResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
 
 var (
 	file_snitch_v1_registration_proto_rawDescOnce sync.Once
@@ -349,27 +1931,97 @@ func file_snitch_v1_registration_proto_rawDescGZIP() []byte {
 	return file_snitch_v1_registration_proto_rawDescData
 }
 
-var file_snitch_v1_registration_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_snitch_v1_registration_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_snitch_v1_registration_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_snitch_v1_registration_proto_goTypes = []any{
-	(*RegisterRequest)(nil),           // 0: snitch.v1.RegisterRequest
-	(*RegisterResponse)(nil),          // 1: snitch.v1.RegisterResponse
-	(*GetGroupForServerRequest)(nil),  // 2: snitch.v1.GetGroupForServerRequest
-	(*GetGroupForServerResponse)(nil), // 3: snitch.v1.GetGroupForServerResponse
-	(*HasGroupRequest)(nil),           // 4: snitch.v1.HasGroupRequest
-	(*HasGroupResponse)(nil),          // 5: snitch.v1.HasGroupResponse
+	(GroupRole)(0),                    // 0: snitch.v1.GroupRole
+	(*RegisterRequest)(nil),           // 1: snitch.v1.RegisterRequest
+	(*RegisterResponse)(nil),          // 2: snitch.v1.RegisterResponse
+	(*GetGroupForServerRequest)(nil),  // 3: snitch.v1.GetGroupForServerRequest
+	(*GetGroupForServerResponse)(nil), // 4: snitch.v1.GetGroupForServerResponse
+	(*HasGroupRequest)(nil),           // 5: snitch.v1.HasGroupRequest
+	(*HasGroupResponse)(nil),          // 6: snitch.v1.HasGroupResponse
+	(*Invite)(nil),                    // 7: snitch.v1.Invite
+	(*CreateInviteRequest)(nil),       // 8: snitch.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),      // 9: snitch.v1.CreateInviteResponse
+	(*ListInvitesRequest)(nil),        // 10: snitch.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),       // 11: snitch.v1.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),       // 12: snitch.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),      // 13: snitch.v1.RevokeInviteResponse
+	(*DecideJoinRequestRequest)(nil),  // 14: snitch.v1.DecideJoinRequestRequest
+	(*DecideJoinRequestResponse)(nil), // 15: snitch.v1.DecideJoinRequestResponse
+	(*LeaveGroupRequest)(nil),         // 16: snitch.v1.LeaveGroupRequest
+	(*LeaveGroupResponse)(nil),        // 17: snitch.v1.LeaveGroupResponse
+	(*KickServerRequest)(nil),         // 18: snitch.v1.KickServerRequest
+	(*KickServerResponse)(nil),        // 19: snitch.v1.KickServerResponse
+	(*DeleteGroupRequest)(nil),        // 20: snitch.v1.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),       // 21: snitch.v1.DeleteGroupResponse
+	(*RestoreGroupRequest)(nil),       // 22: snitch.v1.RestoreGroupRequest
+	(*RestoreGroupResponse)(nil),      // 23: snitch.v1.RestoreGroupResponse
+	(*GroupServer)(nil),               // 24: snitch.v1.GroupServer
+	(*ListGroupServersRequest)(nil),   // 25: snitch.v1.ListGroupServersRequest
+	(*ListGroupServersResponse)(nil),  // 26: snitch.v1.ListGroupServersResponse
+	(*SetServerRoleRequest)(nil),      // 27: snitch.v1.SetServerRoleRequest
+	(*SetServerRoleResponse)(nil),     // 28: snitch.v1.SetServerRoleResponse
+	(*TransferOwnershipRequest)(nil),  // 29: snitch.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil), // 30: snitch.v1.TransferOwnershipResponse
+	(*ServerGroup)(nil),               // 31: snitch.v1.ServerGroup
+	(*ListServerGroupsRequest)(nil),   // 32: snitch.v1.ListServerGroupsRequest
+	(*ListServerGroupsResponse)(nil),  // 33: snitch.v1.ListServerGroupsResponse
+	(*timestamppb.Timestamp)(nil),     // 34: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 35: google.protobuf.Duration
 }
 var file_snitch_v1_registration_proto_depIdxs = []int32{
-	0, // 0: snitch.v1.RegistrarService.Register:input_type -> snitch.v1.RegisterRequest
-	2, // 1: snitch.v1.RegistrarService.GetGroupForServer:input_type -> snitch.v1.GetGroupForServerRequest
-	4, // 2: snitch.v1.RegistrarService.HasGroup:input_type -> snitch.v1.HasGroupRequest
-	1, // 3: snitch.v1.RegistrarService.Register:output_type -> snitch.v1.RegisterResponse
-	3, // 4: snitch.v1.RegistrarService.GetGroupForServer:output_type -> snitch.v1.GetGroupForServerResponse
-	5, // 5: snitch.v1.RegistrarService.HasGroup:output_type -> snitch.v1.HasGroupResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	34, // 0: snitch.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 1: snitch.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	34, // 2: snitch.v1.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	35, // 3: snitch.v1.CreateInviteRequest.expires_in:type_name -> google.protobuf.Duration
+	7,  // 4: snitch.v1.CreateInviteResponse.invite:type_name -> snitch.v1.Invite
+	7,  // 5: snitch.v1.ListInvitesResponse.invites:type_name -> snitch.v1.Invite
+	7,  // 6: snitch.v1.RevokeInviteResponse.invite:type_name -> snitch.v1.Invite
+	34, // 7: snitch.v1.DeleteGroupResponse.purge_after:type_name -> google.protobuf.Timestamp
+	0,  // 8: snitch.v1.GroupServer.role:type_name -> snitch.v1.GroupRole
+	24, // 9: snitch.v1.ListGroupServersResponse.servers:type_name -> snitch.v1.GroupServer
+	0,  // 10: snitch.v1.SetServerRoleRequest.role:type_name -> snitch.v1.GroupRole
+	0,  // 11: snitch.v1.SetServerRoleResponse.previous_role:type_name -> snitch.v1.GroupRole
+	0,  // 12: snitch.v1.SetServerRoleResponse.role:type_name -> snitch.v1.GroupRole
+	0,  // 13: snitch.v1.ServerGroup.role:type_name -> snitch.v1.GroupRole
+	31, // 14: snitch.v1.ListServerGroupsResponse.groups:type_name -> snitch.v1.ServerGroup
+	1,  // 15: snitch.v1.RegistrarService.Register:input_type -> snitch.v1.RegisterRequest
+	3,  // 16: snitch.v1.RegistrarService.GetGroupForServer:input_type -> snitch.v1.GetGroupForServerRequest
+	5,  // 17: snitch.v1.RegistrarService.HasGroup:input_type -> snitch.v1.HasGroupRequest
+	8,  // 18: snitch.v1.RegistrarService.CreateInvite:input_type -> snitch.v1.CreateInviteRequest
+	10, // 19: snitch.v1.RegistrarService.ListInvites:input_type -> snitch.v1.ListInvitesRequest
+	12, // 20: snitch.v1.RegistrarService.RevokeInvite:input_type -> snitch.v1.RevokeInviteRequest
+	14, // 21: snitch.v1.RegistrarService.DecideJoinRequest:input_type -> snitch.v1.DecideJoinRequestRequest
+	16, // 22: snitch.v1.RegistrarService.LeaveGroup:input_type -> snitch.v1.LeaveGroupRequest
+	18, // 23: snitch.v1.RegistrarService.KickServer:input_type -> snitch.v1.KickServerRequest
+	20, // 24: snitch.v1.RegistrarService.DeleteGroup:input_type -> snitch.v1.DeleteGroupRequest
+	22, // 25: snitch.v1.RegistrarService.RestoreGroup:input_type -> snitch.v1.RestoreGroupRequest
+	25, // 26: snitch.v1.RegistrarService.ListGroupServers:input_type -> snitch.v1.ListGroupServersRequest
+	27, // 27: snitch.v1.RegistrarService.SetServerRole:input_type -> snitch.v1.SetServerRoleRequest
+	29, // 28: snitch.v1.RegistrarService.TransferOwnership:input_type -> snitch.v1.TransferOwnershipRequest
+	32, // 29: snitch.v1.RegistrarService.ListServerGroups:input_type -> snitch.v1.ListServerGroupsRequest
+	2,  // 30: snitch.v1.RegistrarService.Register:output_type -> snitch.v1.RegisterResponse
+	4,  // 31: snitch.v1.RegistrarService.GetGroupForServer:output_type -> snitch.v1.GetGroupForServerResponse
+	6,  // 32: snitch.v1.RegistrarService.HasGroup:output_type -> snitch.v1.HasGroupResponse
+	9,  // 33: snitch.v1.RegistrarService.CreateInvite:output_type -> snitch.v1.CreateInviteResponse
+	11, // 34: snitch.v1.RegistrarService.ListInvites:output_type -> snitch.v1.ListInvitesResponse
+	13, // 35: snitch.v1.RegistrarService.RevokeInvite:output_type -> snitch.v1.RevokeInviteResponse
+	15, // 36: snitch.v1.RegistrarService.DecideJoinRequest:output_type -> snitch.v1.DecideJoinRequestResponse
+	17, // 37: snitch.v1.RegistrarService.LeaveGroup:output_type -> snitch.v1.LeaveGroupResponse
+	19, // 38: snitch.v1.RegistrarService.KickServer:output_type -> snitch.v1.KickServerResponse
+	21, // 39: snitch.v1.RegistrarService.DeleteGroup:output_type -> snitch.v1.DeleteGroupResponse
+	23, // 40: snitch.v1.RegistrarService.RestoreGroup:output_type -> snitch.v1.RestoreGroupResponse
+	26, // 41: snitch.v1.RegistrarService.ListGroupServers:output_type -> snitch.v1.ListGroupServersResponse
+	28, // 42: snitch.v1.RegistrarService.SetServerRole:output_type -> snitch.v1.SetServerRoleResponse
+	30, // 43: snitch.v1.RegistrarService.TransferOwnership:output_type -> snitch.v1.TransferOwnershipResponse
+	33, // 44: snitch.v1.RegistrarService.ListServerGroups:output_type -> snitch.v1.ListServerGroupsResponse
+	30, // [30:45] is the sub-list for method output_type
+	15, // [15:30] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_snitch_v1_registration_proto_init() }
@@ -378,18 +2030,22 @@ func file_snitch_v1_registration_proto_init() {
 		return
 	}
 	file_snitch_v1_registration_proto_msgTypes[0].OneofWrappers = []any{}
+	file_snitch_v1_registration_proto_msgTypes[1].OneofWrappers = []any{}
+	file_snitch_v1_registration_proto_msgTypes[6].OneofWrappers = []any{}
+	file_snitch_v1_registration_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_registration_proto_rawDesc), len(file_snitch_v1_registration_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_snitch_v1_registration_proto_goTypes,
 		DependencyIndexes: file_snitch_v1_registration_proto_depIdxs,
+		EnumInfos:         file_snitch_v1_registration_proto_enumTypes,
 		MessageInfos:      file_snitch_v1_registration_proto_msgTypes,
 	}.Build()
 	File_snitch_v1_registration_proto = out.File
@@ -9,6 +9,7 @@ package snitchv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,30 +22,846 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED  ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN         ReportStatus = 1
+	ReportStatus_REPORT_STATUS_UNDER_REVIEW ReportStatus = 2
+	ReportStatus_REPORT_STATUS_RESOLVED     ReportStatus = 3
+	ReportStatus_REPORT_STATUS_DISMISSED    ReportStatus = 4
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_UNDER_REVIEW",
+		3: "REPORT_STATUS_RESOLVED",
+		4: "REPORT_STATUS_DISMISSED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED":  0,
+		"REPORT_STATUS_OPEN":         1,
+		"REPORT_STATUS_UNDER_REVIEW": 2,
+		"REPORT_STATUS_RESOLVED":     3,
+		"REPORT_STATUS_DISMISSED":    4,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_snitch_v1_report_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_snitch_v1_report_proto_enumTypes[0]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
+type ReportAuditAction int32
+
+const (
+	ReportAuditAction_REPORT_AUDIT_ACTION_UNSPECIFIED    ReportAuditAction = 0
+	ReportAuditAction_REPORT_AUDIT_ACTION_STATUS_CHANGED ReportAuditAction = 1
+	ReportAuditAction_REPORT_AUDIT_ACTION_DELETED        ReportAuditAction = 2
+)
+
+// Enum value maps for ReportAuditAction.
+var (
+	ReportAuditAction_name = map[int32]string{
+		0: "REPORT_AUDIT_ACTION_UNSPECIFIED",
+		1: "REPORT_AUDIT_ACTION_STATUS_CHANGED",
+		2: "REPORT_AUDIT_ACTION_DELETED",
+	}
+	ReportAuditAction_value = map[string]int32{
+		"REPORT_AUDIT_ACTION_UNSPECIFIED":    0,
+		"REPORT_AUDIT_ACTION_STATUS_CHANGED": 1,
+		"REPORT_AUDIT_ACTION_DELETED":        2,
+	}
+)
+
+func (x ReportAuditAction) Enum() *ReportAuditAction {
+	p := new(ReportAuditAction)
+	*p = x
+	return p
+}
+
+func (x ReportAuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportAuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_snitch_v1_report_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportAuditAction) Type() protoreflect.EnumType {
+	return &file_snitch_v1_report_proto_enumTypes[1]
+}
+
+func (x ReportAuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportAuditAction.Descriptor instead.
+func (ReportAuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+type ReportEvidence struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType     *string                `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	MessageLink     *string                `protobuf:"bytes,3,opt,name=message_link,json=messageLink,proto3,oneof" json:"message_link,omitempty"`
+	MessageContent  *string                `protobuf:"bytes,4,opt,name=message_content,json=messageContent,proto3,oneof" json:"message_content,omitempty"`
+	MessageAuthorId *string                `protobuf:"bytes,5,opt,name=message_author_id,json=messageAuthorId,proto3,oneof" json:"message_author_id,omitempty"`
+	ChannelId       *string                `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportEvidence) Reset() {
+	*x = ReportEvidence{}
+	mi := &file_snitch_v1_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvidence) ProtoMessage() {}
+
+func (x *ReportEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvidence.ProtoReflect.Descriptor instead.
+func (*ReportEvidence) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportEvidence) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetMessageLink() string {
+	if x != nil && x.MessageLink != nil {
+		return *x.MessageLink
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetMessageContent() string {
+	if x != nil && x.MessageContent != nil {
+		return *x.MessageContent
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetMessageAuthorId() string {
+	if x != nil && x.MessageAuthorId != nil {
+		return *x.MessageAuthorId
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetChannelId() string {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return ""
+}
+
+type Report struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReporterId     string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	ReportedId     string                 `protobuf:"bytes,3,opt,name=reported_id,json=reportedId,proto3" json:"reported_id,omitempty"`
+	OriginServerId string                 `protobuf:"bytes,4,opt,name=origin_server_id,json=originServerId,proto3" json:"origin_server_id,omitempty"`
+	ReportText     string                 `protobuf:"bytes,5,opt,name=report_text,json=reportText,proto3" json:"report_text,omitempty"`
+	Category       *string                `protobuf:"bytes,6,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status         ReportStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	Evidence       []*ReportEvidence      `protobuf:"bytes,10,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_snitch_v1_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Report) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Report) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Report) GetReportedId() string {
+	if x != nil {
+		return x.ReportedId
+	}
+	return ""
+}
+
+func (x *Report) GetOriginServerId() string {
+	if x != nil {
+		return x.OriginServerId
+	}
+	return ""
+}
+
+func (x *Report) GetReportText() string {
+	if x != nil {
+		return x.ReportText
+	}
+	return ""
+}
+
+func (x *Report) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Report) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Report) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Report) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
 type CreateReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportText    string                 `protobuf:"bytes,1,opt,name=report_text,json=reportText,proto3" json:"report_text,omitempty"`
-	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
-	ReportedId    string                 `protobuf:"bytes,3,opt,name=reported_id,json=reportedId,proto3" json:"reported_id,omitempty"`
+	ReportText    string                 `protobuf:"bytes,1,opt,name=report_text,json=reportText,proto3" json:"report_text,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	ReportedId    string                 `protobuf:"bytes,3,opt,name=reported_id,json=reportedId,proto3" json:"reported_id,omitempty"`
+	Evidence      []*ReportEvidence      `protobuf:"bytes,4,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReportRequest) Reset() {
+	*x = CreateReportRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReportRequest) ProtoMessage() {}
+
+func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReportRequest.ProtoReflect.Descriptor instead.
+func (*CreateReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateReportRequest) GetReportText() string {
+	if x != nil {
+		return x.ReportText
+	}
+	return ""
+}
+
+func (x *CreateReportRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *CreateReportRequest) GetReportedId() string {
+	if x != nil {
+		return x.ReportedId
+	}
+	return ""
+}
+
+func (x *CreateReportRequest) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *CreateReportRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+// GroupReport is the copy of a report filed to one group
+type GroupReport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReportId int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// error says why the report couldn't be filed to this group; report_id is then unset
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	GroupName     string `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupReport) Reset() {
+	*x = GroupReport{}
+	mi := &file_snitch_v1_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupReport) ProtoMessage() {}
+
+func (x *GroupReport) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupReport.ProtoReflect.Descriptor instead.
+func (*GroupReport) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupReport) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupReport) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *GroupReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GroupReport) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type CreateReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the report in the first group it was filed to
+	ReportId int64 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// One entry per group, more than one when the report was filed to all of the server's groups.
+	// Filing to all groups succeeds if any group got the report; the entries of the others carry an error.
+	Reports       []*GroupReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReportResponse) Reset() {
+	*x = CreateReportResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReportResponse) ProtoMessage() {}
+
+func (x *CreateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReportResponse.ProtoReflect.Descriptor instead.
+func (*CreateReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateReportResponse) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *CreateReportResponse) GetReports() []*GroupReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ListReportsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ReporterId *string                `protobuf:"bytes,1,opt,name=reporter_id,json=reporterId,proto3,oneof" json:"reporter_id,omitempty"`
+	ReportedId *string                `protobuf:"bytes,2,opt,name=reported_id,json=reportedId,proto3,oneof" json:"reported_id,omitempty"`
+	Status     *ReportStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=snitch.v1.ReportStatus,oneof" json:"status,omitempty"`
+	PageSize   *int32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Opaque token from a previous ListReportsResponse
+	PageToken      *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	OriginServerId *string `protobuf:"bytes,6,opt,name=origin_server_id,json=originServerId,proto3,oneof" json:"origin_server_id,omitempty"`
+	// Reports created at or after this time
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	// Reports created before this time
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReportsRequest) GetReporterId() string {
+	if x != nil && x.ReporterId != nil {
+		return *x.ReporterId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetReportedId() string {
+	if x != nil && x.ReportedId != nil {
+		return *x.ReportedId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetStatus() ReportStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetOriginServerId() string {
+	if x != nil && x.OriginServerId != nil {
+		return *x.OriginServerId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListReportsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type ListReportsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken     *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+	PreviousPageToken *string                `protobuf:"bytes,3,opt,name=previous_page_token,json=previousPageToken,proto3,oneof" json:"previous_page_token,omitempty"`
+	Reports           []*Report              `protobuf:"bytes,4,rep,name=reports,proto3" json:"reports,omitempty"`
+	// The group the reports were listed from, for paging through a group picked by name
+	GroupId       string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListReportsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListReportsResponse) GetPreviousPageToken() string {
+	if x != nil && x.PreviousPageToken != nil {
+		return *x.PreviousPageToken
+	}
+	return ""
+}
+
+func (x *ListReportsResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportRequest) Reset() {
+	*x = GetReportRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportRequest) ProtoMessage() {}
+
+func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReportRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type GetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportResponse) Reset() {
+	*x = GetReportResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportResponse) ProtoMessage() {}
+
+func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReportResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type DeleteReportRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReportId int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// The Discord user deleting the report, recorded in the audit log
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReportRequest) Reset() {
+	*x = DeleteReportRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReportRequest) ProtoMessage() {}
+
+func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReportRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteReportRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *DeleteReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReportRequest) Reset() {
-	*x = CreateReportRequest{}
-	mi := &file_snitch_v1_report_proto_msgTypes[0]
+func (x *DeleteReportResponse) Reset() {
+	*x = DeleteReportResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReportRequest) String() string {
+func (x *DeleteReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReportRequest) ProtoMessage() {}
+func (*DeleteReportResponse) ProtoMessage() {}
 
-func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[0]
+func (x *DeleteReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,54 +872,103 @@ func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReportRequest.ProtoReflect.Descriptor instead.
-func (*CreateReportRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DeleteReportResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateReportRequest) GetReportText() string {
+func (x *DeleteReportResponse) GetReportId() int64 {
 	if x != nil {
-		return x.ReportText
+		return x.ReportId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReportRequest) GetReporterId() string {
+type UpdateReportStatusRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReportId int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Status   ReportStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	// The Discord user changing the status, recorded in the audit log
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReportStatusRequest) Reset() {
+	*x = UpdateReportStatusRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReportStatusRequest) ProtoMessage() {}
+
+func (x *UpdateReportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[11]
 	if x != nil {
-		return x.ReporterId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateReportRequest) GetReportedId() string {
+// Deprecated: Use UpdateReportStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateReportStatusRequest) GetReportId() int64 {
 	if x != nil {
-		return x.ReportedId
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *UpdateReportStatusRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateReportStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type CreateReportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateReportStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReportId       int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	PreviousStatus ReportStatus           `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=snitch.v1.ReportStatus" json:"previous_status,omitempty"`
+	Status         ReportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=snitch.v1.ReportStatus" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateReportResponse) Reset() {
-	*x = CreateReportResponse{}
-	mi := &file_snitch_v1_report_proto_msgTypes[1]
+func (x *UpdateReportStatusResponse) Reset() {
+	*x = UpdateReportStatusResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReportResponse) String() string {
+func (x *UpdateReportStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReportResponse) ProtoMessage() {}
+func (*UpdateReportStatusResponse) ProtoMessage() {}
 
-func (x *CreateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[1]
+func (x *UpdateReportStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,41 +979,62 @@ func (x *CreateReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReportResponse.ProtoReflect.Descriptor instead.
-func (*CreateReportResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateReportStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReportStatusResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateReportResponse) GetReportId() int64 {
+func (x *UpdateReportStatusResponse) GetReportId() int64 {
 	if x != nil {
 		return x.ReportId
 	}
 	return 0
 }
 
-type ListReportsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReporterId    *string                `protobuf:"bytes,1,opt,name=reporter_id,json=reporterId,proto3,oneof" json:"reporter_id,omitempty"`
-	ReportedId    *string                `protobuf:"bytes,2,opt,name=reported_id,json=reportedId,proto3,oneof" json:"reported_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateReportStatusResponse) GetPreviousStatus() ReportStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
 }
 
-func (x *ListReportsRequest) Reset() {
-	*x = ListReportsRequest{}
-	mi := &file_snitch_v1_report_proto_msgTypes[2]
+func (x *UpdateReportStatusResponse) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+type ReportAuditEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuditId        int64                  `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	ReportId       int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Action         ReportAuditAction      `protobuf:"varint,3,opt,name=action,proto3,enum=snitch.v1.ReportAuditAction" json:"action,omitempty"`
+	ActorServerId  string                 `protobuf:"bytes,4,opt,name=actor_server_id,json=actorServerId,proto3" json:"actor_server_id,omitempty"`
+	ActorUserId    string                 `protobuf:"bytes,5,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	OriginServerId string                 `protobuf:"bytes,6,opt,name=origin_server_id,json=originServerId,proto3" json:"origin_server_id,omitempty"`
+	ReportedUserId string                 `protobuf:"bytes,7,opt,name=reported_user_id,json=reportedUserId,proto3" json:"reported_user_id,omitempty"`
+	Details        *string                `protobuf:"bytes,8,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportAuditEntry) Reset() {
+	*x = ReportAuditEntry{}
+	mi := &file_snitch_v1_report_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReportsRequest) String() string {
+func (x *ReportAuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReportsRequest) ProtoMessage() {}
+func (*ReportAuditEntry) ProtoMessage() {}
 
-func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[2]
+func (x *ReportAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,47 +1045,97 @@ func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
-func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ReportAuditEntry.ProtoReflect.Descriptor instead.
+func (*ReportAuditEntry) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListReportsRequest) GetReporterId() string {
-	if x != nil && x.ReporterId != nil {
-		return *x.ReporterId
+func (x *ReportAuditEntry) GetAuditId() int64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *ReportAuditEntry) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ReportAuditEntry) GetAction() ReportAuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return ReportAuditAction_REPORT_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *ReportAuditEntry) GetActorServerId() string {
+	if x != nil {
+		return x.ActorServerId
 	}
 	return ""
 }
 
-func (x *ListReportsRequest) GetReportedId() string {
-	if x != nil && x.ReportedId != nil {
-		return *x.ReportedId
+func (x *ReportAuditEntry) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
 	}
 	return ""
 }
 
-type ListReportsResponse struct {
+func (x *ReportAuditEntry) GetOriginServerId() string {
+	if x != nil {
+		return x.OriginServerId
+	}
+	return ""
+}
+
+func (x *ReportAuditEntry) GetReportedUserId() string {
+	if x != nil {
+		return x.ReportedUserId
+	}
+	return ""
+}
+
+func (x *ReportAuditEntry) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+func (x *ReportAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Listing the audit log requires at least the member role in the group
+type ListReportAuditLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reports       []*CreateReportRequest `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReportsResponse) Reset() {
-	*x = ListReportsResponse{}
-	mi := &file_snitch_v1_report_proto_msgTypes[3]
+func (x *ListReportAuditLogRequest) Reset() {
+	*x = ListReportAuditLogRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReportsResponse) String() string {
+func (x *ListReportAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReportsResponse) ProtoMessage() {}
+func (*ListReportAuditLogRequest) ProtoMessage() {}
 
-func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[3]
+func (x *ListReportAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,40 +1146,85 @@ func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
-func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListReportAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListReportAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListReportAuditLogRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListReportAuditLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest entries first
+	Entries       []*ReportAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportAuditLogResponse) Reset() {
+	*x = ListReportAuditLogResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListReportsResponse) GetReports() []*CreateReportRequest {
+func (*ListReportAuditLogResponse) ProtoMessage() {}
+
+func (x *ListReportAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[15]
 	if x != nil {
-		return x.Reports
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListReportAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListReportAuditLogResponse) GetEntries() []*ReportAuditEntry {
+	if x != nil {
+		return x.Entries
 	}
 	return nil
 }
 
-type DeleteReportRequest struct {
+type LookupUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReportRequest) Reset() {
-	*x = DeleteReportRequest{}
-	mi := &file_snitch_v1_report_proto_msgTypes[4]
+func (x *LookupUserRequest) Reset() {
+	*x = LookupUserRequest{}
+	mi := &file_snitch_v1_report_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReportRequest) String() string {
+func (x *LookupUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReportRequest) ProtoMessage() {}
+func (*LookupUserRequest) ProtoMessage() {}
 
-func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[4]
+func (x *LookupUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,40 +1235,48 @@ func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReportRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LookupUserRequest.ProtoReflect.Descriptor instead.
+func (*LookupUserRequest) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteReportRequest) GetReportId() int64 {
+func (x *LookupUserRequest) GetUserId() string {
 	if x != nil {
-		return x.ReportId
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type DeleteReportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+type LookupUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportCount     int64                  `protobuf:"varint,2,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	OpenReportCount int64                  `protobuf:"varint,3,opt,name=open_report_count,json=openReportCount,proto3" json:"open_report_count,omitempty"`
+	// Number of servers in the group that reported the user
+	ServerCount    int64                  `protobuf:"varint,4,opt,name=server_count,json=serverCount,proto3" json:"server_count,omitempty"`
+	LastReportedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_reported_at,json=lastReportedAt,proto3,oneof" json:"last_reported_at,omitempty"`
+	BanCount       int64                  `protobuf:"varint,6,opt,name=ban_count,json=banCount,proto3" json:"ban_count,omitempty"`
+	// The most recent reports against the user, newest first
+	RecentReports []*Report `protobuf:"bytes,7,rep,name=recent_reports,json=recentReports,proto3" json:"recent_reports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReportResponse) Reset() {
-	*x = DeleteReportResponse{}
-	mi := &file_snitch_v1_report_proto_msgTypes[5]
+func (x *LookupUserResponse) Reset() {
+	*x = LookupUserResponse{}
+	mi := &file_snitch_v1_report_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReportResponse) String() string {
+func (x *LookupUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReportResponse) ProtoMessage() {}
+func (*LookupUserResponse) ProtoMessage() {}
 
-func (x *DeleteReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snitch_v1_report_proto_msgTypes[5]
+func (x *LookupUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snitch_v1_report_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,49 +1287,209 @@ func (x *DeleteReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReportResponse.ProtoReflect.Descriptor instead.
-func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
-	return file_snitch_v1_report_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LookupUserResponse.ProtoReflect.Descriptor instead.
+func (*LookupUserResponse) Descriptor() ([]byte, []int) {
+	return file_snitch_v1_report_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteReportResponse) GetReportId() int64 {
+func (x *LookupUserResponse) GetUserId() string {
 	if x != nil {
-		return x.ReportId
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LookupUserResponse) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *LookupUserResponse) GetOpenReportCount() int64 {
+	if x != nil {
+		return x.OpenReportCount
+	}
+	return 0
+}
+
+func (x *LookupUserResponse) GetServerCount() int64 {
+	if x != nil {
+		return x.ServerCount
+	}
+	return 0
+}
+
+func (x *LookupUserResponse) GetLastReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportedAt
+	}
+	return nil
+}
+
+func (x *LookupUserResponse) GetBanCount() int64 {
+	if x != nil {
+		return x.BanCount
 	}
 	return 0
 }
 
+func (x *LookupUserResponse) GetRecentReports() []*Report {
+	if x != nil {
+		return x.RecentReports
+	}
+	return nil
+}
+
 var File_snitch_v1_report_proto protoreflect.FileDescriptor
 
 const file_snitch_v1_report_proto_rawDesc = "" +
 	"\n" +
-	"\x16snitch/v1/report.proto\x12\tsnitch.v1\"x\n" +
+	"\x16snitch/v1/report.proto\x12\tsnitch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x02\n" +
+	"\x0eReportEvidence\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12&\n" +
+	"\fcontent_type\x18\x02 \x01(\tH\x00R\vcontentType\x88\x01\x01\x12&\n" +
+	"\fmessage_link\x18\x03 \x01(\tH\x01R\vmessageLink\x88\x01\x01\x12,\n" +
+	"\x0fmessage_content\x18\x04 \x01(\tH\x02R\x0emessageContent\x88\x01\x01\x12/\n" +
+	"\x11message_author_id\x18\x05 \x01(\tH\x03R\x0fmessageAuthorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"channel_id\x18\x06 \x01(\tH\x04R\tchannelId\x88\x01\x01B\x0f\n" +
+	"\r_content_typeB\x0f\n" +
+	"\r_message_linkB\x12\n" +
+	"\x10_message_contentB\x14\n" +
+	"\x12_message_author_idB\r\n" +
+	"\v_channel_id\"\xc5\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12\x1f\n" +
+	"\vreported_id\x18\x03 \x01(\tR\n" +
+	"reportedId\x12(\n" +
+	"\x10origin_server_id\x18\x04 \x01(\tR\x0eoriginServerId\x12\x1f\n" +
+	"\vreport_text\x18\x05 \x01(\tR\n" +
+	"reportText\x12\x1f\n" +
+	"\bcategory\x18\x06 \x01(\tH\x00R\bcategory\x88\x01\x01\x12/\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tupdatedAt\x88\x01\x01\x125\n" +
+	"\bevidence\x18\n" +
+	" \x03(\v2\x19.snitch.v1.ReportEvidenceR\bevidenceB\v\n" +
+	"\t_categoryB\r\n" +
+	"\v_updated_at\"\xdd\x01\n" +
 	"\x13CreateReportRequest\x12\x1f\n" +
 	"\vreport_text\x18\x01 \x01(\tR\n" +
 	"reportText\x12\x1f\n" +
 	"\vreporter_id\x18\x02 \x01(\tR\n" +
 	"reporterId\x12\x1f\n" +
 	"\vreported_id\x18\x03 \x01(\tR\n" +
-	"reportedId\"3\n" +
+	"reportedId\x125\n" +
+	"\bevidence\x18\x04 \x03(\v2\x19.snitch.v1.ReportEvidenceR\bevidence\x12\x1f\n" +
+	"\bcategory\x18\x05 \x01(\tH\x00R\bcategory\x88\x01\x01B\v\n" +
+	"\t_category\"z\n" +
+	"\vGroupReport\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x04 \x01(\tR\tgroupName\"e\n" +
 	"\x14CreateReportResponse\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x03R\breportId\"\x80\x01\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x120\n" +
+	"\areports\x18\x02 \x03(\v2\x16.snitch.v1.GroupReportR\areports\"\x9b\x04\n" +
 	"\x12ListReportsRequest\x12$\n" +
 	"\vreporter_id\x18\x01 \x01(\tH\x00R\n" +
 	"reporterId\x88\x01\x01\x12$\n" +
 	"\vreported_id\x18\x02 \x01(\tH\x01R\n" +
-	"reportedId\x88\x01\x01B\x0e\n" +
+	"reportedId\x88\x01\x01\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.snitch.v1.ReportStatusH\x02R\x06status\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\x05H\x03R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tH\x04R\tpageToken\x88\x01\x01\x12-\n" +
+	"\x10origin_server_id\x18\x06 \x01(\tH\x05R\x0eoriginServerId\x88\x01\x01\x12D\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\aR\rcreatedBefore\x88\x01\x01B\x0e\n" +
 	"\f_reporter_idB\x0e\n" +
-	"\f_reported_id\"O\n" +
-	"\x13ListReportsResponse\x128\n" +
-	"\areports\x18\x01 \x03(\v2\x1e.snitch.v1.CreateReportRequestR\areports\"2\n" +
+	"\f_reported_idB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_page_tokenB\x13\n" +
+	"\x11_origin_server_idB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_before\"\xf1\x01\n" +
+	"\x13ListReportsResponse\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tH\x00R\rnextPageToken\x88\x01\x01\x123\n" +
+	"\x13previous_page_token\x18\x03 \x01(\tH\x01R\x11previousPageToken\x88\x01\x01\x12+\n" +
+	"\areports\x18\x04 \x03(\v2\x11.snitch.v1.ReportR\areports\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\tR\agroupIdB\x12\n" +
+	"\x10_next_page_tokenB\x16\n" +
+	"\x14_previous_page_tokenJ\x04\b\x01\x10\x02\"/\n" +
+	"\x10GetReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\">\n" +
+	"\x11GetReportResponse\x12)\n" +
+	"\x06report\x18\x01 \x01(\v2\x11.snitch.v1.ReportR\x06report\"K\n" +
 	"\x13DeleteReportRequest\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x03R\breportId\"3\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
 	"\x14DeleteReportResponse\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x03R\breportId2\x85\x02\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\"\x82\x01\n" +
+	"\x19UpdateReportStatusRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xac\x01\n" +
+	"\x1aUpdateReportStatusResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12@\n" +
+	"\x0fprevious_status\x18\x02 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x0epreviousStatus\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.snitch.v1.ReportStatusR\x06status\"\x86\x03\n" +
+	"\x10ReportAuditEntry\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x03R\aauditId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x124\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1c.snitch.v1.ReportAuditActionR\x06action\x12&\n" +
+	"\x0factor_server_id\x18\x04 \x01(\tR\ractorServerId\x12\"\n" +
+	"\ractor_user_id\x18\x05 \x01(\tR\vactorUserId\x12(\n" +
+	"\x10origin_server_id\x18\x06 \x01(\tR\x0eoriginServerId\x12(\n" +
+	"\x10reported_user_id\x18\a \x01(\tR\x0ereportedUserId\x12\x1d\n" +
+	"\adetails\x18\b \x01(\tH\x00R\adetails\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_details\"@\n" +
+	"\x19ListReportAuditLogRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"S\n" +
+	"\x1aListReportAuditLogResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.snitch.v1.ReportAuditEntryR\aentries\",\n" +
+	"\x11LookupUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd6\x02\n" +
+	"\x12LookupUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\freport_count\x18\x02 \x01(\x03R\vreportCount\x12*\n" +
+	"\x11open_report_count\x18\x03 \x01(\x03R\x0fopenReportCount\x12!\n" +
+	"\fserver_count\x18\x04 \x01(\x03R\vserverCount\x12I\n" +
+	"\x10last_reported_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0elastReportedAt\x88\x01\x01\x12\x1b\n" +
+	"\tban_count\x18\x06 \x01(\x03R\bbanCount\x128\n" +
+	"\x0erecent_reports\x18\a \x03(\v2\x11.snitch.v1.ReportR\rrecentReportsB\x13\n" +
+	"\x11_last_reported_at*\x9e\x01\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aREPORT_STATUS_UNDER_REVIEW\x10\x02\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x03\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x04*\x81\x01\n" +
+	"\x11ReportAuditAction\x12#\n" +
+	"\x1fREPORT_AUDIT_ACTION_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"REPORT_AUDIT_ACTION_STATUS_CHANGED\x10\x01\x12\x1f\n" +
+	"\x1bREPORT_AUDIT_ACTION_DELETED\x10\x022\xe6\x04\n" +
 	"\rReportService\x12Q\n" +
 	"\fCreateReport\x12\x1e.snitch.v1.CreateReportRequest\x1a\x1f.snitch.v1.CreateReportResponse\"\x00\x12N\n" +
-	"\vListReports\x12\x1d.snitch.v1.ListReportsRequest\x1a\x1e.snitch.v1.ListReportsResponse\"\x00\x12Q\n" +
-	"\fDeleteReport\x12\x1e.snitch.v1.DeleteReportRequest\x1a\x1f.snitch.v1.DeleteReportResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
+	"\vListReports\x12\x1d.snitch.v1.ListReportsRequest\x1a\x1e.snitch.v1.ListReportsResponse\"\x00\x12H\n" +
+	"\tGetReport\x12\x1b.snitch.v1.GetReportRequest\x1a\x1c.snitch.v1.GetReportResponse\"\x00\x12Q\n" +
+	"\fDeleteReport\x12\x1e.snitch.v1.DeleteReportRequest\x1a\x1f.snitch.v1.DeleteReportResponse\"\x00\x12c\n" +
+	"\x12UpdateReportStatus\x12$.snitch.v1.UpdateReportStatusRequest\x1a%.snitch.v1.UpdateReportStatusResponse\"\x00\x12c\n" +
+	"\x12ListReportAuditLog\x12$.snitch.v1.ListReportAuditLogRequest\x1a%.snitch.v1.ListReportAuditLogResponse\"\x00\x12K\n" +
+	"\n" +
+	"LookupUser\x12\x1c.snitch.v1.LookupUserRequest\x1a\x1d.snitch.v1.LookupUserResponse\"\x00B)Z'snitch/pkg/proto/gen/snitch/v1;snitchv1b\x06proto3"
 
 var (
 	file_snitch_v1_report_proto_rawDescOnce sync.Once
@@ -353,28 +1503,70 @@ func file_snitch_v1_report_proto_rawDescGZIP() []byte {
 	return file_snitch_v1_report_proto_rawDescData
 }
 
-var file_snitch_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_snitch_v1_report_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_snitch_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_snitch_v1_report_proto_goTypes = []any{
-	(*CreateReportRequest)(nil),  // 0: snitch.v1.CreateReportRequest
-	(*CreateReportResponse)(nil), // 1: snitch.v1.CreateReportResponse
-	(*ListReportsRequest)(nil),   // 2: snitch.v1.ListReportsRequest
-	(*ListReportsResponse)(nil),  // 3: snitch.v1.ListReportsResponse
-	(*DeleteReportRequest)(nil),  // 4: snitch.v1.DeleteReportRequest
-	(*DeleteReportResponse)(nil), // 5: snitch.v1.DeleteReportResponse
+	(ReportStatus)(0),                  // 0: snitch.v1.ReportStatus
+	(ReportAuditAction)(0),             // 1: snitch.v1.ReportAuditAction
+	(*ReportEvidence)(nil),             // 2: snitch.v1.ReportEvidence
+	(*Report)(nil),                     // 3: snitch.v1.Report
+	(*CreateReportRequest)(nil),        // 4: snitch.v1.CreateReportRequest
+	(*GroupReport)(nil),                // 5: snitch.v1.GroupReport
+	(*CreateReportResponse)(nil),       // 6: snitch.v1.CreateReportResponse
+	(*ListReportsRequest)(nil),         // 7: snitch.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),        // 8: snitch.v1.ListReportsResponse
+	(*GetReportRequest)(nil),           // 9: snitch.v1.GetReportRequest
+	(*GetReportResponse)(nil),          // 10: snitch.v1.GetReportResponse
+	(*DeleteReportRequest)(nil),        // 11: snitch.v1.DeleteReportRequest
+	(*DeleteReportResponse)(nil),       // 12: snitch.v1.DeleteReportResponse
+	(*UpdateReportStatusRequest)(nil),  // 13: snitch.v1.UpdateReportStatusRequest
+	(*UpdateReportStatusResponse)(nil), // 14: snitch.v1.UpdateReportStatusResponse
+	(*ReportAuditEntry)(nil),           // 15: snitch.v1.ReportAuditEntry
+	(*ListReportAuditLogRequest)(nil),  // 16: snitch.v1.ListReportAuditLogRequest
+	(*ListReportAuditLogResponse)(nil), // 17: snitch.v1.ListReportAuditLogResponse
+	(*LookupUserRequest)(nil),          // 18: snitch.v1.LookupUserRequest
+	(*LookupUserResponse)(nil),         // 19: snitch.v1.LookupUserResponse
+	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
 }
 var file_snitch_v1_report_proto_depIdxs = []int32{
-	0, // 0: snitch.v1.ListReportsResponse.reports:type_name -> snitch.v1.CreateReportRequest
-	0, // 1: snitch.v1.ReportService.CreateReport:input_type -> snitch.v1.CreateReportRequest
-	2, // 2: snitch.v1.ReportService.ListReports:input_type -> snitch.v1.ListReportsRequest
-	4, // 3: snitch.v1.ReportService.DeleteReport:input_type -> snitch.v1.DeleteReportRequest
-	1, // 4: snitch.v1.ReportService.CreateReport:output_type -> snitch.v1.CreateReportResponse
-	3, // 5: snitch.v1.ReportService.ListReports:output_type -> snitch.v1.ListReportsResponse
-	5, // 6: snitch.v1.ReportService.DeleteReport:output_type -> snitch.v1.DeleteReportResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: snitch.v1.Report.status:type_name -> snitch.v1.ReportStatus
+	20, // 1: snitch.v1.Report.created_at:type_name -> google.protobuf.Timestamp
+	20, // 2: snitch.v1.Report.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: snitch.v1.Report.evidence:type_name -> snitch.v1.ReportEvidence
+	2,  // 4: snitch.v1.CreateReportRequest.evidence:type_name -> snitch.v1.ReportEvidence
+	5,  // 5: snitch.v1.CreateReportResponse.reports:type_name -> snitch.v1.GroupReport
+	0,  // 6: snitch.v1.ListReportsRequest.status:type_name -> snitch.v1.ReportStatus
+	20, // 7: snitch.v1.ListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
+	20, // 8: snitch.v1.ListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
+	3,  // 9: snitch.v1.ListReportsResponse.reports:type_name -> snitch.v1.Report
+	3,  // 10: snitch.v1.GetReportResponse.report:type_name -> snitch.v1.Report
+	0,  // 11: snitch.v1.UpdateReportStatusRequest.status:type_name -> snitch.v1.ReportStatus
+	0,  // 12: snitch.v1.UpdateReportStatusResponse.previous_status:type_name -> snitch.v1.ReportStatus
+	0,  // 13: snitch.v1.UpdateReportStatusResponse.status:type_name -> snitch.v1.ReportStatus
+	1,  // 14: snitch.v1.ReportAuditEntry.action:type_name -> snitch.v1.ReportAuditAction
+	20, // 15: snitch.v1.ReportAuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	15, // 16: snitch.v1.ListReportAuditLogResponse.entries:type_name -> snitch.v1.ReportAuditEntry
+	20, // 17: snitch.v1.LookupUserResponse.last_reported_at:type_name -> google.protobuf.Timestamp
+	3,  // 18: snitch.v1.LookupUserResponse.recent_reports:type_name -> snitch.v1.Report
+	4,  // 19: snitch.v1.ReportService.CreateReport:input_type -> snitch.v1.CreateReportRequest
+	7,  // 20: snitch.v1.ReportService.ListReports:input_type -> snitch.v1.ListReportsRequest
+	9,  // 21: snitch.v1.ReportService.GetReport:input_type -> snitch.v1.GetReportRequest
+	11, // 22: snitch.v1.ReportService.DeleteReport:input_type -> snitch.v1.DeleteReportRequest
+	13, // 23: snitch.v1.ReportService.UpdateReportStatus:input_type -> snitch.v1.UpdateReportStatusRequest
+	16, // 24: snitch.v1.ReportService.ListReportAuditLog:input_type -> snitch.v1.ListReportAuditLogRequest
+	18, // 25: snitch.v1.ReportService.LookupUser:input_type -> snitch.v1.LookupUserRequest
+	6,  // 26: snitch.v1.ReportService.CreateReport:output_type -> snitch.v1.CreateReportResponse
+	8,  // 27: snitch.v1.ReportService.ListReports:output_type -> snitch.v1.ListReportsResponse
+	10, // 28: snitch.v1.ReportService.GetReport:output_type -> snitch.v1.GetReportResponse
+	12, // 29: snitch.v1.ReportService.DeleteReport:output_type -> snitch.v1.DeleteReportResponse
+	14, // 30: snitch.v1.ReportService.UpdateReportStatus:output_type -> snitch.v1.UpdateReportStatusResponse
+	17, // 31: snitch.v1.ReportService.ListReportAuditLog:output_type -> snitch.v1.ListReportAuditLogResponse
+	19, // 32: snitch.v1.ReportService.LookupUser:output_type -> snitch.v1.LookupUserResponse
+	26, // [26:33] is the sub-list for method output_type
+	19, // [19:26] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_snitch_v1_report_proto_init() }
@@ -382,19 +1574,27 @@ func file_snitch_v1_report_proto_init() {
 	if File_snitch_v1_report_proto != nil {
 		return
 	}
+	file_snitch_v1_report_proto_msgTypes[0].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[1].OneofWrappers = []any{}
 	file_snitch_v1_report_proto_msgTypes[2].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[5].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[6].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[13].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[14].OneofWrappers = []any{}
+	file_snitch_v1_report_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_snitch_v1_report_proto_rawDesc), len(file_snitch_v1_report_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_snitch_v1_report_proto_goTypes,
 		DependencyIndexes: file_snitch_v1_report_proto_depIdxs,
+		EnumInfos:         file_snitch_v1_report_proto_enumTypes,
 		MessageInfos:      file_snitch_v1_report_proto_msgTypes,
 	}.Build()
 	File_snitch_v1_report_proto = out.File
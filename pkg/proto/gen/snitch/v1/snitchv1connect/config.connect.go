@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: snitch/v1/config.proto
+
+package snitchv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	v1 "snitch/pkg/proto/gen/snitch/v1"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConfigServiceName is the fully-qualified name of the ConfigService service.
+	ConfigServiceName = "snitch.v1.ConfigService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConfigServiceGetServerConfigProcedure is the fully-qualified name of the ConfigService's
+	// GetServerConfig RPC.
+	ConfigServiceGetServerConfigProcedure = "/snitch.v1.ConfigService/GetServerConfig"
+	// ConfigServiceUpdateServerConfigProcedure is the fully-qualified name of the ConfigService's
+	// UpdateServerConfig RPC.
+	ConfigServiceUpdateServerConfigProcedure = "/snitch.v1.ConfigService/UpdateServerConfig"
+	// ConfigServiceGetGroupConfigProcedure is the fully-qualified name of the ConfigService's
+	// GetGroupConfig RPC.
+	ConfigServiceGetGroupConfigProcedure = "/snitch.v1.ConfigService/GetGroupConfig"
+	// ConfigServiceUpdateGroupConfigProcedure is the fully-qualified name of the ConfigService's
+	// UpdateGroupConfig RPC.
+	ConfigServiceUpdateGroupConfigProcedure = "/snitch.v1.ConfigService/UpdateGroupConfig"
+	// ConfigServiceGetPermissionPolicyProcedure is the fully-qualified name of the ConfigService's
+	// GetPermissionPolicy RPC.
+	ConfigServiceGetPermissionPolicyProcedure = "/snitch.v1.ConfigService/GetPermissionPolicy"
+	// ConfigServiceUpdatePermissionPolicyProcedure is the fully-qualified name of the ConfigService's
+	// UpdatePermissionPolicy RPC.
+	ConfigServiceUpdatePermissionPolicyProcedure = "/snitch.v1.ConfigService/UpdatePermissionPolicy"
+)
+
+// ConfigServiceClient is a client for the snitch.v1.ConfigService service.
+type ConfigServiceClient interface {
+	GetServerConfig(context.Context, *connect.Request[v1.GetServerConfigRequest]) (*connect.Response[v1.GetServerConfigResponse], error)
+	UpdateServerConfig(context.Context, *connect.Request[v1.UpdateServerConfigRequest]) (*connect.Response[v1.UpdateServerConfigResponse], error)
+	GetGroupConfig(context.Context, *connect.Request[v1.GetGroupConfigRequest]) (*connect.Response[v1.GetGroupConfigResponse], error)
+	UpdateGroupConfig(context.Context, *connect.Request[v1.UpdateGroupConfigRequest]) (*connect.Response[v1.UpdateGroupConfigResponse], error)
+	GetPermissionPolicy(context.Context, *connect.Request[v1.GetPermissionPolicyRequest]) (*connect.Response[v1.GetPermissionPolicyResponse], error)
+	UpdatePermissionPolicy(context.Context, *connect.Request[v1.UpdatePermissionPolicyRequest]) (*connect.Response[v1.UpdatePermissionPolicyResponse], error)
+}
+
+// NewConfigServiceClient constructs a client for the snitch.v1.ConfigService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConfigServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConfigServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	configServiceMethods := v1.File_snitch_v1_config_proto.Services().ByName("ConfigService").Methods()
+	return &configServiceClient{
+		getServerConfig: connect.NewClient[v1.GetServerConfigRequest, v1.GetServerConfigResponse](
+			httpClient,
+			baseURL+ConfigServiceGetServerConfigProcedure,
+			connect.WithSchema(configServiceMethods.ByName("GetServerConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateServerConfig: connect.NewClient[v1.UpdateServerConfigRequest, v1.UpdateServerConfigResponse](
+			httpClient,
+			baseURL+ConfigServiceUpdateServerConfigProcedure,
+			connect.WithSchema(configServiceMethods.ByName("UpdateServerConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupConfig: connect.NewClient[v1.GetGroupConfigRequest, v1.GetGroupConfigResponse](
+			httpClient,
+			baseURL+ConfigServiceGetGroupConfigProcedure,
+			connect.WithSchema(configServiceMethods.ByName("GetGroupConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateGroupConfig: connect.NewClient[v1.UpdateGroupConfigRequest, v1.UpdateGroupConfigResponse](
+			httpClient,
+			baseURL+ConfigServiceUpdateGroupConfigProcedure,
+			connect.WithSchema(configServiceMethods.ByName("UpdateGroupConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		getPermissionPolicy: connect.NewClient[v1.GetPermissionPolicyRequest, v1.GetPermissionPolicyResponse](
+			httpClient,
+			baseURL+ConfigServiceGetPermissionPolicyProcedure,
+			connect.WithSchema(configServiceMethods.ByName("GetPermissionPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePermissionPolicy: connect.NewClient[v1.UpdatePermissionPolicyRequest, v1.UpdatePermissionPolicyResponse](
+			httpClient,
+			baseURL+ConfigServiceUpdatePermissionPolicyProcedure,
+			connect.WithSchema(configServiceMethods.ByName("UpdatePermissionPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// configServiceClient implements ConfigServiceClient.
+type configServiceClient struct {
+	getServerConfig        *connect.Client[v1.GetServerConfigRequest, v1.GetServerConfigResponse]
+	updateServerConfig     *connect.Client[v1.UpdateServerConfigRequest, v1.UpdateServerConfigResponse]
+	getGroupConfig         *connect.Client[v1.GetGroupConfigRequest, v1.GetGroupConfigResponse]
+	updateGroupConfig      *connect.Client[v1.UpdateGroupConfigRequest, v1.UpdateGroupConfigResponse]
+	getPermissionPolicy    *connect.Client[v1.GetPermissionPolicyRequest, v1.GetPermissionPolicyResponse]
+	updatePermissionPolicy *connect.Client[v1.UpdatePermissionPolicyRequest, v1.UpdatePermissionPolicyResponse]
+}
+
+// GetServerConfig calls snitch.v1.ConfigService.GetServerConfig.
+func (c *configServiceClient) GetServerConfig(ctx context.Context, req *connect.Request[v1.GetServerConfigRequest]) (*connect.Response[v1.GetServerConfigResponse], error) {
+	return c.getServerConfig.CallUnary(ctx, req)
+}
+
+// UpdateServerConfig calls snitch.v1.ConfigService.UpdateServerConfig.
+func (c *configServiceClient) UpdateServerConfig(ctx context.Context, req *connect.Request[v1.UpdateServerConfigRequest]) (*connect.Response[v1.UpdateServerConfigResponse], error) {
+	return c.updateServerConfig.CallUnary(ctx, req)
+}
+
+// GetGroupConfig calls snitch.v1.ConfigService.GetGroupConfig.
+func (c *configServiceClient) GetGroupConfig(ctx context.Context, req *connect.Request[v1.GetGroupConfigRequest]) (*connect.Response[v1.GetGroupConfigResponse], error) {
+	return c.getGroupConfig.CallUnary(ctx, req)
+}
+
+// UpdateGroupConfig calls snitch.v1.ConfigService.UpdateGroupConfig.
+func (c *configServiceClient) UpdateGroupConfig(ctx context.Context, req *connect.Request[v1.UpdateGroupConfigRequest]) (*connect.Response[v1.UpdateGroupConfigResponse], error) {
+	return c.updateGroupConfig.CallUnary(ctx, req)
+}
+
+// GetPermissionPolicy calls snitch.v1.ConfigService.GetPermissionPolicy.
+func (c *configServiceClient) GetPermissionPolicy(ctx context.Context, req *connect.Request[v1.GetPermissionPolicyRequest]) (*connect.Response[v1.GetPermissionPolicyResponse], error) {
+	return c.getPermissionPolicy.CallUnary(ctx, req)
+}
+
+// UpdatePermissionPolicy calls snitch.v1.ConfigService.UpdatePermissionPolicy.
+func (c *configServiceClient) UpdatePermissionPolicy(ctx context.Context, req *connect.Request[v1.UpdatePermissionPolicyRequest]) (*connect.Response[v1.UpdatePermissionPolicyResponse], error) {
+	return c.updatePermissionPolicy.CallUnary(ctx, req)
+}
+
+// ConfigServiceHandler is an implementation of the snitch.v1.ConfigService service.
+type ConfigServiceHandler interface {
+	GetServerConfig(context.Context, *connect.Request[v1.GetServerConfigRequest]) (*connect.Response[v1.GetServerConfigResponse], error)
+	UpdateServerConfig(context.Context, *connect.Request[v1.UpdateServerConfigRequest]) (*connect.Response[v1.UpdateServerConfigResponse], error)
+	GetGroupConfig(context.Context, *connect.Request[v1.GetGroupConfigRequest]) (*connect.Response[v1.GetGroupConfigResponse], error)
+	UpdateGroupConfig(context.Context, *connect.Request[v1.UpdateGroupConfigRequest]) (*connect.Response[v1.UpdateGroupConfigResponse], error)
+	GetPermissionPolicy(context.Context, *connect.Request[v1.GetPermissionPolicyRequest]) (*connect.Response[v1.GetPermissionPolicyResponse], error)
+	UpdatePermissionPolicy(context.Context, *connect.Request[v1.UpdatePermissionPolicyRequest]) (*connect.Response[v1.UpdatePermissionPolicyResponse], error)
+}
+
+// NewConfigServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConfigServiceHandler(svc ConfigServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	configServiceMethods := v1.File_snitch_v1_config_proto.Services().ByName("ConfigService").Methods()
+	configServiceGetServerConfigHandler := connect.NewUnaryHandler(
+		ConfigServiceGetServerConfigProcedure,
+		svc.GetServerConfig,
+		connect.WithSchema(configServiceMethods.ByName("GetServerConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceUpdateServerConfigHandler := connect.NewUnaryHandler(
+		ConfigServiceUpdateServerConfigProcedure,
+		svc.UpdateServerConfig,
+		connect.WithSchema(configServiceMethods.ByName("UpdateServerConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceGetGroupConfigHandler := connect.NewUnaryHandler(
+		ConfigServiceGetGroupConfigProcedure,
+		svc.GetGroupConfig,
+		connect.WithSchema(configServiceMethods.ByName("GetGroupConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceUpdateGroupConfigHandler := connect.NewUnaryHandler(
+		ConfigServiceUpdateGroupConfigProcedure,
+		svc.UpdateGroupConfig,
+		connect.WithSchema(configServiceMethods.ByName("UpdateGroupConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceGetPermissionPolicyHandler := connect.NewUnaryHandler(
+		ConfigServiceGetPermissionPolicyProcedure,
+		svc.GetPermissionPolicy,
+		connect.WithSchema(configServiceMethods.ByName("GetPermissionPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceUpdatePermissionPolicyHandler := connect.NewUnaryHandler(
+		ConfigServiceUpdatePermissionPolicyProcedure,
+		svc.UpdatePermissionPolicy,
+		connect.WithSchema(configServiceMethods.ByName("UpdatePermissionPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/snitch.v1.ConfigService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConfigServiceGetServerConfigProcedure:
+			configServiceGetServerConfigHandler.ServeHTTP(w, r)
+		case ConfigServiceUpdateServerConfigProcedure:
+			configServiceUpdateServerConfigHandler.ServeHTTP(w, r)
+		case ConfigServiceGetGroupConfigProcedure:
+			configServiceGetGroupConfigHandler.ServeHTTP(w, r)
+		case ConfigServiceUpdateGroupConfigProcedure:
+			configServiceUpdateGroupConfigHandler.ServeHTTP(w, r)
+		case ConfigServiceGetPermissionPolicyProcedure:
+			configServiceGetPermissionPolicyHandler.ServeHTTP(w, r)
+		case ConfigServiceUpdatePermissionPolicyProcedure:
+			configServiceUpdatePermissionPolicyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConfigServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConfigServiceHandler struct{}
+
+func (UnimplementedConfigServiceHandler) GetServerConfig(context.Context, *connect.Request[v1.GetServerConfigRequest]) (*connect.Response[v1.GetServerConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.GetServerConfig is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) UpdateServerConfig(context.Context, *connect.Request[v1.UpdateServerConfigRequest]) (*connect.Response[v1.UpdateServerConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.UpdateServerConfig is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) GetGroupConfig(context.Context, *connect.Request[v1.GetGroupConfigRequest]) (*connect.Response[v1.GetGroupConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.GetGroupConfig is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) UpdateGroupConfig(context.Context, *connect.Request[v1.UpdateGroupConfigRequest]) (*connect.Response[v1.UpdateGroupConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.UpdateGroupConfig is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) GetPermissionPolicy(context.Context, *connect.Request[v1.GetPermissionPolicyRequest]) (*connect.Response[v1.GetPermissionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.GetPermissionPolicy is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) UpdatePermissionPolicy(context.Context, *connect.Request[v1.UpdatePermissionPolicyRequest]) (*connect.Response[v1.UpdatePermissionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ConfigService.UpdatePermissionPolicy is not implemented"))
+}
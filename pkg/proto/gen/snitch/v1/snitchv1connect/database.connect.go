@@ -39,12 +39,30 @@ const (
 	// DatabaseServiceFindGroupByServerProcedure is the fully-qualified name of the DatabaseService's
 	// FindGroupByServer RPC.
 	DatabaseServiceFindGroupByServerProcedure = "/snitch.v1.DatabaseService/FindGroupByServer"
+	// DatabaseServiceListServerGroupsProcedure is the fully-qualified name of the DatabaseService's
+	// ListServerGroups RPC.
+	DatabaseServiceListServerGroupsProcedure = "/snitch.v1.DatabaseService/ListServerGroups"
 	// DatabaseServiceAddServerToGroupProcedure is the fully-qualified name of the DatabaseService's
 	// AddServerToGroup RPC.
 	DatabaseServiceAddServerToGroupProcedure = "/snitch.v1.DatabaseService/AddServerToGroup"
+	// DatabaseServiceRemoveServerFromGroupProcedure is the fully-qualified name of the
+	// DatabaseService's RemoveServerFromGroup RPC.
+	DatabaseServiceRemoveServerFromGroupProcedure = "/snitch.v1.DatabaseService/RemoveServerFromGroup"
+	// DatabaseServiceSetServerRoleProcedure is the fully-qualified name of the DatabaseService's
+	// SetServerRole RPC.
+	DatabaseServiceSetServerRoleProcedure = "/snitch.v1.DatabaseService/SetServerRole"
+	// DatabaseServiceTransferGroupOwnershipProcedure is the fully-qualified name of the
+	// DatabaseService's TransferGroupOwnership RPC.
+	DatabaseServiceTransferGroupOwnershipProcedure = "/snitch.v1.DatabaseService/TransferGroupOwnership"
 	// DatabaseServiceCreateGroupDatabaseProcedure is the fully-qualified name of the DatabaseService's
 	// CreateGroupDatabase RPC.
 	DatabaseServiceCreateGroupDatabaseProcedure = "/snitch.v1.DatabaseService/CreateGroupDatabase"
+	// DatabaseServiceDeleteGroupProcedure is the fully-qualified name of the DatabaseService's
+	// DeleteGroup RPC.
+	DatabaseServiceDeleteGroupProcedure = "/snitch.v1.DatabaseService/DeleteGroup"
+	// DatabaseServiceRestoreGroupProcedure is the fully-qualified name of the DatabaseService's
+	// RestoreGroup RPC.
+	DatabaseServiceRestoreGroupProcedure = "/snitch.v1.DatabaseService/RestoreGroup"
 	// DatabaseServiceCreateReportProcedure is the fully-qualified name of the DatabaseService's
 	// CreateReport RPC.
 	DatabaseServiceCreateReportProcedure = "/snitch.v1.DatabaseService/CreateReport"
@@ -57,15 +75,90 @@ const (
 	// DatabaseServiceDeleteReportProcedure is the fully-qualified name of the DatabaseService's
 	// DeleteReport RPC.
 	DatabaseServiceDeleteReportProcedure = "/snitch.v1.DatabaseService/DeleteReport"
+	// DatabaseServiceUpdateReportStatusProcedure is the fully-qualified name of the DatabaseService's
+	// UpdateReportStatus RPC.
+	DatabaseServiceUpdateReportStatusProcedure = "/snitch.v1.DatabaseService/UpdateReportStatus"
+	// DatabaseServiceListReportAuditLogProcedure is the fully-qualified name of the DatabaseService's
+	// ListReportAuditLog RPC.
+	DatabaseServiceListReportAuditLogProcedure = "/snitch.v1.DatabaseService/ListReportAuditLog"
+	// DatabaseServiceGetUserReportSummaryProcedure is the fully-qualified name of the DatabaseService's
+	// GetUserReportSummary RPC.
+	DatabaseServiceGetUserReportSummaryProcedure = "/snitch.v1.DatabaseService/GetUserReportSummary"
 	// DatabaseServiceCreateUserHistoryProcedure is the fully-qualified name of the DatabaseService's
 	// CreateUserHistory RPC.
 	DatabaseServiceCreateUserHistoryProcedure = "/snitch.v1.DatabaseService/CreateUserHistory"
 	// DatabaseServiceGetUserHistoryProcedure is the fully-qualified name of the DatabaseService's
 	// GetUserHistory RPC.
 	DatabaseServiceGetUserHistoryProcedure = "/snitch.v1.DatabaseService/GetUserHistory"
+	// DatabaseServiceCreateBanProcedure is the fully-qualified name of the DatabaseService's CreateBan
+	// RPC.
+	DatabaseServiceCreateBanProcedure = "/snitch.v1.DatabaseService/CreateBan"
+	// DatabaseServiceAppendEventProcedure is the fully-qualified name of the DatabaseService's
+	// AppendEvent RPC.
+	DatabaseServiceAppendEventProcedure = "/snitch.v1.DatabaseService/AppendEvent"
+	// DatabaseServiceListEventsProcedure is the fully-qualified name of the DatabaseService's
+	// ListEvents RPC.
+	DatabaseServiceListEventsProcedure = "/snitch.v1.DatabaseService/ListEvents"
+	// DatabaseServiceGetLatestEventSequenceProcedure is the fully-qualified name of the
+	// DatabaseService's GetLatestEventSequence RPC.
+	DatabaseServiceGetLatestEventSequenceProcedure = "/snitch.v1.DatabaseService/GetLatestEventSequence"
+	// DatabaseServiceListOutboxEventsProcedure is the fully-qualified name of the DatabaseService's
+	// ListOutboxEvents RPC.
+	DatabaseServiceListOutboxEventsProcedure = "/snitch.v1.DatabaseService/ListOutboxEvents"
+	// DatabaseServiceDeleteOutboxEventProcedure is the fully-qualified name of the DatabaseService's
+	// DeleteOutboxEvent RPC.
+	DatabaseServiceDeleteOutboxEventProcedure = "/snitch.v1.DatabaseService/DeleteOutboxEvent"
 	// DatabaseServiceListServersProcedure is the fully-qualified name of the DatabaseService's
 	// ListServers RPC.
 	DatabaseServiceListServersProcedure = "/snitch.v1.DatabaseService/ListServers"
+	// DatabaseServiceGetServerConfigProcedure is the fully-qualified name of the DatabaseService's
+	// GetServerConfig RPC.
+	DatabaseServiceGetServerConfigProcedure = "/snitch.v1.DatabaseService/GetServerConfig"
+	// DatabaseServiceUpdateServerConfigProcedure is the fully-qualified name of the DatabaseService's
+	// UpdateServerConfig RPC.
+	DatabaseServiceUpdateServerConfigProcedure = "/snitch.v1.DatabaseService/UpdateServerConfig"
+	// DatabaseServiceCreateAPIKeyProcedure is the fully-qualified name of the DatabaseService's
+	// CreateAPIKey RPC.
+	DatabaseServiceCreateAPIKeyProcedure = "/snitch.v1.DatabaseService/CreateAPIKey"
+	// DatabaseServiceGetAPIKeyProcedure is the fully-qualified name of the DatabaseService's GetAPIKey
+	// RPC.
+	DatabaseServiceGetAPIKeyProcedure = "/snitch.v1.DatabaseService/GetAPIKey"
+	// DatabaseServiceListAPIKeysProcedure is the fully-qualified name of the DatabaseService's
+	// ListAPIKeys RPC.
+	DatabaseServiceListAPIKeysProcedure = "/snitch.v1.DatabaseService/ListAPIKeys"
+	// DatabaseServiceRevokeAPIKeyProcedure is the fully-qualified name of the DatabaseService's
+	// RevokeAPIKey RPC.
+	DatabaseServiceRevokeAPIKeyProcedure = "/snitch.v1.DatabaseService/RevokeAPIKey"
+	// DatabaseServiceCreateInviteProcedure is the fully-qualified name of the DatabaseService's
+	// CreateInvite RPC.
+	DatabaseServiceCreateInviteProcedure = "/snitch.v1.DatabaseService/CreateInvite"
+	// DatabaseServiceListInvitesProcedure is the fully-qualified name of the DatabaseService's
+	// ListInvites RPC.
+	DatabaseServiceListInvitesProcedure = "/snitch.v1.DatabaseService/ListInvites"
+	// DatabaseServiceRevokeInviteProcedure is the fully-qualified name of the DatabaseService's
+	// RevokeInvite RPC.
+	DatabaseServiceRevokeInviteProcedure = "/snitch.v1.DatabaseService/RevokeInvite"
+	// DatabaseServiceRedeemInviteProcedure is the fully-qualified name of the DatabaseService's
+	// RedeemInvite RPC.
+	DatabaseServiceRedeemInviteProcedure = "/snitch.v1.DatabaseService/RedeemInvite"
+	// DatabaseServiceDecideJoinRequestProcedure is the fully-qualified name of the DatabaseService's
+	// DecideJoinRequest RPC.
+	DatabaseServiceDecideJoinRequestProcedure = "/snitch.v1.DatabaseService/DecideJoinRequest"
+	// DatabaseServiceCancelJoinRequestProcedure is the fully-qualified name of the DatabaseService's
+	// CancelJoinRequest RPC.
+	DatabaseServiceCancelJoinRequestProcedure = "/snitch.v1.DatabaseService/CancelJoinRequest"
+	// DatabaseServiceGetGroupConfigProcedure is the fully-qualified name of the DatabaseService's
+	// GetGroupConfig RPC.
+	DatabaseServiceGetGroupConfigProcedure = "/snitch.v1.DatabaseService/GetGroupConfig"
+	// DatabaseServiceUpdateGroupConfigProcedure is the fully-qualified name of the DatabaseService's
+	// UpdateGroupConfig RPC.
+	DatabaseServiceUpdateGroupConfigProcedure = "/snitch.v1.DatabaseService/UpdateGroupConfig"
+	// DatabaseServiceGetPermissionPolicyProcedure is the fully-qualified name of the DatabaseService's
+	// GetPermissionPolicy RPC.
+	DatabaseServiceGetPermissionPolicyProcedure = "/snitch.v1.DatabaseService/GetPermissionPolicy"
+	// DatabaseServiceUpdatePermissionPolicyProcedure is the fully-qualified name of the
+	// DatabaseService's UpdatePermissionPolicy RPC.
+	DatabaseServiceUpdatePermissionPolicyProcedure = "/snitch.v1.DatabaseService/UpdatePermissionPolicy"
 )
 
 // DatabaseServiceClient is a client for the snitch.v1.DatabaseService service.
@@ -73,19 +166,65 @@ type DatabaseServiceClient interface {
 	// Metadata operations
 	CreateGroup(context.Context, *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error)
 	FindGroupByServer(context.Context, *connect.Request[v1.FindGroupByServerRequest]) (*connect.Response[v1.FindGroupByServerResponse], error)
+	ListServerGroups(context.Context, *connect.Request[v1.DatabaseServiceListServerGroupsRequest]) (*connect.Response[v1.DatabaseServiceListServerGroupsResponse], error)
 	AddServerToGroup(context.Context, *connect.Request[v1.AddServerToGroupRequest]) (*connect.Response[v1.AddServerToGroupResponse], error)
+	RemoveServerFromGroup(context.Context, *connect.Request[v1.RemoveServerFromGroupRequest]) (*connect.Response[v1.RemoveServerFromGroupResponse], error)
+	// Ownership changes go through TransferGroupOwnership so the group's owner stays in sync
+	SetServerRole(context.Context, *connect.Request[v1.DatabaseServiceSetServerRoleRequest]) (*connect.Response[v1.DatabaseServiceSetServerRoleResponse], error)
+	TransferGroupOwnership(context.Context, *connect.Request[v1.DatabaseServiceTransferGroupOwnershipRequest]) (*connect.Response[v1.DatabaseServiceTransferGroupOwnershipResponse], error)
 	// Group database operations
 	CreateGroupDatabase(context.Context, *connect.Request[v1.CreateGroupDatabaseRequest]) (*connect.Response[v1.CreateGroupDatabaseResponse], error)
+	// Soft-deletes a group; it is purged along with its database once the grace period ends
+	DeleteGroup(context.Context, *connect.Request[v1.DatabaseServiceDeleteGroupRequest]) (*connect.Response[v1.DatabaseServiceDeleteGroupResponse], error)
+	// Undoes the most recent deletion of a group owned by the server, while still in its grace period
+	RestoreGroup(context.Context, *connect.Request[v1.DatabaseServiceRestoreGroupRequest]) (*connect.Response[v1.DatabaseServiceRestoreGroupResponse], error)
 	// Report operations
 	CreateReport(context.Context, *connect.Request[v1.DatabaseServiceCreateReportRequest]) (*connect.Response[v1.DatabaseServiceCreateReportResponse], error)
 	GetReport(context.Context, *connect.Request[v1.DatabaseServiceGetReportRequest]) (*connect.Response[v1.DatabaseServiceGetReportResponse], error)
 	ListReports(context.Context, *connect.Request[v1.DatabaseServiceListReportsRequest]) (*connect.Response[v1.DatabaseServiceListReportsResponse], error)
 	DeleteReport(context.Context, *connect.Request[v1.DatabaseServiceDeleteReportRequest]) (*connect.Response[v1.DatabaseServiceDeleteReportResponse], error)
+	UpdateReportStatus(context.Context, *connect.Request[v1.DatabaseServiceUpdateReportStatusRequest]) (*connect.Response[v1.DatabaseServiceUpdateReportStatusResponse], error)
+	ListReportAuditLog(context.Context, *connect.Request[v1.DatabaseServiceListReportAuditLogRequest]) (*connect.Response[v1.DatabaseServiceListReportAuditLogResponse], error)
+	GetUserReportSummary(context.Context, *connect.Request[v1.DatabaseServiceGetUserReportSummaryRequest]) (*connect.Response[v1.DatabaseServiceGetUserReportSummaryResponse], error)
 	// User history operations
 	CreateUserHistory(context.Context, *connect.Request[v1.DatabaseServiceCreateUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceCreateUserHistoryResponse], error)
 	GetUserHistory(context.Context, *connect.Request[v1.DatabaseServiceGetUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceGetUserHistoryResponse], error)
+	// Moderation operations
+	CreateBan(context.Context, *connect.Request[v1.DatabaseServiceCreateBanRequest]) (*connect.Response[v1.DatabaseServiceCreateBanResponse], error)
+	// Event log operations
+	// AppendEvent stores an event in the group's event log and assigns its sequence
+	AppendEvent(context.Context, *connect.Request[v1.DatabaseServiceAppendEventRequest]) (*connect.Response[v1.DatabaseServiceAppendEventResponse], error)
+	ListEvents(context.Context, *connect.Request[v1.DatabaseServiceListEventsRequest]) (*connect.Response[v1.DatabaseServiceListEventsResponse], error)
+	GetLatestEventSequence(context.Context, *connect.Request[v1.DatabaseServiceGetLatestEventSequenceRequest]) (*connect.Response[v1.DatabaseServiceGetLatestEventSequenceResponse], error)
+	// ListOutboxEvents returns the oldest unpublished outbox events across every group
+	ListOutboxEvents(context.Context, *connect.Request[v1.DatabaseServiceListOutboxEventsRequest]) (*connect.Response[v1.DatabaseServiceListOutboxEventsResponse], error)
+	// DeleteOutboxEvent removes an outbox event once it has been published
+	DeleteOutboxEvent(context.Context, *connect.Request[v1.DatabaseServiceDeleteOutboxEventRequest]) (*connect.Response[v1.DatabaseServiceDeleteOutboxEventResponse], error)
 	// Server operations
 	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
+	GetServerConfig(context.Context, *connect.Request[v1.DatabaseServiceGetServerConfigRequest]) (*connect.Response[v1.DatabaseServiceGetServerConfigResponse], error)
+	UpdateServerConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateServerConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateServerConfigResponse], error)
+	// API key operations
+	CreateAPIKey(context.Context, *connect.Request[v1.DatabaseServiceCreateAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceCreateAPIKeyResponse], error)
+	GetAPIKey(context.Context, *connect.Request[v1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceGetAPIKeyResponse], error)
+	ListAPIKeys(context.Context, *connect.Request[v1.DatabaseServiceListAPIKeysRequest]) (*connect.Response[v1.DatabaseServiceListAPIKeysResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[v1.DatabaseServiceRevokeAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceRevokeAPIKeyResponse], error)
+	// Invite operations
+	CreateInvite(context.Context, *connect.Request[v1.DatabaseServiceCreateInviteRequest]) (*connect.Response[v1.DatabaseServiceCreateInviteResponse], error)
+	ListInvites(context.Context, *connect.Request[v1.DatabaseServiceListInvitesRequest]) (*connect.Response[v1.DatabaseServiceListInvitesResponse], error)
+	RevokeInvite(context.Context, *connect.Request[v1.DatabaseServiceRevokeInviteRequest]) (*connect.Response[v1.DatabaseServiceRevokeInviteResponse], error)
+	// RedeemInvite uses up one use of an invite and adds the server to its group
+	RedeemInvite(context.Context, *connect.Request[v1.DatabaseServiceRedeemInviteRequest]) (*connect.Response[v1.DatabaseServiceRedeemInviteResponse], error)
+	// DecideJoinRequest approves or denies a pending join request, adding the server to the group when approved
+	DecideJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceDecideJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceDecideJoinRequestResponse], error)
+	// CancelJoinRequest withdraws a pending join request and gives back the use of the invite it was filed with
+	CancelJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceCancelJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceCancelJoinRequestResponse], error)
+	// Group config operations
+	GetGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceGetGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceGetGroupConfigResponse], error)
+	UpdateGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateGroupConfigResponse], error)
+	// Bot permission policy operations
+	GetPermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceGetPermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceGetPermissionPolicyResponse], error)
+	UpdatePermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceUpdatePermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceUpdatePermissionPolicyResponse], error)
 }
 
 // NewDatabaseServiceClient constructs a client for the snitch.v1.DatabaseService service. By
@@ -111,18 +250,54 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("FindGroupByServer")),
 			connect.WithClientOptions(opts...),
 		),
+		listServerGroups: connect.NewClient[v1.DatabaseServiceListServerGroupsRequest, v1.DatabaseServiceListServerGroupsResponse](
+			httpClient,
+			baseURL+DatabaseServiceListServerGroupsProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListServerGroups")),
+			connect.WithClientOptions(opts...),
+		),
 		addServerToGroup: connect.NewClient[v1.AddServerToGroupRequest, v1.AddServerToGroupResponse](
 			httpClient,
 			baseURL+DatabaseServiceAddServerToGroupProcedure,
 			connect.WithSchema(databaseServiceMethods.ByName("AddServerToGroup")),
 			connect.WithClientOptions(opts...),
 		),
+		removeServerFromGroup: connect.NewClient[v1.RemoveServerFromGroupRequest, v1.RemoveServerFromGroupResponse](
+			httpClient,
+			baseURL+DatabaseServiceRemoveServerFromGroupProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RemoveServerFromGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		setServerRole: connect.NewClient[v1.DatabaseServiceSetServerRoleRequest, v1.DatabaseServiceSetServerRoleResponse](
+			httpClient,
+			baseURL+DatabaseServiceSetServerRoleProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("SetServerRole")),
+			connect.WithClientOptions(opts...),
+		),
+		transferGroupOwnership: connect.NewClient[v1.DatabaseServiceTransferGroupOwnershipRequest, v1.DatabaseServiceTransferGroupOwnershipResponse](
+			httpClient,
+			baseURL+DatabaseServiceTransferGroupOwnershipProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("TransferGroupOwnership")),
+			connect.WithClientOptions(opts...),
+		),
 		createGroupDatabase: connect.NewClient[v1.CreateGroupDatabaseRequest, v1.CreateGroupDatabaseResponse](
 			httpClient,
 			baseURL+DatabaseServiceCreateGroupDatabaseProcedure,
 			connect.WithSchema(databaseServiceMethods.ByName("CreateGroupDatabase")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteGroup: connect.NewClient[v1.DatabaseServiceDeleteGroupRequest, v1.DatabaseServiceDeleteGroupResponse](
+			httpClient,
+			baseURL+DatabaseServiceDeleteGroupProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("DeleteGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreGroup: connect.NewClient[v1.DatabaseServiceRestoreGroupRequest, v1.DatabaseServiceRestoreGroupResponse](
+			httpClient,
+			baseURL+DatabaseServiceRestoreGroupProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RestoreGroup")),
+			connect.WithClientOptions(opts...),
+		),
 		createReport: connect.NewClient[v1.DatabaseServiceCreateReportRequest, v1.DatabaseServiceCreateReportResponse](
 			httpClient,
 			baseURL+DatabaseServiceCreateReportProcedure,
@@ -147,6 +322,24 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("DeleteReport")),
 			connect.WithClientOptions(opts...),
 		),
+		updateReportStatus: connect.NewClient[v1.DatabaseServiceUpdateReportStatusRequest, v1.DatabaseServiceUpdateReportStatusResponse](
+			httpClient,
+			baseURL+DatabaseServiceUpdateReportStatusProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("UpdateReportStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listReportAuditLog: connect.NewClient[v1.DatabaseServiceListReportAuditLogRequest, v1.DatabaseServiceListReportAuditLogResponse](
+			httpClient,
+			baseURL+DatabaseServiceListReportAuditLogProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListReportAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserReportSummary: connect.NewClient[v1.DatabaseServiceGetUserReportSummaryRequest, v1.DatabaseServiceGetUserReportSummaryResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetUserReportSummaryProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetUserReportSummary")),
+			connect.WithClientOptions(opts...),
+		),
 		createUserHistory: connect.NewClient[v1.DatabaseServiceCreateUserHistoryRequest, v1.DatabaseServiceCreateUserHistoryResponse](
 			httpClient,
 			baseURL+DatabaseServiceCreateUserHistoryProcedure,
@@ -159,28 +352,191 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("GetUserHistory")),
 			connect.WithClientOptions(opts...),
 		),
+		createBan: connect.NewClient[v1.DatabaseServiceCreateBanRequest, v1.DatabaseServiceCreateBanResponse](
+			httpClient,
+			baseURL+DatabaseServiceCreateBanProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("CreateBan")),
+			connect.WithClientOptions(opts...),
+		),
+		appendEvent: connect.NewClient[v1.DatabaseServiceAppendEventRequest, v1.DatabaseServiceAppendEventResponse](
+			httpClient,
+			baseURL+DatabaseServiceAppendEventProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("AppendEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		listEvents: connect.NewClient[v1.DatabaseServiceListEventsRequest, v1.DatabaseServiceListEventsResponse](
+			httpClient,
+			baseURL+DatabaseServiceListEventsProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getLatestEventSequence: connect.NewClient[v1.DatabaseServiceGetLatestEventSequenceRequest, v1.DatabaseServiceGetLatestEventSequenceResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetLatestEventSequenceProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetLatestEventSequence")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutboxEvents: connect.NewClient[v1.DatabaseServiceListOutboxEventsRequest, v1.DatabaseServiceListOutboxEventsResponse](
+			httpClient,
+			baseURL+DatabaseServiceListOutboxEventsProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListOutboxEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOutboxEvent: connect.NewClient[v1.DatabaseServiceDeleteOutboxEventRequest, v1.DatabaseServiceDeleteOutboxEventResponse](
+			httpClient,
+			baseURL+DatabaseServiceDeleteOutboxEventProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("DeleteOutboxEvent")),
+			connect.WithClientOptions(opts...),
+		),
 		listServers: connect.NewClient[v1.ListServersRequest, v1.ListServersResponse](
 			httpClient,
 			baseURL+DatabaseServiceListServersProcedure,
 			connect.WithSchema(databaseServiceMethods.ByName("ListServers")),
 			connect.WithClientOptions(opts...),
 		),
+		getServerConfig: connect.NewClient[v1.DatabaseServiceGetServerConfigRequest, v1.DatabaseServiceGetServerConfigResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetServerConfigProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetServerConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateServerConfig: connect.NewClient[v1.DatabaseServiceUpdateServerConfigRequest, v1.DatabaseServiceUpdateServerConfigResponse](
+			httpClient,
+			baseURL+DatabaseServiceUpdateServerConfigProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("UpdateServerConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[v1.DatabaseServiceCreateAPIKeyRequest, v1.DatabaseServiceCreateAPIKeyResponse](
+			httpClient,
+			baseURL+DatabaseServiceCreateAPIKeyProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getAPIKey: connect.NewClient[v1.DatabaseServiceGetAPIKeyRequest, v1.DatabaseServiceGetAPIKeyResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetAPIKeyProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.DatabaseServiceListAPIKeysRequest, v1.DatabaseServiceListAPIKeysResponse](
+			httpClient,
+			baseURL+DatabaseServiceListAPIKeysProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.DatabaseServiceRevokeAPIKeyRequest, v1.DatabaseServiceRevokeAPIKeyResponse](
+			httpClient,
+			baseURL+DatabaseServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		createInvite: connect.NewClient[v1.DatabaseServiceCreateInviteRequest, v1.DatabaseServiceCreateInviteResponse](
+			httpClient,
+			baseURL+DatabaseServiceCreateInviteProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("CreateInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvites: connect.NewClient[v1.DatabaseServiceListInvitesRequest, v1.DatabaseServiceListInvitesResponse](
+			httpClient,
+			baseURL+DatabaseServiceListInvitesProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListInvites")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvite: connect.NewClient[v1.DatabaseServiceRevokeInviteRequest, v1.DatabaseServiceRevokeInviteResponse](
+			httpClient,
+			baseURL+DatabaseServiceRevokeInviteProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RevokeInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		redeemInvite: connect.NewClient[v1.DatabaseServiceRedeemInviteRequest, v1.DatabaseServiceRedeemInviteResponse](
+			httpClient,
+			baseURL+DatabaseServiceRedeemInviteProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RedeemInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		decideJoinRequest: connect.NewClient[v1.DatabaseServiceDecideJoinRequestRequest, v1.DatabaseServiceDecideJoinRequestResponse](
+			httpClient,
+			baseURL+DatabaseServiceDecideJoinRequestProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("DecideJoinRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelJoinRequest: connect.NewClient[v1.DatabaseServiceCancelJoinRequestRequest, v1.DatabaseServiceCancelJoinRequestResponse](
+			httpClient,
+			baseURL+DatabaseServiceCancelJoinRequestProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("CancelJoinRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupConfig: connect.NewClient[v1.DatabaseServiceGetGroupConfigRequest, v1.DatabaseServiceGetGroupConfigResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetGroupConfigProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetGroupConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateGroupConfig: connect.NewClient[v1.DatabaseServiceUpdateGroupConfigRequest, v1.DatabaseServiceUpdateGroupConfigResponse](
+			httpClient,
+			baseURL+DatabaseServiceUpdateGroupConfigProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("UpdateGroupConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		getPermissionPolicy: connect.NewClient[v1.DatabaseServiceGetPermissionPolicyRequest, v1.DatabaseServiceGetPermissionPolicyResponse](
+			httpClient,
+			baseURL+DatabaseServiceGetPermissionPolicyProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetPermissionPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePermissionPolicy: connect.NewClient[v1.DatabaseServiceUpdatePermissionPolicyRequest, v1.DatabaseServiceUpdatePermissionPolicyResponse](
+			httpClient,
+			baseURL+DatabaseServiceUpdatePermissionPolicyProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("UpdatePermissionPolicy")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // databaseServiceClient implements DatabaseServiceClient.
 type databaseServiceClient struct {
-	createGroup         *connect.Client[v1.CreateGroupRequest, v1.CreateGroupResponse]
-	findGroupByServer   *connect.Client[v1.FindGroupByServerRequest, v1.FindGroupByServerResponse]
-	addServerToGroup    *connect.Client[v1.AddServerToGroupRequest, v1.AddServerToGroupResponse]
-	createGroupDatabase *connect.Client[v1.CreateGroupDatabaseRequest, v1.CreateGroupDatabaseResponse]
-	createReport        *connect.Client[v1.DatabaseServiceCreateReportRequest, v1.DatabaseServiceCreateReportResponse]
-	getReport           *connect.Client[v1.DatabaseServiceGetReportRequest, v1.DatabaseServiceGetReportResponse]
-	listReports         *connect.Client[v1.DatabaseServiceListReportsRequest, v1.DatabaseServiceListReportsResponse]
-	deleteReport        *connect.Client[v1.DatabaseServiceDeleteReportRequest, v1.DatabaseServiceDeleteReportResponse]
-	createUserHistory   *connect.Client[v1.DatabaseServiceCreateUserHistoryRequest, v1.DatabaseServiceCreateUserHistoryResponse]
-	getUserHistory      *connect.Client[v1.DatabaseServiceGetUserHistoryRequest, v1.DatabaseServiceGetUserHistoryResponse]
-	listServers         *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
+	createGroup            *connect.Client[v1.CreateGroupRequest, v1.CreateGroupResponse]
+	findGroupByServer      *connect.Client[v1.FindGroupByServerRequest, v1.FindGroupByServerResponse]
+	listServerGroups       *connect.Client[v1.DatabaseServiceListServerGroupsRequest, v1.DatabaseServiceListServerGroupsResponse]
+	addServerToGroup       *connect.Client[v1.AddServerToGroupRequest, v1.AddServerToGroupResponse]
+	removeServerFromGroup  *connect.Client[v1.RemoveServerFromGroupRequest, v1.RemoveServerFromGroupResponse]
+	setServerRole          *connect.Client[v1.DatabaseServiceSetServerRoleRequest, v1.DatabaseServiceSetServerRoleResponse]
+	transferGroupOwnership *connect.Client[v1.DatabaseServiceTransferGroupOwnershipRequest, v1.DatabaseServiceTransferGroupOwnershipResponse]
+	createGroupDatabase    *connect.Client[v1.CreateGroupDatabaseRequest, v1.CreateGroupDatabaseResponse]
+	deleteGroup            *connect.Client[v1.DatabaseServiceDeleteGroupRequest, v1.DatabaseServiceDeleteGroupResponse]
+	restoreGroup           *connect.Client[v1.DatabaseServiceRestoreGroupRequest, v1.DatabaseServiceRestoreGroupResponse]
+	createReport           *connect.Client[v1.DatabaseServiceCreateReportRequest, v1.DatabaseServiceCreateReportResponse]
+	getReport              *connect.Client[v1.DatabaseServiceGetReportRequest, v1.DatabaseServiceGetReportResponse]
+	listReports            *connect.Client[v1.DatabaseServiceListReportsRequest, v1.DatabaseServiceListReportsResponse]
+	deleteReport           *connect.Client[v1.DatabaseServiceDeleteReportRequest, v1.DatabaseServiceDeleteReportResponse]
+	updateReportStatus     *connect.Client[v1.DatabaseServiceUpdateReportStatusRequest, v1.DatabaseServiceUpdateReportStatusResponse]
+	listReportAuditLog     *connect.Client[v1.DatabaseServiceListReportAuditLogRequest, v1.DatabaseServiceListReportAuditLogResponse]
+	getUserReportSummary   *connect.Client[v1.DatabaseServiceGetUserReportSummaryRequest, v1.DatabaseServiceGetUserReportSummaryResponse]
+	createUserHistory      *connect.Client[v1.DatabaseServiceCreateUserHistoryRequest, v1.DatabaseServiceCreateUserHistoryResponse]
+	getUserHistory         *connect.Client[v1.DatabaseServiceGetUserHistoryRequest, v1.DatabaseServiceGetUserHistoryResponse]
+	createBan              *connect.Client[v1.DatabaseServiceCreateBanRequest, v1.DatabaseServiceCreateBanResponse]
+	appendEvent            *connect.Client[v1.DatabaseServiceAppendEventRequest, v1.DatabaseServiceAppendEventResponse]
+	listEvents             *connect.Client[v1.DatabaseServiceListEventsRequest, v1.DatabaseServiceListEventsResponse]
+	getLatestEventSequence *connect.Client[v1.DatabaseServiceGetLatestEventSequenceRequest, v1.DatabaseServiceGetLatestEventSequenceResponse]
+	listOutboxEvents       *connect.Client[v1.DatabaseServiceListOutboxEventsRequest, v1.DatabaseServiceListOutboxEventsResponse]
+	deleteOutboxEvent      *connect.Client[v1.DatabaseServiceDeleteOutboxEventRequest, v1.DatabaseServiceDeleteOutboxEventResponse]
+	listServers            *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
+	getServerConfig        *connect.Client[v1.DatabaseServiceGetServerConfigRequest, v1.DatabaseServiceGetServerConfigResponse]
+	updateServerConfig     *connect.Client[v1.DatabaseServiceUpdateServerConfigRequest, v1.DatabaseServiceUpdateServerConfigResponse]
+	createAPIKey           *connect.Client[v1.DatabaseServiceCreateAPIKeyRequest, v1.DatabaseServiceCreateAPIKeyResponse]
+	getAPIKey              *connect.Client[v1.DatabaseServiceGetAPIKeyRequest, v1.DatabaseServiceGetAPIKeyResponse]
+	listAPIKeys            *connect.Client[v1.DatabaseServiceListAPIKeysRequest, v1.DatabaseServiceListAPIKeysResponse]
+	revokeAPIKey           *connect.Client[v1.DatabaseServiceRevokeAPIKeyRequest, v1.DatabaseServiceRevokeAPIKeyResponse]
+	createInvite           *connect.Client[v1.DatabaseServiceCreateInviteRequest, v1.DatabaseServiceCreateInviteResponse]
+	listInvites            *connect.Client[v1.DatabaseServiceListInvitesRequest, v1.DatabaseServiceListInvitesResponse]
+	revokeInvite           *connect.Client[v1.DatabaseServiceRevokeInviteRequest, v1.DatabaseServiceRevokeInviteResponse]
+	redeemInvite           *connect.Client[v1.DatabaseServiceRedeemInviteRequest, v1.DatabaseServiceRedeemInviteResponse]
+	decideJoinRequest      *connect.Client[v1.DatabaseServiceDecideJoinRequestRequest, v1.DatabaseServiceDecideJoinRequestResponse]
+	cancelJoinRequest      *connect.Client[v1.DatabaseServiceCancelJoinRequestRequest, v1.DatabaseServiceCancelJoinRequestResponse]
+	getGroupConfig         *connect.Client[v1.DatabaseServiceGetGroupConfigRequest, v1.DatabaseServiceGetGroupConfigResponse]
+	updateGroupConfig      *connect.Client[v1.DatabaseServiceUpdateGroupConfigRequest, v1.DatabaseServiceUpdateGroupConfigResponse]
+	getPermissionPolicy    *connect.Client[v1.DatabaseServiceGetPermissionPolicyRequest, v1.DatabaseServiceGetPermissionPolicyResponse]
+	updatePermissionPolicy *connect.Client[v1.DatabaseServiceUpdatePermissionPolicyRequest, v1.DatabaseServiceUpdatePermissionPolicyResponse]
 }
 
 // CreateGroup calls snitch.v1.DatabaseService.CreateGroup.
@@ -193,16 +549,46 @@ func (c *databaseServiceClient) FindGroupByServer(ctx context.Context, req *conn
 	return c.findGroupByServer.CallUnary(ctx, req)
 }
 
+// ListServerGroups calls snitch.v1.DatabaseService.ListServerGroups.
+func (c *databaseServiceClient) ListServerGroups(ctx context.Context, req *connect.Request[v1.DatabaseServiceListServerGroupsRequest]) (*connect.Response[v1.DatabaseServiceListServerGroupsResponse], error) {
+	return c.listServerGroups.CallUnary(ctx, req)
+}
+
 // AddServerToGroup calls snitch.v1.DatabaseService.AddServerToGroup.
 func (c *databaseServiceClient) AddServerToGroup(ctx context.Context, req *connect.Request[v1.AddServerToGroupRequest]) (*connect.Response[v1.AddServerToGroupResponse], error) {
 	return c.addServerToGroup.CallUnary(ctx, req)
 }
 
+// RemoveServerFromGroup calls snitch.v1.DatabaseService.RemoveServerFromGroup.
+func (c *databaseServiceClient) RemoveServerFromGroup(ctx context.Context, req *connect.Request[v1.RemoveServerFromGroupRequest]) (*connect.Response[v1.RemoveServerFromGroupResponse], error) {
+	return c.removeServerFromGroup.CallUnary(ctx, req)
+}
+
+// SetServerRole calls snitch.v1.DatabaseService.SetServerRole.
+func (c *databaseServiceClient) SetServerRole(ctx context.Context, req *connect.Request[v1.DatabaseServiceSetServerRoleRequest]) (*connect.Response[v1.DatabaseServiceSetServerRoleResponse], error) {
+	return c.setServerRole.CallUnary(ctx, req)
+}
+
+// TransferGroupOwnership calls snitch.v1.DatabaseService.TransferGroupOwnership.
+func (c *databaseServiceClient) TransferGroupOwnership(ctx context.Context, req *connect.Request[v1.DatabaseServiceTransferGroupOwnershipRequest]) (*connect.Response[v1.DatabaseServiceTransferGroupOwnershipResponse], error) {
+	return c.transferGroupOwnership.CallUnary(ctx, req)
+}
+
 // CreateGroupDatabase calls snitch.v1.DatabaseService.CreateGroupDatabase.
 func (c *databaseServiceClient) CreateGroupDatabase(ctx context.Context, req *connect.Request[v1.CreateGroupDatabaseRequest]) (*connect.Response[v1.CreateGroupDatabaseResponse], error) {
 	return c.createGroupDatabase.CallUnary(ctx, req)
 }
 
+// DeleteGroup calls snitch.v1.DatabaseService.DeleteGroup.
+func (c *databaseServiceClient) DeleteGroup(ctx context.Context, req *connect.Request[v1.DatabaseServiceDeleteGroupRequest]) (*connect.Response[v1.DatabaseServiceDeleteGroupResponse], error) {
+	return c.deleteGroup.CallUnary(ctx, req)
+}
+
+// RestoreGroup calls snitch.v1.DatabaseService.RestoreGroup.
+func (c *databaseServiceClient) RestoreGroup(ctx context.Context, req *connect.Request[v1.DatabaseServiceRestoreGroupRequest]) (*connect.Response[v1.DatabaseServiceRestoreGroupResponse], error) {
+	return c.restoreGroup.CallUnary(ctx, req)
+}
+
 // CreateReport calls snitch.v1.DatabaseService.CreateReport.
 func (c *databaseServiceClient) CreateReport(ctx context.Context, req *connect.Request[v1.DatabaseServiceCreateReportRequest]) (*connect.Response[v1.DatabaseServiceCreateReportResponse], error) {
 	return c.createReport.CallUnary(ctx, req)
@@ -223,6 +609,21 @@ func (c *databaseServiceClient) DeleteReport(ctx context.Context, req *connect.R
 	return c.deleteReport.CallUnary(ctx, req)
 }
 
+// UpdateReportStatus calls snitch.v1.DatabaseService.UpdateReportStatus.
+func (c *databaseServiceClient) UpdateReportStatus(ctx context.Context, req *connect.Request[v1.DatabaseServiceUpdateReportStatusRequest]) (*connect.Response[v1.DatabaseServiceUpdateReportStatusResponse], error) {
+	return c.updateReportStatus.CallUnary(ctx, req)
+}
+
+// ListReportAuditLog calls snitch.v1.DatabaseService.ListReportAuditLog.
+func (c *databaseServiceClient) ListReportAuditLog(ctx context.Context, req *connect.Request[v1.DatabaseServiceListReportAuditLogRequest]) (*connect.Response[v1.DatabaseServiceListReportAuditLogResponse], error) {
+	return c.listReportAuditLog.CallUnary(ctx, req)
+}
+
+// GetUserReportSummary calls snitch.v1.DatabaseService.GetUserReportSummary.
+func (c *databaseServiceClient) GetUserReportSummary(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetUserReportSummaryRequest]) (*connect.Response[v1.DatabaseServiceGetUserReportSummaryResponse], error) {
+	return c.getUserReportSummary.CallUnary(ctx, req)
+}
+
 // CreateUserHistory calls snitch.v1.DatabaseService.CreateUserHistory.
 func (c *databaseServiceClient) CreateUserHistory(ctx context.Context, req *connect.Request[v1.DatabaseServiceCreateUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceCreateUserHistoryResponse], error) {
 	return c.createUserHistory.CallUnary(ctx, req)
@@ -233,29 +634,185 @@ func (c *databaseServiceClient) GetUserHistory(ctx context.Context, req *connect
 	return c.getUserHistory.CallUnary(ctx, req)
 }
 
+// CreateBan calls snitch.v1.DatabaseService.CreateBan.
+func (c *databaseServiceClient) CreateBan(ctx context.Context, req *connect.Request[v1.DatabaseServiceCreateBanRequest]) (*connect.Response[v1.DatabaseServiceCreateBanResponse], error) {
+	return c.createBan.CallUnary(ctx, req)
+}
+
+// AppendEvent calls snitch.v1.DatabaseService.AppendEvent.
+func (c *databaseServiceClient) AppendEvent(ctx context.Context, req *connect.Request[v1.DatabaseServiceAppendEventRequest]) (*connect.Response[v1.DatabaseServiceAppendEventResponse], error) {
+	return c.appendEvent.CallUnary(ctx, req)
+}
+
+// ListEvents calls snitch.v1.DatabaseService.ListEvents.
+func (c *databaseServiceClient) ListEvents(ctx context.Context, req *connect.Request[v1.DatabaseServiceListEventsRequest]) (*connect.Response[v1.DatabaseServiceListEventsResponse], error) {
+	return c.listEvents.CallUnary(ctx, req)
+}
+
+// GetLatestEventSequence calls snitch.v1.DatabaseService.GetLatestEventSequence.
+func (c *databaseServiceClient) GetLatestEventSequence(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetLatestEventSequenceRequest]) (*connect.Response[v1.DatabaseServiceGetLatestEventSequenceResponse], error) {
+	return c.getLatestEventSequence.CallUnary(ctx, req)
+}
+
+// ListOutboxEvents calls snitch.v1.DatabaseService.ListOutboxEvents.
+func (c *databaseServiceClient) ListOutboxEvents(ctx context.Context, req *connect.Request[v1.DatabaseServiceListOutboxEventsRequest]) (*connect.Response[v1.DatabaseServiceListOutboxEventsResponse], error) {
+	return c.listOutboxEvents.CallUnary(ctx, req)
+}
+
+// DeleteOutboxEvent calls snitch.v1.DatabaseService.DeleteOutboxEvent.
+func (c *databaseServiceClient) DeleteOutboxEvent(ctx context.Context, req *connect.Request[v1.DatabaseServiceDeleteOutboxEventRequest]) (*connect.Response[v1.DatabaseServiceDeleteOutboxEventResponse], error) {
+	return c.deleteOutboxEvent.CallUnary(ctx, req)
+}
+
 // ListServers calls snitch.v1.DatabaseService.ListServers.
 func (c *databaseServiceClient) ListServers(ctx context.Context, req *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error) {
 	return c.listServers.CallUnary(ctx, req)
 }
 
+// GetServerConfig calls snitch.v1.DatabaseService.GetServerConfig.
+func (c *databaseServiceClient) GetServerConfig(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetServerConfigRequest]) (*connect.Response[v1.DatabaseServiceGetServerConfigResponse], error) {
+	return c.getServerConfig.CallUnary(ctx, req)
+}
+
+// UpdateServerConfig calls snitch.v1.DatabaseService.UpdateServerConfig.
+func (c *databaseServiceClient) UpdateServerConfig(ctx context.Context, req *connect.Request[v1.DatabaseServiceUpdateServerConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateServerConfigResponse], error) {
+	return c.updateServerConfig.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls snitch.v1.DatabaseService.CreateAPIKey.
+func (c *databaseServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.DatabaseServiceCreateAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceCreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// GetAPIKey calls snitch.v1.DatabaseService.GetAPIKey.
+func (c *databaseServiceClient) GetAPIKey(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceGetAPIKeyResponse], error) {
+	return c.getAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls snitch.v1.DatabaseService.ListAPIKeys.
+func (c *databaseServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.DatabaseServiceListAPIKeysRequest]) (*connect.Response[v1.DatabaseServiceListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls snitch.v1.DatabaseService.RevokeAPIKey.
+func (c *databaseServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.DatabaseServiceRevokeAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceRevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// CreateInvite calls snitch.v1.DatabaseService.CreateInvite.
+func (c *databaseServiceClient) CreateInvite(ctx context.Context, req *connect.Request[v1.DatabaseServiceCreateInviteRequest]) (*connect.Response[v1.DatabaseServiceCreateInviteResponse], error) {
+	return c.createInvite.CallUnary(ctx, req)
+}
+
+// ListInvites calls snitch.v1.DatabaseService.ListInvites.
+func (c *databaseServiceClient) ListInvites(ctx context.Context, req *connect.Request[v1.DatabaseServiceListInvitesRequest]) (*connect.Response[v1.DatabaseServiceListInvitesResponse], error) {
+	return c.listInvites.CallUnary(ctx, req)
+}
+
+// RevokeInvite calls snitch.v1.DatabaseService.RevokeInvite.
+func (c *databaseServiceClient) RevokeInvite(ctx context.Context, req *connect.Request[v1.DatabaseServiceRevokeInviteRequest]) (*connect.Response[v1.DatabaseServiceRevokeInviteResponse], error) {
+	return c.revokeInvite.CallUnary(ctx, req)
+}
+
+// RedeemInvite calls snitch.v1.DatabaseService.RedeemInvite.
+func (c *databaseServiceClient) RedeemInvite(ctx context.Context, req *connect.Request[v1.DatabaseServiceRedeemInviteRequest]) (*connect.Response[v1.DatabaseServiceRedeemInviteResponse], error) {
+	return c.redeemInvite.CallUnary(ctx, req)
+}
+
+// DecideJoinRequest calls snitch.v1.DatabaseService.DecideJoinRequest.
+func (c *databaseServiceClient) DecideJoinRequest(ctx context.Context, req *connect.Request[v1.DatabaseServiceDecideJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceDecideJoinRequestResponse], error) {
+	return c.decideJoinRequest.CallUnary(ctx, req)
+}
+
+// CancelJoinRequest calls snitch.v1.DatabaseService.CancelJoinRequest.
+func (c *databaseServiceClient) CancelJoinRequest(ctx context.Context, req *connect.Request[v1.DatabaseServiceCancelJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceCancelJoinRequestResponse], error) {
+	return c.cancelJoinRequest.CallUnary(ctx, req)
+}
+
+// GetGroupConfig calls snitch.v1.DatabaseService.GetGroupConfig.
+func (c *databaseServiceClient) GetGroupConfig(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceGetGroupConfigResponse], error) {
+	return c.getGroupConfig.CallUnary(ctx, req)
+}
+
+// UpdateGroupConfig calls snitch.v1.DatabaseService.UpdateGroupConfig.
+func (c *databaseServiceClient) UpdateGroupConfig(ctx context.Context, req *connect.Request[v1.DatabaseServiceUpdateGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateGroupConfigResponse], error) {
+	return c.updateGroupConfig.CallUnary(ctx, req)
+}
+
+// GetPermissionPolicy calls snitch.v1.DatabaseService.GetPermissionPolicy.
+func (c *databaseServiceClient) GetPermissionPolicy(ctx context.Context, req *connect.Request[v1.DatabaseServiceGetPermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceGetPermissionPolicyResponse], error) {
+	return c.getPermissionPolicy.CallUnary(ctx, req)
+}
+
+// UpdatePermissionPolicy calls snitch.v1.DatabaseService.UpdatePermissionPolicy.
+func (c *databaseServiceClient) UpdatePermissionPolicy(ctx context.Context, req *connect.Request[v1.DatabaseServiceUpdatePermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceUpdatePermissionPolicyResponse], error) {
+	return c.updatePermissionPolicy.CallUnary(ctx, req)
+}
+
 // DatabaseServiceHandler is an implementation of the snitch.v1.DatabaseService service.
 type DatabaseServiceHandler interface {
 	// Metadata operations
 	CreateGroup(context.Context, *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error)
 	FindGroupByServer(context.Context, *connect.Request[v1.FindGroupByServerRequest]) (*connect.Response[v1.FindGroupByServerResponse], error)
+	ListServerGroups(context.Context, *connect.Request[v1.DatabaseServiceListServerGroupsRequest]) (*connect.Response[v1.DatabaseServiceListServerGroupsResponse], error)
 	AddServerToGroup(context.Context, *connect.Request[v1.AddServerToGroupRequest]) (*connect.Response[v1.AddServerToGroupResponse], error)
+	RemoveServerFromGroup(context.Context, *connect.Request[v1.RemoveServerFromGroupRequest]) (*connect.Response[v1.RemoveServerFromGroupResponse], error)
+	// Ownership changes go through TransferGroupOwnership so the group's owner stays in sync
+	SetServerRole(context.Context, *connect.Request[v1.DatabaseServiceSetServerRoleRequest]) (*connect.Response[v1.DatabaseServiceSetServerRoleResponse], error)
+	TransferGroupOwnership(context.Context, *connect.Request[v1.DatabaseServiceTransferGroupOwnershipRequest]) (*connect.Response[v1.DatabaseServiceTransferGroupOwnershipResponse], error)
 	// Group database operations
 	CreateGroupDatabase(context.Context, *connect.Request[v1.CreateGroupDatabaseRequest]) (*connect.Response[v1.CreateGroupDatabaseResponse], error)
+	// Soft-deletes a group; it is purged along with its database once the grace period ends
+	DeleteGroup(context.Context, *connect.Request[v1.DatabaseServiceDeleteGroupRequest]) (*connect.Response[v1.DatabaseServiceDeleteGroupResponse], error)
+	// Undoes the most recent deletion of a group owned by the server, while still in its grace period
+	RestoreGroup(context.Context, *connect.Request[v1.DatabaseServiceRestoreGroupRequest]) (*connect.Response[v1.DatabaseServiceRestoreGroupResponse], error)
 	// Report operations
 	CreateReport(context.Context, *connect.Request[v1.DatabaseServiceCreateReportRequest]) (*connect.Response[v1.DatabaseServiceCreateReportResponse], error)
 	GetReport(context.Context, *connect.Request[v1.DatabaseServiceGetReportRequest]) (*connect.Response[v1.DatabaseServiceGetReportResponse], error)
 	ListReports(context.Context, *connect.Request[v1.DatabaseServiceListReportsRequest]) (*connect.Response[v1.DatabaseServiceListReportsResponse], error)
 	DeleteReport(context.Context, *connect.Request[v1.DatabaseServiceDeleteReportRequest]) (*connect.Response[v1.DatabaseServiceDeleteReportResponse], error)
+	UpdateReportStatus(context.Context, *connect.Request[v1.DatabaseServiceUpdateReportStatusRequest]) (*connect.Response[v1.DatabaseServiceUpdateReportStatusResponse], error)
+	ListReportAuditLog(context.Context, *connect.Request[v1.DatabaseServiceListReportAuditLogRequest]) (*connect.Response[v1.DatabaseServiceListReportAuditLogResponse], error)
+	GetUserReportSummary(context.Context, *connect.Request[v1.DatabaseServiceGetUserReportSummaryRequest]) (*connect.Response[v1.DatabaseServiceGetUserReportSummaryResponse], error)
 	// User history operations
 	CreateUserHistory(context.Context, *connect.Request[v1.DatabaseServiceCreateUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceCreateUserHistoryResponse], error)
 	GetUserHistory(context.Context, *connect.Request[v1.DatabaseServiceGetUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceGetUserHistoryResponse], error)
+	// Moderation operations
+	CreateBan(context.Context, *connect.Request[v1.DatabaseServiceCreateBanRequest]) (*connect.Response[v1.DatabaseServiceCreateBanResponse], error)
+	// Event log operations
+	// AppendEvent stores an event in the group's event log and assigns its sequence
+	AppendEvent(context.Context, *connect.Request[v1.DatabaseServiceAppendEventRequest]) (*connect.Response[v1.DatabaseServiceAppendEventResponse], error)
+	ListEvents(context.Context, *connect.Request[v1.DatabaseServiceListEventsRequest]) (*connect.Response[v1.DatabaseServiceListEventsResponse], error)
+	GetLatestEventSequence(context.Context, *connect.Request[v1.DatabaseServiceGetLatestEventSequenceRequest]) (*connect.Response[v1.DatabaseServiceGetLatestEventSequenceResponse], error)
+	// ListOutboxEvents returns the oldest unpublished outbox events across every group
+	ListOutboxEvents(context.Context, *connect.Request[v1.DatabaseServiceListOutboxEventsRequest]) (*connect.Response[v1.DatabaseServiceListOutboxEventsResponse], error)
+	// DeleteOutboxEvent removes an outbox event once it has been published
+	DeleteOutboxEvent(context.Context, *connect.Request[v1.DatabaseServiceDeleteOutboxEventRequest]) (*connect.Response[v1.DatabaseServiceDeleteOutboxEventResponse], error)
 	// Server operations
 	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
+	GetServerConfig(context.Context, *connect.Request[v1.DatabaseServiceGetServerConfigRequest]) (*connect.Response[v1.DatabaseServiceGetServerConfigResponse], error)
+	UpdateServerConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateServerConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateServerConfigResponse], error)
+	// API key operations
+	CreateAPIKey(context.Context, *connect.Request[v1.DatabaseServiceCreateAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceCreateAPIKeyResponse], error)
+	GetAPIKey(context.Context, *connect.Request[v1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceGetAPIKeyResponse], error)
+	ListAPIKeys(context.Context, *connect.Request[v1.DatabaseServiceListAPIKeysRequest]) (*connect.Response[v1.DatabaseServiceListAPIKeysResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[v1.DatabaseServiceRevokeAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceRevokeAPIKeyResponse], error)
+	// Invite operations
+	CreateInvite(context.Context, *connect.Request[v1.DatabaseServiceCreateInviteRequest]) (*connect.Response[v1.DatabaseServiceCreateInviteResponse], error)
+	ListInvites(context.Context, *connect.Request[v1.DatabaseServiceListInvitesRequest]) (*connect.Response[v1.DatabaseServiceListInvitesResponse], error)
+	RevokeInvite(context.Context, *connect.Request[v1.DatabaseServiceRevokeInviteRequest]) (*connect.Response[v1.DatabaseServiceRevokeInviteResponse], error)
+	// RedeemInvite uses up one use of an invite and adds the server to its group
+	RedeemInvite(context.Context, *connect.Request[v1.DatabaseServiceRedeemInviteRequest]) (*connect.Response[v1.DatabaseServiceRedeemInviteResponse], error)
+	// DecideJoinRequest approves or denies a pending join request, adding the server to the group when approved
+	DecideJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceDecideJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceDecideJoinRequestResponse], error)
+	// CancelJoinRequest withdraws a pending join request and gives back the use of the invite it was filed with
+	CancelJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceCancelJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceCancelJoinRequestResponse], error)
+	// Group config operations
+	GetGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceGetGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceGetGroupConfigResponse], error)
+	UpdateGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateGroupConfigResponse], error)
+	// Bot permission policy operations
+	GetPermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceGetPermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceGetPermissionPolicyResponse], error)
+	UpdatePermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceUpdatePermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceUpdatePermissionPolicyResponse], error)
 }
 
 // NewDatabaseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -277,18 +834,54 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("FindGroupByServer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceListServerGroupsHandler := connect.NewUnaryHandler(
+		DatabaseServiceListServerGroupsProcedure,
+		svc.ListServerGroups,
+		connect.WithSchema(databaseServiceMethods.ByName("ListServerGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceAddServerToGroupHandler := connect.NewUnaryHandler(
 		DatabaseServiceAddServerToGroupProcedure,
 		svc.AddServerToGroup,
 		connect.WithSchema(databaseServiceMethods.ByName("AddServerToGroup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceRemoveServerFromGroupHandler := connect.NewUnaryHandler(
+		DatabaseServiceRemoveServerFromGroupProcedure,
+		svc.RemoveServerFromGroup,
+		connect.WithSchema(databaseServiceMethods.ByName("RemoveServerFromGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceSetServerRoleHandler := connect.NewUnaryHandler(
+		DatabaseServiceSetServerRoleProcedure,
+		svc.SetServerRole,
+		connect.WithSchema(databaseServiceMethods.ByName("SetServerRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceTransferGroupOwnershipHandler := connect.NewUnaryHandler(
+		DatabaseServiceTransferGroupOwnershipProcedure,
+		svc.TransferGroupOwnership,
+		connect.WithSchema(databaseServiceMethods.ByName("TransferGroupOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceCreateGroupDatabaseHandler := connect.NewUnaryHandler(
 		DatabaseServiceCreateGroupDatabaseProcedure,
 		svc.CreateGroupDatabase,
 		connect.WithSchema(databaseServiceMethods.ByName("CreateGroupDatabase")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceDeleteGroupHandler := connect.NewUnaryHandler(
+		DatabaseServiceDeleteGroupProcedure,
+		svc.DeleteGroup,
+		connect.WithSchema(databaseServiceMethods.ByName("DeleteGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceRestoreGroupHandler := connect.NewUnaryHandler(
+		DatabaseServiceRestoreGroupProcedure,
+		svc.RestoreGroup,
+		connect.WithSchema(databaseServiceMethods.ByName("RestoreGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceCreateReportHandler := connect.NewUnaryHandler(
 		DatabaseServiceCreateReportProcedure,
 		svc.CreateReport,
@@ -313,6 +906,24 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("DeleteReport")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceUpdateReportStatusHandler := connect.NewUnaryHandler(
+		DatabaseServiceUpdateReportStatusProcedure,
+		svc.UpdateReportStatus,
+		connect.WithSchema(databaseServiceMethods.ByName("UpdateReportStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListReportAuditLogHandler := connect.NewUnaryHandler(
+		DatabaseServiceListReportAuditLogProcedure,
+		svc.ListReportAuditLog,
+		connect.WithSchema(databaseServiceMethods.ByName("ListReportAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceGetUserReportSummaryHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetUserReportSummaryProcedure,
+		svc.GetUserReportSummary,
+		connect.WithSchema(databaseServiceMethods.ByName("GetUserReportSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceCreateUserHistoryHandler := connect.NewUnaryHandler(
 		DatabaseServiceCreateUserHistoryProcedure,
 		svc.CreateUserHistory,
@@ -325,22 +936,166 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("GetUserHistory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceCreateBanHandler := connect.NewUnaryHandler(
+		DatabaseServiceCreateBanProcedure,
+		svc.CreateBan,
+		connect.WithSchema(databaseServiceMethods.ByName("CreateBan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceAppendEventHandler := connect.NewUnaryHandler(
+		DatabaseServiceAppendEventProcedure,
+		svc.AppendEvent,
+		connect.WithSchema(databaseServiceMethods.ByName("AppendEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListEventsHandler := connect.NewUnaryHandler(
+		DatabaseServiceListEventsProcedure,
+		svc.ListEvents,
+		connect.WithSchema(databaseServiceMethods.ByName("ListEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceGetLatestEventSequenceHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetLatestEventSequenceProcedure,
+		svc.GetLatestEventSequence,
+		connect.WithSchema(databaseServiceMethods.ByName("GetLatestEventSequence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListOutboxEventsHandler := connect.NewUnaryHandler(
+		DatabaseServiceListOutboxEventsProcedure,
+		svc.ListOutboxEvents,
+		connect.WithSchema(databaseServiceMethods.ByName("ListOutboxEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceDeleteOutboxEventHandler := connect.NewUnaryHandler(
+		DatabaseServiceDeleteOutboxEventProcedure,
+		svc.DeleteOutboxEvent,
+		connect.WithSchema(databaseServiceMethods.ByName("DeleteOutboxEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceListServersHandler := connect.NewUnaryHandler(
 		DatabaseServiceListServersProcedure,
 		svc.ListServers,
 		connect.WithSchema(databaseServiceMethods.ByName("ListServers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceGetServerConfigHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetServerConfigProcedure,
+		svc.GetServerConfig,
+		connect.WithSchema(databaseServiceMethods.ByName("GetServerConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceUpdateServerConfigHandler := connect.NewUnaryHandler(
+		DatabaseServiceUpdateServerConfigProcedure,
+		svc.UpdateServerConfig,
+		connect.WithSchema(databaseServiceMethods.ByName("UpdateServerConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		DatabaseServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(databaseServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceGetAPIKeyHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetAPIKeyProcedure,
+		svc.GetAPIKey,
+		connect.WithSchema(databaseServiceMethods.ByName("GetAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		DatabaseServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(databaseServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		DatabaseServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(databaseServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceCreateInviteHandler := connect.NewUnaryHandler(
+		DatabaseServiceCreateInviteProcedure,
+		svc.CreateInvite,
+		connect.WithSchema(databaseServiceMethods.ByName("CreateInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListInvitesHandler := connect.NewUnaryHandler(
+		DatabaseServiceListInvitesProcedure,
+		svc.ListInvites,
+		connect.WithSchema(databaseServiceMethods.ByName("ListInvites")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceRevokeInviteHandler := connect.NewUnaryHandler(
+		DatabaseServiceRevokeInviteProcedure,
+		svc.RevokeInvite,
+		connect.WithSchema(databaseServiceMethods.ByName("RevokeInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceRedeemInviteHandler := connect.NewUnaryHandler(
+		DatabaseServiceRedeemInviteProcedure,
+		svc.RedeemInvite,
+		connect.WithSchema(databaseServiceMethods.ByName("RedeemInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceDecideJoinRequestHandler := connect.NewUnaryHandler(
+		DatabaseServiceDecideJoinRequestProcedure,
+		svc.DecideJoinRequest,
+		connect.WithSchema(databaseServiceMethods.ByName("DecideJoinRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceCancelJoinRequestHandler := connect.NewUnaryHandler(
+		DatabaseServiceCancelJoinRequestProcedure,
+		svc.CancelJoinRequest,
+		connect.WithSchema(databaseServiceMethods.ByName("CancelJoinRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceGetGroupConfigHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetGroupConfigProcedure,
+		svc.GetGroupConfig,
+		connect.WithSchema(databaseServiceMethods.ByName("GetGroupConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceUpdateGroupConfigHandler := connect.NewUnaryHandler(
+		DatabaseServiceUpdateGroupConfigProcedure,
+		svc.UpdateGroupConfig,
+		connect.WithSchema(databaseServiceMethods.ByName("UpdateGroupConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceGetPermissionPolicyHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetPermissionPolicyProcedure,
+		svc.GetPermissionPolicy,
+		connect.WithSchema(databaseServiceMethods.ByName("GetPermissionPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceUpdatePermissionPolicyHandler := connect.NewUnaryHandler(
+		DatabaseServiceUpdatePermissionPolicyProcedure,
+		svc.UpdatePermissionPolicy,
+		connect.WithSchema(databaseServiceMethods.ByName("UpdatePermissionPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/snitch.v1.DatabaseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DatabaseServiceCreateGroupProcedure:
 			databaseServiceCreateGroupHandler.ServeHTTP(w, r)
 		case DatabaseServiceFindGroupByServerProcedure:
 			databaseServiceFindGroupByServerHandler.ServeHTTP(w, r)
+		case DatabaseServiceListServerGroupsProcedure:
+			databaseServiceListServerGroupsHandler.ServeHTTP(w, r)
 		case DatabaseServiceAddServerToGroupProcedure:
 			databaseServiceAddServerToGroupHandler.ServeHTTP(w, r)
+		case DatabaseServiceRemoveServerFromGroupProcedure:
+			databaseServiceRemoveServerFromGroupHandler.ServeHTTP(w, r)
+		case DatabaseServiceSetServerRoleProcedure:
+			databaseServiceSetServerRoleHandler.ServeHTTP(w, r)
+		case DatabaseServiceTransferGroupOwnershipProcedure:
+			databaseServiceTransferGroupOwnershipHandler.ServeHTTP(w, r)
 		case DatabaseServiceCreateGroupDatabaseProcedure:
 			databaseServiceCreateGroupDatabaseHandler.ServeHTTP(w, r)
+		case DatabaseServiceDeleteGroupProcedure:
+			databaseServiceDeleteGroupHandler.ServeHTTP(w, r)
+		case DatabaseServiceRestoreGroupProcedure:
+			databaseServiceRestoreGroupHandler.ServeHTTP(w, r)
 		case DatabaseServiceCreateReportProcedure:
 			databaseServiceCreateReportHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetReportProcedure:
@@ -349,12 +1104,62 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceListReportsHandler.ServeHTTP(w, r)
 		case DatabaseServiceDeleteReportProcedure:
 			databaseServiceDeleteReportHandler.ServeHTTP(w, r)
+		case DatabaseServiceUpdateReportStatusProcedure:
+			databaseServiceUpdateReportStatusHandler.ServeHTTP(w, r)
+		case DatabaseServiceListReportAuditLogProcedure:
+			databaseServiceListReportAuditLogHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetUserReportSummaryProcedure:
+			databaseServiceGetUserReportSummaryHandler.ServeHTTP(w, r)
 		case DatabaseServiceCreateUserHistoryProcedure:
 			databaseServiceCreateUserHistoryHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetUserHistoryProcedure:
 			databaseServiceGetUserHistoryHandler.ServeHTTP(w, r)
+		case DatabaseServiceCreateBanProcedure:
+			databaseServiceCreateBanHandler.ServeHTTP(w, r)
+		case DatabaseServiceAppendEventProcedure:
+			databaseServiceAppendEventHandler.ServeHTTP(w, r)
+		case DatabaseServiceListEventsProcedure:
+			databaseServiceListEventsHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetLatestEventSequenceProcedure:
+			databaseServiceGetLatestEventSequenceHandler.ServeHTTP(w, r)
+		case DatabaseServiceListOutboxEventsProcedure:
+			databaseServiceListOutboxEventsHandler.ServeHTTP(w, r)
+		case DatabaseServiceDeleteOutboxEventProcedure:
+			databaseServiceDeleteOutboxEventHandler.ServeHTTP(w, r)
 		case DatabaseServiceListServersProcedure:
 			databaseServiceListServersHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetServerConfigProcedure:
+			databaseServiceGetServerConfigHandler.ServeHTTP(w, r)
+		case DatabaseServiceUpdateServerConfigProcedure:
+			databaseServiceUpdateServerConfigHandler.ServeHTTP(w, r)
+		case DatabaseServiceCreateAPIKeyProcedure:
+			databaseServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetAPIKeyProcedure:
+			databaseServiceGetAPIKeyHandler.ServeHTTP(w, r)
+		case DatabaseServiceListAPIKeysProcedure:
+			databaseServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case DatabaseServiceRevokeAPIKeyProcedure:
+			databaseServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case DatabaseServiceCreateInviteProcedure:
+			databaseServiceCreateInviteHandler.ServeHTTP(w, r)
+		case DatabaseServiceListInvitesProcedure:
+			databaseServiceListInvitesHandler.ServeHTTP(w, r)
+		case DatabaseServiceRevokeInviteProcedure:
+			databaseServiceRevokeInviteHandler.ServeHTTP(w, r)
+		case DatabaseServiceRedeemInviteProcedure:
+			databaseServiceRedeemInviteHandler.ServeHTTP(w, r)
+		case DatabaseServiceDecideJoinRequestProcedure:
+			databaseServiceDecideJoinRequestHandler.ServeHTTP(w, r)
+		case DatabaseServiceCancelJoinRequestProcedure:
+			databaseServiceCancelJoinRequestHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetGroupConfigProcedure:
+			databaseServiceGetGroupConfigHandler.ServeHTTP(w, r)
+		case DatabaseServiceUpdateGroupConfigProcedure:
+			databaseServiceUpdateGroupConfigHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetPermissionPolicyProcedure:
+			databaseServiceGetPermissionPolicyHandler.ServeHTTP(w, r)
+		case DatabaseServiceUpdatePermissionPolicyProcedure:
+			databaseServiceUpdatePermissionPolicyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -372,14 +1177,38 @@ func (UnimplementedDatabaseServiceHandler) FindGroupByServer(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.FindGroupByServer is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) ListServerGroups(context.Context, *connect.Request[v1.DatabaseServiceListServerGroupsRequest]) (*connect.Response[v1.DatabaseServiceListServerGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListServerGroups is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) AddServerToGroup(context.Context, *connect.Request[v1.AddServerToGroupRequest]) (*connect.Response[v1.AddServerToGroupResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.AddServerToGroup is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) RemoveServerFromGroup(context.Context, *connect.Request[v1.RemoveServerFromGroupRequest]) (*connect.Response[v1.RemoveServerFromGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.RemoveServerFromGroup is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) SetServerRole(context.Context, *connect.Request[v1.DatabaseServiceSetServerRoleRequest]) (*connect.Response[v1.DatabaseServiceSetServerRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.SetServerRole is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) TransferGroupOwnership(context.Context, *connect.Request[v1.DatabaseServiceTransferGroupOwnershipRequest]) (*connect.Response[v1.DatabaseServiceTransferGroupOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.TransferGroupOwnership is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) CreateGroupDatabase(context.Context, *connect.Request[v1.CreateGroupDatabaseRequest]) (*connect.Response[v1.CreateGroupDatabaseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateGroupDatabase is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) DeleteGroup(context.Context, *connect.Request[v1.DatabaseServiceDeleteGroupRequest]) (*connect.Response[v1.DatabaseServiceDeleteGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.DeleteGroup is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) RestoreGroup(context.Context, *connect.Request[v1.DatabaseServiceRestoreGroupRequest]) (*connect.Response[v1.DatabaseServiceRestoreGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.RestoreGroup is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) CreateReport(context.Context, *connect.Request[v1.DatabaseServiceCreateReportRequest]) (*connect.Response[v1.DatabaseServiceCreateReportResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateReport is not implemented"))
 }
@@ -396,6 +1225,18 @@ func (UnimplementedDatabaseServiceHandler) DeleteReport(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.DeleteReport is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) UpdateReportStatus(context.Context, *connect.Request[v1.DatabaseServiceUpdateReportStatusRequest]) (*connect.Response[v1.DatabaseServiceUpdateReportStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.UpdateReportStatus is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListReportAuditLog(context.Context, *connect.Request[v1.DatabaseServiceListReportAuditLogRequest]) (*connect.Response[v1.DatabaseServiceListReportAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListReportAuditLog is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) GetUserReportSummary(context.Context, *connect.Request[v1.DatabaseServiceGetUserReportSummaryRequest]) (*connect.Response[v1.DatabaseServiceGetUserReportSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetUserReportSummary is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) CreateUserHistory(context.Context, *connect.Request[v1.DatabaseServiceCreateUserHistoryRequest]) (*connect.Response[v1.DatabaseServiceCreateUserHistoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateUserHistory is not implemented"))
 }
@@ -404,6 +1245,94 @@ func (UnimplementedDatabaseServiceHandler) GetUserHistory(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetUserHistory is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) CreateBan(context.Context, *connect.Request[v1.DatabaseServiceCreateBanRequest]) (*connect.Response[v1.DatabaseServiceCreateBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateBan is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) AppendEvent(context.Context, *connect.Request[v1.DatabaseServiceAppendEventRequest]) (*connect.Response[v1.DatabaseServiceAppendEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.AppendEvent is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListEvents(context.Context, *connect.Request[v1.DatabaseServiceListEventsRequest]) (*connect.Response[v1.DatabaseServiceListEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListEvents is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) GetLatestEventSequence(context.Context, *connect.Request[v1.DatabaseServiceGetLatestEventSequenceRequest]) (*connect.Response[v1.DatabaseServiceGetLatestEventSequenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetLatestEventSequence is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListOutboxEvents(context.Context, *connect.Request[v1.DatabaseServiceListOutboxEventsRequest]) (*connect.Response[v1.DatabaseServiceListOutboxEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListOutboxEvents is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) DeleteOutboxEvent(context.Context, *connect.Request[v1.DatabaseServiceDeleteOutboxEventRequest]) (*connect.Response[v1.DatabaseServiceDeleteOutboxEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.DeleteOutboxEvent is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListServers is not implemented"))
 }
+
+func (UnimplementedDatabaseServiceHandler) GetServerConfig(context.Context, *connect.Request[v1.DatabaseServiceGetServerConfigRequest]) (*connect.Response[v1.DatabaseServiceGetServerConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetServerConfig is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) UpdateServerConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateServerConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateServerConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.UpdateServerConfig is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.DatabaseServiceCreateAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceCreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) GetAPIKey(context.Context, *connect.Request[v1.DatabaseServiceGetAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceGetAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetAPIKey is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.DatabaseServiceListAPIKeysRequest]) (*connect.Response[v1.DatabaseServiceListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.DatabaseServiceRevokeAPIKeyRequest]) (*connect.Response[v1.DatabaseServiceRevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) CreateInvite(context.Context, *connect.Request[v1.DatabaseServiceCreateInviteRequest]) (*connect.Response[v1.DatabaseServiceCreateInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CreateInvite is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListInvites(context.Context, *connect.Request[v1.DatabaseServiceListInvitesRequest]) (*connect.Response[v1.DatabaseServiceListInvitesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.ListInvites is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) RevokeInvite(context.Context, *connect.Request[v1.DatabaseServiceRevokeInviteRequest]) (*connect.Response[v1.DatabaseServiceRevokeInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.RevokeInvite is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) RedeemInvite(context.Context, *connect.Request[v1.DatabaseServiceRedeemInviteRequest]) (*connect.Response[v1.DatabaseServiceRedeemInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.RedeemInvite is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) DecideJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceDecideJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceDecideJoinRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.DecideJoinRequest is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) CancelJoinRequest(context.Context, *connect.Request[v1.DatabaseServiceCancelJoinRequestRequest]) (*connect.Response[v1.DatabaseServiceCancelJoinRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.CancelJoinRequest is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) GetGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceGetGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceGetGroupConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetGroupConfig is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) UpdateGroupConfig(context.Context, *connect.Request[v1.DatabaseServiceUpdateGroupConfigRequest]) (*connect.Response[v1.DatabaseServiceUpdateGroupConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.UpdateGroupConfig is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) GetPermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceGetPermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceGetPermissionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.GetPermissionPolicy is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) UpdatePermissionPolicy(context.Context, *connect.Request[v1.DatabaseServiceUpdatePermissionPolicyRequest]) (*connect.Response[v1.DatabaseServiceUpdatePermissionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.DatabaseService.UpdatePermissionPolicy is not implemented"))
+}
@@ -35,11 +35,16 @@ const (
 const (
 	// EventServiceSubscribeProcedure is the fully-qualified name of the EventService's Subscribe RPC.
 	EventServiceSubscribeProcedure = "/snitch.v1.EventService/Subscribe"
+	// EventServiceGetEventCursorProcedure is the fully-qualified name of the EventService's
+	// GetEventCursor RPC.
+	EventServiceGetEventCursorProcedure = "/snitch.v1.EventService/GetEventCursor"
 )
 
 // EventServiceClient is a client for the snitch.v1.EventService service.
 type EventServiceClient interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error)
+	// GetEventCursor returns the sequence of the group's latest event, for a first subscription to resume from
+	GetEventCursor(context.Context, *connect.Request[v1.GetEventCursorRequest]) (*connect.Response[v1.GetEventCursorResponse], error)
 }
 
 // NewEventServiceClient constructs a client for the snitch.v1.EventService service. By default, it
@@ -59,12 +64,19 @@ func NewEventServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(eventServiceMethods.ByName("Subscribe")),
 			connect.WithClientOptions(opts...),
 		),
+		getEventCursor: connect.NewClient[v1.GetEventCursorRequest, v1.GetEventCursorResponse](
+			httpClient,
+			baseURL+EventServiceGetEventCursorProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("GetEventCursor")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // eventServiceClient implements EventServiceClient.
 type eventServiceClient struct {
-	subscribe *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
+	subscribe      *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
+	getEventCursor *connect.Client[v1.GetEventCursorRequest, v1.GetEventCursorResponse]
 }
 
 // Subscribe calls snitch.v1.EventService.Subscribe.
@@ -72,9 +84,16 @@ func (c *eventServiceClient) Subscribe(ctx context.Context, req *connect.Request
 	return c.subscribe.CallServerStream(ctx, req)
 }
 
+// GetEventCursor calls snitch.v1.EventService.GetEventCursor.
+func (c *eventServiceClient) GetEventCursor(ctx context.Context, req *connect.Request[v1.GetEventCursorRequest]) (*connect.Response[v1.GetEventCursorResponse], error) {
+	return c.getEventCursor.CallUnary(ctx, req)
+}
+
 // EventServiceHandler is an implementation of the snitch.v1.EventService service.
 type EventServiceHandler interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error
+	// GetEventCursor returns the sequence of the group's latest event, for a first subscription to resume from
+	GetEventCursor(context.Context, *connect.Request[v1.GetEventCursorRequest]) (*connect.Response[v1.GetEventCursorResponse], error)
 }
 
 // NewEventServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -90,10 +109,18 @@ func NewEventServiceHandler(svc EventServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(eventServiceMethods.ByName("Subscribe")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventServiceGetEventCursorHandler := connect.NewUnaryHandler(
+		EventServiceGetEventCursorProcedure,
+		svc.GetEventCursor,
+		connect.WithSchema(eventServiceMethods.ByName("GetEventCursor")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/snitch.v1.EventService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EventServiceSubscribeProcedure:
 			eventServiceSubscribeHandler.ServeHTTP(w, r)
+		case EventServiceGetEventCursorProcedure:
+			eventServiceGetEventCursorHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +133,7 @@ type UnimplementedEventServiceHandler struct{}
 func (UnimplementedEventServiceHandler) Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.EventService.Subscribe is not implemented"))
 }
+
+func (UnimplementedEventServiceHandler) GetEventCursor(context.Context, *connect.Request[v1.GetEventCursorRequest]) (*connect.Response[v1.GetEventCursorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.EventService.GetEventCursor is not implemented"))
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: snitch/v1/moderation.proto
+
+package snitchv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	v1 "snitch/pkg/proto/gen/snitch/v1"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ModerationServiceName is the fully-qualified name of the ModerationService service.
+	ModerationServiceName = "snitch.v1.ModerationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ModerationServiceRecordBanProcedure is the fully-qualified name of the ModerationService's
+	// RecordBan RPC.
+	ModerationServiceRecordBanProcedure = "/snitch.v1.ModerationService/RecordBan"
+)
+
+// ModerationServiceClient is a client for the snitch.v1.ModerationService service.
+type ModerationServiceClient interface {
+	RecordBan(context.Context, *connect.Request[v1.RecordBanRequest]) (*connect.Response[v1.RecordBanResponse], error)
+}
+
+// NewModerationServiceClient constructs a client for the snitch.v1.ModerationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewModerationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ModerationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	moderationServiceMethods := v1.File_snitch_v1_moderation_proto.Services().ByName("ModerationService").Methods()
+	return &moderationServiceClient{
+		recordBan: connect.NewClient[v1.RecordBanRequest, v1.RecordBanResponse](
+			httpClient,
+			baseURL+ModerationServiceRecordBanProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("RecordBan")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// moderationServiceClient implements ModerationServiceClient.
+type moderationServiceClient struct {
+	recordBan *connect.Client[v1.RecordBanRequest, v1.RecordBanResponse]
+}
+
+// RecordBan calls snitch.v1.ModerationService.RecordBan.
+func (c *moderationServiceClient) RecordBan(ctx context.Context, req *connect.Request[v1.RecordBanRequest]) (*connect.Response[v1.RecordBanResponse], error) {
+	return c.recordBan.CallUnary(ctx, req)
+}
+
+// ModerationServiceHandler is an implementation of the snitch.v1.ModerationService service.
+type ModerationServiceHandler interface {
+	RecordBan(context.Context, *connect.Request[v1.RecordBanRequest]) (*connect.Response[v1.RecordBanResponse], error)
+}
+
+// NewModerationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	moderationServiceMethods := v1.File_snitch_v1_moderation_proto.Services().ByName("ModerationService").Methods()
+	moderationServiceRecordBanHandler := connect.NewUnaryHandler(
+		ModerationServiceRecordBanProcedure,
+		svc.RecordBan,
+		connect.WithSchema(moderationServiceMethods.ByName("RecordBan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/snitch.v1.ModerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ModerationServiceRecordBanProcedure:
+			moderationServiceRecordBanHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedModerationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedModerationServiceHandler struct{}
+
+func (UnimplementedModerationServiceHandler) RecordBan(context.Context, *connect.Request[v1.RecordBanRequest]) (*connect.Response[v1.RecordBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ModerationService.RecordBan is not implemented"))
+}
@@ -42,6 +42,42 @@ const (
 	// RegistrarServiceHasGroupProcedure is the fully-qualified name of the RegistrarService's HasGroup
 	// RPC.
 	RegistrarServiceHasGroupProcedure = "/snitch.v1.RegistrarService/HasGroup"
+	// RegistrarServiceCreateInviteProcedure is the fully-qualified name of the RegistrarService's
+	// CreateInvite RPC.
+	RegistrarServiceCreateInviteProcedure = "/snitch.v1.RegistrarService/CreateInvite"
+	// RegistrarServiceListInvitesProcedure is the fully-qualified name of the RegistrarService's
+	// ListInvites RPC.
+	RegistrarServiceListInvitesProcedure = "/snitch.v1.RegistrarService/ListInvites"
+	// RegistrarServiceRevokeInviteProcedure is the fully-qualified name of the RegistrarService's
+	// RevokeInvite RPC.
+	RegistrarServiceRevokeInviteProcedure = "/snitch.v1.RegistrarService/RevokeInvite"
+	// RegistrarServiceDecideJoinRequestProcedure is the fully-qualified name of the RegistrarService's
+	// DecideJoinRequest RPC.
+	RegistrarServiceDecideJoinRequestProcedure = "/snitch.v1.RegistrarService/DecideJoinRequest"
+	// RegistrarServiceLeaveGroupProcedure is the fully-qualified name of the RegistrarService's
+	// LeaveGroup RPC.
+	RegistrarServiceLeaveGroupProcedure = "/snitch.v1.RegistrarService/LeaveGroup"
+	// RegistrarServiceKickServerProcedure is the fully-qualified name of the RegistrarService's
+	// KickServer RPC.
+	RegistrarServiceKickServerProcedure = "/snitch.v1.RegistrarService/KickServer"
+	// RegistrarServiceDeleteGroupProcedure is the fully-qualified name of the RegistrarService's
+	// DeleteGroup RPC.
+	RegistrarServiceDeleteGroupProcedure = "/snitch.v1.RegistrarService/DeleteGroup"
+	// RegistrarServiceRestoreGroupProcedure is the fully-qualified name of the RegistrarService's
+	// RestoreGroup RPC.
+	RegistrarServiceRestoreGroupProcedure = "/snitch.v1.RegistrarService/RestoreGroup"
+	// RegistrarServiceListGroupServersProcedure is the fully-qualified name of the RegistrarService's
+	// ListGroupServers RPC.
+	RegistrarServiceListGroupServersProcedure = "/snitch.v1.RegistrarService/ListGroupServers"
+	// RegistrarServiceSetServerRoleProcedure is the fully-qualified name of the RegistrarService's
+	// SetServerRole RPC.
+	RegistrarServiceSetServerRoleProcedure = "/snitch.v1.RegistrarService/SetServerRole"
+	// RegistrarServiceTransferOwnershipProcedure is the fully-qualified name of the RegistrarService's
+	// TransferOwnership RPC.
+	RegistrarServiceTransferOwnershipProcedure = "/snitch.v1.RegistrarService/TransferOwnership"
+	// RegistrarServiceListServerGroupsProcedure is the fully-qualified name of the RegistrarService's
+	// ListServerGroups RPC.
+	RegistrarServiceListServerGroupsProcedure = "/snitch.v1.RegistrarService/ListServerGroups"
 )
 
 // RegistrarServiceClient is a client for the snitch.v1.RegistrarService service.
@@ -49,6 +85,18 @@ type RegistrarServiceClient interface {
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	GetGroupForServer(context.Context, *connect.Request[v1.GetGroupForServerRequest]) (*connect.Response[v1.GetGroupForServerResponse], error)
 	HasGroup(context.Context, *connect.Request[v1.HasGroupRequest]) (*connect.Response[v1.HasGroupResponse], error)
+	CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error)
+	ListInvites(context.Context, *connect.Request[v1.ListInvitesRequest]) (*connect.Response[v1.ListInvitesResponse], error)
+	RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error)
+	DecideJoinRequest(context.Context, *connect.Request[v1.DecideJoinRequestRequest]) (*connect.Response[v1.DecideJoinRequestResponse], error)
+	LeaveGroup(context.Context, *connect.Request[v1.LeaveGroupRequest]) (*connect.Response[v1.LeaveGroupResponse], error)
+	KickServer(context.Context, *connect.Request[v1.KickServerRequest]) (*connect.Response[v1.KickServerResponse], error)
+	DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error)
+	RestoreGroup(context.Context, *connect.Request[v1.RestoreGroupRequest]) (*connect.Response[v1.RestoreGroupResponse], error)
+	ListGroupServers(context.Context, *connect.Request[v1.ListGroupServersRequest]) (*connect.Response[v1.ListGroupServersResponse], error)
+	SetServerRole(context.Context, *connect.Request[v1.SetServerRoleRequest]) (*connect.Response[v1.SetServerRoleResponse], error)
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	ListServerGroups(context.Context, *connect.Request[v1.ListServerGroupsRequest]) (*connect.Response[v1.ListServerGroupsResponse], error)
 }
 
 // NewRegistrarServiceClient constructs a client for the snitch.v1.RegistrarService service. By
@@ -80,6 +128,78 @@ func NewRegistrarServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(registrarServiceMethods.ByName("HasGroup")),
 			connect.WithClientOptions(opts...),
 		),
+		createInvite: connect.NewClient[v1.CreateInviteRequest, v1.CreateInviteResponse](
+			httpClient,
+			baseURL+RegistrarServiceCreateInviteProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("CreateInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvites: connect.NewClient[v1.ListInvitesRequest, v1.ListInvitesResponse](
+			httpClient,
+			baseURL+RegistrarServiceListInvitesProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("ListInvites")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvite: connect.NewClient[v1.RevokeInviteRequest, v1.RevokeInviteResponse](
+			httpClient,
+			baseURL+RegistrarServiceRevokeInviteProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("RevokeInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		decideJoinRequest: connect.NewClient[v1.DecideJoinRequestRequest, v1.DecideJoinRequestResponse](
+			httpClient,
+			baseURL+RegistrarServiceDecideJoinRequestProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("DecideJoinRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveGroup: connect.NewClient[v1.LeaveGroupRequest, v1.LeaveGroupResponse](
+			httpClient,
+			baseURL+RegistrarServiceLeaveGroupProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("LeaveGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		kickServer: connect.NewClient[v1.KickServerRequest, v1.KickServerResponse](
+			httpClient,
+			baseURL+RegistrarServiceKickServerProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("KickServer")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGroup: connect.NewClient[v1.DeleteGroupRequest, v1.DeleteGroupResponse](
+			httpClient,
+			baseURL+RegistrarServiceDeleteGroupProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("DeleteGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreGroup: connect.NewClient[v1.RestoreGroupRequest, v1.RestoreGroupResponse](
+			httpClient,
+			baseURL+RegistrarServiceRestoreGroupProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("RestoreGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listGroupServers: connect.NewClient[v1.ListGroupServersRequest, v1.ListGroupServersResponse](
+			httpClient,
+			baseURL+RegistrarServiceListGroupServersProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("ListGroupServers")),
+			connect.WithClientOptions(opts...),
+		),
+		setServerRole: connect.NewClient[v1.SetServerRoleRequest, v1.SetServerRoleResponse](
+			httpClient,
+			baseURL+RegistrarServiceSetServerRoleProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("SetServerRole")),
+			connect.WithClientOptions(opts...),
+		),
+		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse](
+			httpClient,
+			baseURL+RegistrarServiceTransferOwnershipProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("TransferOwnership")),
+			connect.WithClientOptions(opts...),
+		),
+		listServerGroups: connect.NewClient[v1.ListServerGroupsRequest, v1.ListServerGroupsResponse](
+			httpClient,
+			baseURL+RegistrarServiceListServerGroupsProcedure,
+			connect.WithSchema(registrarServiceMethods.ByName("ListServerGroups")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -88,6 +208,18 @@ type registrarServiceClient struct {
 	register          *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
 	getGroupForServer *connect.Client[v1.GetGroupForServerRequest, v1.GetGroupForServerResponse]
 	hasGroup          *connect.Client[v1.HasGroupRequest, v1.HasGroupResponse]
+	createInvite      *connect.Client[v1.CreateInviteRequest, v1.CreateInviteResponse]
+	listInvites       *connect.Client[v1.ListInvitesRequest, v1.ListInvitesResponse]
+	revokeInvite      *connect.Client[v1.RevokeInviteRequest, v1.RevokeInviteResponse]
+	decideJoinRequest *connect.Client[v1.DecideJoinRequestRequest, v1.DecideJoinRequestResponse]
+	leaveGroup        *connect.Client[v1.LeaveGroupRequest, v1.LeaveGroupResponse]
+	kickServer        *connect.Client[v1.KickServerRequest, v1.KickServerResponse]
+	deleteGroup       *connect.Client[v1.DeleteGroupRequest, v1.DeleteGroupResponse]
+	restoreGroup      *connect.Client[v1.RestoreGroupRequest, v1.RestoreGroupResponse]
+	listGroupServers  *connect.Client[v1.ListGroupServersRequest, v1.ListGroupServersResponse]
+	setServerRole     *connect.Client[v1.SetServerRoleRequest, v1.SetServerRoleResponse]
+	transferOwnership *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	listServerGroups  *connect.Client[v1.ListServerGroupsRequest, v1.ListServerGroupsResponse]
 }
 
 // Register calls snitch.v1.RegistrarService.Register.
@@ -105,11 +237,83 @@ func (c *registrarServiceClient) HasGroup(ctx context.Context, req *connect.Requ
 	return c.hasGroup.CallUnary(ctx, req)
 }
 
+// CreateInvite calls snitch.v1.RegistrarService.CreateInvite.
+func (c *registrarServiceClient) CreateInvite(ctx context.Context, req *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error) {
+	return c.createInvite.CallUnary(ctx, req)
+}
+
+// ListInvites calls snitch.v1.RegistrarService.ListInvites.
+func (c *registrarServiceClient) ListInvites(ctx context.Context, req *connect.Request[v1.ListInvitesRequest]) (*connect.Response[v1.ListInvitesResponse], error) {
+	return c.listInvites.CallUnary(ctx, req)
+}
+
+// RevokeInvite calls snitch.v1.RegistrarService.RevokeInvite.
+func (c *registrarServiceClient) RevokeInvite(ctx context.Context, req *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error) {
+	return c.revokeInvite.CallUnary(ctx, req)
+}
+
+// DecideJoinRequest calls snitch.v1.RegistrarService.DecideJoinRequest.
+func (c *registrarServiceClient) DecideJoinRequest(ctx context.Context, req *connect.Request[v1.DecideJoinRequestRequest]) (*connect.Response[v1.DecideJoinRequestResponse], error) {
+	return c.decideJoinRequest.CallUnary(ctx, req)
+}
+
+// LeaveGroup calls snitch.v1.RegistrarService.LeaveGroup.
+func (c *registrarServiceClient) LeaveGroup(ctx context.Context, req *connect.Request[v1.LeaveGroupRequest]) (*connect.Response[v1.LeaveGroupResponse], error) {
+	return c.leaveGroup.CallUnary(ctx, req)
+}
+
+// KickServer calls snitch.v1.RegistrarService.KickServer.
+func (c *registrarServiceClient) KickServer(ctx context.Context, req *connect.Request[v1.KickServerRequest]) (*connect.Response[v1.KickServerResponse], error) {
+	return c.kickServer.CallUnary(ctx, req)
+}
+
+// DeleteGroup calls snitch.v1.RegistrarService.DeleteGroup.
+func (c *registrarServiceClient) DeleteGroup(ctx context.Context, req *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error) {
+	return c.deleteGroup.CallUnary(ctx, req)
+}
+
+// RestoreGroup calls snitch.v1.RegistrarService.RestoreGroup.
+func (c *registrarServiceClient) RestoreGroup(ctx context.Context, req *connect.Request[v1.RestoreGroupRequest]) (*connect.Response[v1.RestoreGroupResponse], error) {
+	return c.restoreGroup.CallUnary(ctx, req)
+}
+
+// ListGroupServers calls snitch.v1.RegistrarService.ListGroupServers.
+func (c *registrarServiceClient) ListGroupServers(ctx context.Context, req *connect.Request[v1.ListGroupServersRequest]) (*connect.Response[v1.ListGroupServersResponse], error) {
+	return c.listGroupServers.CallUnary(ctx, req)
+}
+
+// SetServerRole calls snitch.v1.RegistrarService.SetServerRole.
+func (c *registrarServiceClient) SetServerRole(ctx context.Context, req *connect.Request[v1.SetServerRoleRequest]) (*connect.Response[v1.SetServerRoleResponse], error) {
+	return c.setServerRole.CallUnary(ctx, req)
+}
+
+// TransferOwnership calls snitch.v1.RegistrarService.TransferOwnership.
+func (c *registrarServiceClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return c.transferOwnership.CallUnary(ctx, req)
+}
+
+// ListServerGroups calls snitch.v1.RegistrarService.ListServerGroups.
+func (c *registrarServiceClient) ListServerGroups(ctx context.Context, req *connect.Request[v1.ListServerGroupsRequest]) (*connect.Response[v1.ListServerGroupsResponse], error) {
+	return c.listServerGroups.CallUnary(ctx, req)
+}
+
 // RegistrarServiceHandler is an implementation of the snitch.v1.RegistrarService service.
 type RegistrarServiceHandler interface {
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	GetGroupForServer(context.Context, *connect.Request[v1.GetGroupForServerRequest]) (*connect.Response[v1.GetGroupForServerResponse], error)
 	HasGroup(context.Context, *connect.Request[v1.HasGroupRequest]) (*connect.Response[v1.HasGroupResponse], error)
+	CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error)
+	ListInvites(context.Context, *connect.Request[v1.ListInvitesRequest]) (*connect.Response[v1.ListInvitesResponse], error)
+	RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error)
+	DecideJoinRequest(context.Context, *connect.Request[v1.DecideJoinRequestRequest]) (*connect.Response[v1.DecideJoinRequestResponse], error)
+	LeaveGroup(context.Context, *connect.Request[v1.LeaveGroupRequest]) (*connect.Response[v1.LeaveGroupResponse], error)
+	KickServer(context.Context, *connect.Request[v1.KickServerRequest]) (*connect.Response[v1.KickServerResponse], error)
+	DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error)
+	RestoreGroup(context.Context, *connect.Request[v1.RestoreGroupRequest]) (*connect.Response[v1.RestoreGroupResponse], error)
+	ListGroupServers(context.Context, *connect.Request[v1.ListGroupServersRequest]) (*connect.Response[v1.ListGroupServersResponse], error)
+	SetServerRole(context.Context, *connect.Request[v1.SetServerRoleRequest]) (*connect.Response[v1.SetServerRoleResponse], error)
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	ListServerGroups(context.Context, *connect.Request[v1.ListServerGroupsRequest]) (*connect.Response[v1.ListServerGroupsResponse], error)
 }
 
 // NewRegistrarServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -137,6 +341,78 @@ func NewRegistrarServiceHandler(svc RegistrarServiceHandler, opts ...connect.Han
 		connect.WithSchema(registrarServiceMethods.ByName("HasGroup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	registrarServiceCreateInviteHandler := connect.NewUnaryHandler(
+		RegistrarServiceCreateInviteProcedure,
+		svc.CreateInvite,
+		connect.WithSchema(registrarServiceMethods.ByName("CreateInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceListInvitesHandler := connect.NewUnaryHandler(
+		RegistrarServiceListInvitesProcedure,
+		svc.ListInvites,
+		connect.WithSchema(registrarServiceMethods.ByName("ListInvites")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceRevokeInviteHandler := connect.NewUnaryHandler(
+		RegistrarServiceRevokeInviteProcedure,
+		svc.RevokeInvite,
+		connect.WithSchema(registrarServiceMethods.ByName("RevokeInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceDecideJoinRequestHandler := connect.NewUnaryHandler(
+		RegistrarServiceDecideJoinRequestProcedure,
+		svc.DecideJoinRequest,
+		connect.WithSchema(registrarServiceMethods.ByName("DecideJoinRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceLeaveGroupHandler := connect.NewUnaryHandler(
+		RegistrarServiceLeaveGroupProcedure,
+		svc.LeaveGroup,
+		connect.WithSchema(registrarServiceMethods.ByName("LeaveGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceKickServerHandler := connect.NewUnaryHandler(
+		RegistrarServiceKickServerProcedure,
+		svc.KickServer,
+		connect.WithSchema(registrarServiceMethods.ByName("KickServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceDeleteGroupHandler := connect.NewUnaryHandler(
+		RegistrarServiceDeleteGroupProcedure,
+		svc.DeleteGroup,
+		connect.WithSchema(registrarServiceMethods.ByName("DeleteGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceRestoreGroupHandler := connect.NewUnaryHandler(
+		RegistrarServiceRestoreGroupProcedure,
+		svc.RestoreGroup,
+		connect.WithSchema(registrarServiceMethods.ByName("RestoreGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceListGroupServersHandler := connect.NewUnaryHandler(
+		RegistrarServiceListGroupServersProcedure,
+		svc.ListGroupServers,
+		connect.WithSchema(registrarServiceMethods.ByName("ListGroupServers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceSetServerRoleHandler := connect.NewUnaryHandler(
+		RegistrarServiceSetServerRoleProcedure,
+		svc.SetServerRole,
+		connect.WithSchema(registrarServiceMethods.ByName("SetServerRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceTransferOwnershipHandler := connect.NewUnaryHandler(
+		RegistrarServiceTransferOwnershipProcedure,
+		svc.TransferOwnership,
+		connect.WithSchema(registrarServiceMethods.ByName("TransferOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
+	registrarServiceListServerGroupsHandler := connect.NewUnaryHandler(
+		RegistrarServiceListServerGroupsProcedure,
+		svc.ListServerGroups,
+		connect.WithSchema(registrarServiceMethods.ByName("ListServerGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/snitch.v1.RegistrarService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RegistrarServiceRegisterProcedure:
@@ -145,6 +421,30 @@ func NewRegistrarServiceHandler(svc RegistrarServiceHandler, opts ...connect.Han
 			registrarServiceGetGroupForServerHandler.ServeHTTP(w, r)
 		case RegistrarServiceHasGroupProcedure:
 			registrarServiceHasGroupHandler.ServeHTTP(w, r)
+		case RegistrarServiceCreateInviteProcedure:
+			registrarServiceCreateInviteHandler.ServeHTTP(w, r)
+		case RegistrarServiceListInvitesProcedure:
+			registrarServiceListInvitesHandler.ServeHTTP(w, r)
+		case RegistrarServiceRevokeInviteProcedure:
+			registrarServiceRevokeInviteHandler.ServeHTTP(w, r)
+		case RegistrarServiceDecideJoinRequestProcedure:
+			registrarServiceDecideJoinRequestHandler.ServeHTTP(w, r)
+		case RegistrarServiceLeaveGroupProcedure:
+			registrarServiceLeaveGroupHandler.ServeHTTP(w, r)
+		case RegistrarServiceKickServerProcedure:
+			registrarServiceKickServerHandler.ServeHTTP(w, r)
+		case RegistrarServiceDeleteGroupProcedure:
+			registrarServiceDeleteGroupHandler.ServeHTTP(w, r)
+		case RegistrarServiceRestoreGroupProcedure:
+			registrarServiceRestoreGroupHandler.ServeHTTP(w, r)
+		case RegistrarServiceListGroupServersProcedure:
+			registrarServiceListGroupServersHandler.ServeHTTP(w, r)
+		case RegistrarServiceSetServerRoleProcedure:
+			registrarServiceSetServerRoleHandler.ServeHTTP(w, r)
+		case RegistrarServiceTransferOwnershipProcedure:
+			registrarServiceTransferOwnershipHandler.ServeHTTP(w, r)
+		case RegistrarServiceListServerGroupsProcedure:
+			registrarServiceListServerGroupsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -165,3 +465,51 @@ func (UnimplementedRegistrarServiceHandler) GetGroupForServer(context.Context, *
 func (UnimplementedRegistrarServiceHandler) HasGroup(context.Context, *connect.Request[v1.HasGroupRequest]) (*connect.Response[v1.HasGroupResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.HasGroup is not implemented"))
 }
+
+func (UnimplementedRegistrarServiceHandler) CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.CreateInvite is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) ListInvites(context.Context, *connect.Request[v1.ListInvitesRequest]) (*connect.Response[v1.ListInvitesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.ListInvites is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.RevokeInvite is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) DecideJoinRequest(context.Context, *connect.Request[v1.DecideJoinRequestRequest]) (*connect.Response[v1.DecideJoinRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.DecideJoinRequest is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) LeaveGroup(context.Context, *connect.Request[v1.LeaveGroupRequest]) (*connect.Response[v1.LeaveGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.LeaveGroup is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) KickServer(context.Context, *connect.Request[v1.KickServerRequest]) (*connect.Response[v1.KickServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.KickServer is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.DeleteGroup is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) RestoreGroup(context.Context, *connect.Request[v1.RestoreGroupRequest]) (*connect.Response[v1.RestoreGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.RestoreGroup is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) ListGroupServers(context.Context, *connect.Request[v1.ListGroupServersRequest]) (*connect.Response[v1.ListGroupServersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.ListGroupServers is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) SetServerRole(context.Context, *connect.Request[v1.SetServerRoleRequest]) (*connect.Response[v1.SetServerRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.SetServerRole is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.TransferOwnership is not implemented"))
+}
+
+func (UnimplementedRegistrarServiceHandler) ListServerGroups(context.Context, *connect.Request[v1.ListServerGroupsRequest]) (*connect.Response[v1.ListServerGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.RegistrarService.ListServerGroups is not implemented"))
+}
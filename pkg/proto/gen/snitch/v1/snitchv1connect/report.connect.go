@@ -39,16 +39,31 @@ const (
 	// ReportServiceListReportsProcedure is the fully-qualified name of the ReportService's ListReports
 	// RPC.
 	ReportServiceListReportsProcedure = "/snitch.v1.ReportService/ListReports"
+	// ReportServiceGetReportProcedure is the fully-qualified name of the ReportService's GetReport RPC.
+	ReportServiceGetReportProcedure = "/snitch.v1.ReportService/GetReport"
 	// ReportServiceDeleteReportProcedure is the fully-qualified name of the ReportService's
 	// DeleteReport RPC.
 	ReportServiceDeleteReportProcedure = "/snitch.v1.ReportService/DeleteReport"
+	// ReportServiceUpdateReportStatusProcedure is the fully-qualified name of the ReportService's
+	// UpdateReportStatus RPC.
+	ReportServiceUpdateReportStatusProcedure = "/snitch.v1.ReportService/UpdateReportStatus"
+	// ReportServiceListReportAuditLogProcedure is the fully-qualified name of the ReportService's
+	// ListReportAuditLog RPC.
+	ReportServiceListReportAuditLogProcedure = "/snitch.v1.ReportService/ListReportAuditLog"
+	// ReportServiceLookupUserProcedure is the fully-qualified name of the ReportService's LookupUser
+	// RPC.
+	ReportServiceLookupUserProcedure = "/snitch.v1.ReportService/LookupUser"
 )
 
 // ReportServiceClient is a client for the snitch.v1.ReportService service.
 type ReportServiceClient interface {
 	CreateReport(context.Context, *connect.Request[v1.CreateReportRequest]) (*connect.Response[v1.CreateReportResponse], error)
 	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	GetReport(context.Context, *connect.Request[v1.GetReportRequest]) (*connect.Response[v1.GetReportResponse], error)
 	DeleteReport(context.Context, *connect.Request[v1.DeleteReportRequest]) (*connect.Response[v1.DeleteReportResponse], error)
+	UpdateReportStatus(context.Context, *connect.Request[v1.UpdateReportStatusRequest]) (*connect.Response[v1.UpdateReportStatusResponse], error)
+	ListReportAuditLog(context.Context, *connect.Request[v1.ListReportAuditLogRequest]) (*connect.Response[v1.ListReportAuditLogResponse], error)
+	LookupUser(context.Context, *connect.Request[v1.LookupUserRequest]) (*connect.Response[v1.LookupUserResponse], error)
 }
 
 // NewReportServiceClient constructs a client for the snitch.v1.ReportService service. By default,
@@ -74,20 +89,48 @@ func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(reportServiceMethods.ByName("ListReports")),
 			connect.WithClientOptions(opts...),
 		),
+		getReport: connect.NewClient[v1.GetReportRequest, v1.GetReportResponse](
+			httpClient,
+			baseURL+ReportServiceGetReportProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetReport")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteReport: connect.NewClient[v1.DeleteReportRequest, v1.DeleteReportResponse](
 			httpClient,
 			baseURL+ReportServiceDeleteReportProcedure,
 			connect.WithSchema(reportServiceMethods.ByName("DeleteReport")),
 			connect.WithClientOptions(opts...),
 		),
+		updateReportStatus: connect.NewClient[v1.UpdateReportStatusRequest, v1.UpdateReportStatusResponse](
+			httpClient,
+			baseURL+ReportServiceUpdateReportStatusProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("UpdateReportStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listReportAuditLog: connect.NewClient[v1.ListReportAuditLogRequest, v1.ListReportAuditLogResponse](
+			httpClient,
+			baseURL+ReportServiceListReportAuditLogProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ListReportAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+		lookupUser: connect.NewClient[v1.LookupUserRequest, v1.LookupUserResponse](
+			httpClient,
+			baseURL+ReportServiceLookupUserProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("LookupUser")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // reportServiceClient implements ReportServiceClient.
 type reportServiceClient struct {
-	createReport *connect.Client[v1.CreateReportRequest, v1.CreateReportResponse]
-	listReports  *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
-	deleteReport *connect.Client[v1.DeleteReportRequest, v1.DeleteReportResponse]
+	createReport       *connect.Client[v1.CreateReportRequest, v1.CreateReportResponse]
+	listReports        *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
+	getReport          *connect.Client[v1.GetReportRequest, v1.GetReportResponse]
+	deleteReport       *connect.Client[v1.DeleteReportRequest, v1.DeleteReportResponse]
+	updateReportStatus *connect.Client[v1.UpdateReportStatusRequest, v1.UpdateReportStatusResponse]
+	listReportAuditLog *connect.Client[v1.ListReportAuditLogRequest, v1.ListReportAuditLogResponse]
+	lookupUser         *connect.Client[v1.LookupUserRequest, v1.LookupUserResponse]
 }
 
 // CreateReport calls snitch.v1.ReportService.CreateReport.
@@ -100,16 +143,40 @@ func (c *reportServiceClient) ListReports(ctx context.Context, req *connect.Requ
 	return c.listReports.CallUnary(ctx, req)
 }
 
+// GetReport calls snitch.v1.ReportService.GetReport.
+func (c *reportServiceClient) GetReport(ctx context.Context, req *connect.Request[v1.GetReportRequest]) (*connect.Response[v1.GetReportResponse], error) {
+	return c.getReport.CallUnary(ctx, req)
+}
+
 // DeleteReport calls snitch.v1.ReportService.DeleteReport.
 func (c *reportServiceClient) DeleteReport(ctx context.Context, req *connect.Request[v1.DeleteReportRequest]) (*connect.Response[v1.DeleteReportResponse], error) {
 	return c.deleteReport.CallUnary(ctx, req)
 }
 
+// UpdateReportStatus calls snitch.v1.ReportService.UpdateReportStatus.
+func (c *reportServiceClient) UpdateReportStatus(ctx context.Context, req *connect.Request[v1.UpdateReportStatusRequest]) (*connect.Response[v1.UpdateReportStatusResponse], error) {
+	return c.updateReportStatus.CallUnary(ctx, req)
+}
+
+// ListReportAuditLog calls snitch.v1.ReportService.ListReportAuditLog.
+func (c *reportServiceClient) ListReportAuditLog(ctx context.Context, req *connect.Request[v1.ListReportAuditLogRequest]) (*connect.Response[v1.ListReportAuditLogResponse], error) {
+	return c.listReportAuditLog.CallUnary(ctx, req)
+}
+
+// LookupUser calls snitch.v1.ReportService.LookupUser.
+func (c *reportServiceClient) LookupUser(ctx context.Context, req *connect.Request[v1.LookupUserRequest]) (*connect.Response[v1.LookupUserResponse], error) {
+	return c.lookupUser.CallUnary(ctx, req)
+}
+
 // ReportServiceHandler is an implementation of the snitch.v1.ReportService service.
 type ReportServiceHandler interface {
 	CreateReport(context.Context, *connect.Request[v1.CreateReportRequest]) (*connect.Response[v1.CreateReportResponse], error)
 	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	GetReport(context.Context, *connect.Request[v1.GetReportRequest]) (*connect.Response[v1.GetReportResponse], error)
 	DeleteReport(context.Context, *connect.Request[v1.DeleteReportRequest]) (*connect.Response[v1.DeleteReportResponse], error)
+	UpdateReportStatus(context.Context, *connect.Request[v1.UpdateReportStatusRequest]) (*connect.Response[v1.UpdateReportStatusResponse], error)
+	ListReportAuditLog(context.Context, *connect.Request[v1.ListReportAuditLogRequest]) (*connect.Response[v1.ListReportAuditLogResponse], error)
+	LookupUser(context.Context, *connect.Request[v1.LookupUserRequest]) (*connect.Response[v1.LookupUserResponse], error)
 }
 
 // NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -131,20 +198,52 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(reportServiceMethods.ByName("ListReports")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reportServiceGetReportHandler := connect.NewUnaryHandler(
+		ReportServiceGetReportProcedure,
+		svc.GetReport,
+		connect.WithSchema(reportServiceMethods.ByName("GetReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	reportServiceDeleteReportHandler := connect.NewUnaryHandler(
 		ReportServiceDeleteReportProcedure,
 		svc.DeleteReport,
 		connect.WithSchema(reportServiceMethods.ByName("DeleteReport")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reportServiceUpdateReportStatusHandler := connect.NewUnaryHandler(
+		ReportServiceUpdateReportStatusProcedure,
+		svc.UpdateReportStatus,
+		connect.WithSchema(reportServiceMethods.ByName("UpdateReportStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceListReportAuditLogHandler := connect.NewUnaryHandler(
+		ReportServiceListReportAuditLogProcedure,
+		svc.ListReportAuditLog,
+		connect.WithSchema(reportServiceMethods.ByName("ListReportAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceLookupUserHandler := connect.NewUnaryHandler(
+		ReportServiceLookupUserProcedure,
+		svc.LookupUser,
+		connect.WithSchema(reportServiceMethods.ByName("LookupUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/snitch.v1.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ReportServiceCreateReportProcedure:
 			reportServiceCreateReportHandler.ServeHTTP(w, r)
 		case ReportServiceListReportsProcedure:
 			reportServiceListReportsHandler.ServeHTTP(w, r)
+		case ReportServiceGetReportProcedure:
+			reportServiceGetReportHandler.ServeHTTP(w, r)
 		case ReportServiceDeleteReportProcedure:
 			reportServiceDeleteReportHandler.ServeHTTP(w, r)
+		case ReportServiceUpdateReportStatusProcedure:
+			reportServiceUpdateReportStatusHandler.ServeHTTP(w, r)
+		case ReportServiceListReportAuditLogProcedure:
+			reportServiceListReportAuditLogHandler.ServeHTTP(w, r)
+		case ReportServiceLookupUserProcedure:
+			reportServiceLookupUserHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -162,6 +261,22 @@ func (UnimplementedReportServiceHandler) ListReports(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.ListReports is not implemented"))
 }
 
+func (UnimplementedReportServiceHandler) GetReport(context.Context, *connect.Request[v1.GetReportRequest]) (*connect.Response[v1.GetReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.GetReport is not implemented"))
+}
+
 func (UnimplementedReportServiceHandler) DeleteReport(context.Context, *connect.Request[v1.DeleteReportRequest]) (*connect.Response[v1.DeleteReportResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.DeleteReport is not implemented"))
 }
+
+func (UnimplementedReportServiceHandler) UpdateReportStatus(context.Context, *connect.Request[v1.UpdateReportStatusRequest]) (*connect.Response[v1.UpdateReportStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.UpdateReportStatus is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) ListReportAuditLog(context.Context, *connect.Request[v1.ListReportAuditLogRequest]) (*connect.Response[v1.ListReportAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.ListReportAuditLog is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) LookupUser(context.Context, *connect.Request[v1.LookupUserRequest]) (*connect.Response[v1.LookupUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("snitch.v1.ReportService.LookupUser is not implemented"))
+}
@@ -17,8 +17,9 @@ CERTS_SCRIPT="${BASE_DIR}/scripts/generate-certs.sh"
 
 # Create .env file if it doesn't exist
 if [ ! -f "$ENV_FILE" ]; then
-  printf "SNITCH_DISCORD_TOKEN=REPLACE_ME\n" > "$ENV_FILE"
+  printf "SNITCH_DISCORD_TOKEN=REPLACE_ME\nSNITCH_API_KEY=REPLACE_ME\n" > "$ENV_FILE"
   echo "Created .env file. Please replace SNITCH_DISCORD_TOKEN with your actual Discord bot token."
+  echo "Once the database is running, replace SNITCH_API_KEY with a key from: go run ./cmd/apikey create bot"
 fi
 
 # Generate TLS certificates if they don't exist
@@ -32,9 +33,10 @@ if [ ! -f "${BASE_DIR}/certs/ca/ca-cert.pem" ]; then
     exit 1
   fi
 else
-  echo "TLS certificates found. Verifying..."
-  "$CERTS_SCRIPT" --verify || {
-    echo "Certificate verification failed. Consider regenerating with: $CERTS_SCRIPT --force"
+  # Keeps valid certificates and regenerates those that are missing or lack a key usage added since
+  echo "TLS certificates found. Updating outdated certificates..."
+  "$CERTS_SCRIPT" || {
+    echo "Certificate generation failed. Consider regenerating with: $CERTS_SCRIPT --force"
     exit 1
   }
 fi
@@ -46,7 +46,7 @@ check_openssl() {
 # Create certificate directory structure
 create_dirs() {
     info "Creating certificate directory structure..."
-    mkdir -p "$CERTS_DIR"/{ca,db,backend,bot}
+    mkdir -p "$CERTS_DIR"/{ca,db,backend,bot,admin}
 }
 
 # Generate CA certificate and key
@@ -70,6 +70,37 @@ generate_ca() {
     info "CA certificate generated: $ca_cert"
 }
 
+# Print the extended key usages a service certificate is generated with
+service_key_usage() {
+    case "$1" in
+        "backend")
+            echo "serverAuth, clientAuth"  # Backend also authenticates to the db service
+            ;;
+        "bot"|"admin")
+            echo "clientAuth"  # Bot acts as client; admin is used by cmd/apikey against the db service
+            ;;
+        *)
+            echo "serverAuth"
+            ;;
+    esac
+}
+
+# Check that a certificate carries every extended key usage its service needs
+has_key_usage() {
+    local service="$1"
+    local cert="$2"
+    local text
+    text="$(openssl x509 -in "$cert" -noout -text 2>/dev/null)" || return 1
+
+    local usage
+    for usage in $(service_key_usage "$service" | tr -d ','); do
+        case "$usage" in
+            serverAuth) grep -q "TLS Web Server Authentication" <<< "$text" || return 1 ;;
+            clientAuth) grep -q "TLS Web Client Authentication" <<< "$text" || return 1 ;;
+        esac
+    done
+}
+
 # Generate service certificate
 generate_service_cert() {
     local service="$1"
@@ -83,8 +114,12 @@ generate_service_cert() {
     local ca_cert="$CERTS_DIR/ca/ca-cert.pem"
     
     if [[ -f "$service_cert" && -f "$service_key" ]]; then
-        warn "$service certificate already exists. Skipping $service certificate generation."
-        return 0
+        # Certificates from older versions can lack a key usage, e.g. clientAuth on the backend certificate
+        if has_key_usage "$service" "$service_cert"; then
+            warn "$service certificate already exists. Skipping $service certificate generation."
+            return 0
+        fi
+        warn "$service certificate is missing the $(service_key_usage "$service") key usage. Regenerating it."
     fi
     
     info "Generating $service private key..."
@@ -92,10 +127,11 @@ generate_service_cert() {
     
     # Create certificate configuration
     local cn="snitch-$service"
-    local ext_key_usage="serverAuth"
+    local ext_key_usage
+    ext_key_usage="$(service_key_usage "$service")"
     local ou_name=""
     
-    # Set proper organizational unit names and key usage
+    # Set proper organizational unit names
     case "$service" in
         "db")
             ou_name="Database"
@@ -105,7 +141,9 @@ generate_service_cert() {
             ;;
         "bot")
             ou_name="Bot"
-            ext_key_usage="clientAuth"  # Bot acts as client
+            ;;
+        "admin")
+            ou_name="Admin"
             ;;
         *)
             ou_name="Service"
@@ -156,16 +194,19 @@ EOF
 verify_certificates() {
     local ca_cert="$CERTS_DIR/ca/ca-cert.pem"
     
-    for service in db backend bot; do
+    for service in db backend bot admin; do
         local service_cert="$CERTS_DIR/$service/cert.pem"
         if [[ -f "$service_cert" ]]; then
             info "Verifying $service certificate..."
-            if openssl verify -CAfile "$ca_cert" "$service_cert" > /dev/null 2>&1; then
-                info "✓ $service certificate is valid"
-            else
+            if ! openssl verify -CAfile "$ca_cert" "$service_cert" > /dev/null 2>&1; then
                 error "✗ $service certificate verification failed"
                 exit 1
             fi
+            if ! has_key_usage "$service" "$service_cert"; then
+                error "✗ $service certificate is missing the $(service_key_usage "$service") key usage, run $0 to regenerate it"
+                exit 1
+            fi
+            info "✓ $service certificate is valid"
         fi
     done
 }
@@ -178,7 +219,7 @@ set_permissions() {
     chmod 600 "$CERTS_DIR/ca/ca-key.pem" 2>/dev/null || true
     
     # Service keys should be restrictive
-    for service in db backend bot; do
+    for service in db backend bot admin; do
         chmod 600 "$CERTS_DIR/$service/key.pem" 2>/dev/null || true
     done
     
@@ -195,7 +236,7 @@ show_certificate_info() {
         openssl x509 -in "$ca_cert" -noout -subject -dates
         echo
         
-        for service in db backend bot; do
+        for service in db backend bot admin; do
             local service_cert="$CERTS_DIR/$service/cert.pem"
             if [[ -f "$service_cert" ]]; then
                 info "$service Service Certificate Information:"
@@ -216,7 +257,7 @@ main() {
     create_dirs
     generate_ca
     
-    for service in db backend bot; do
+    for service in db backend bot admin; do
         generate_service_cert "$service"
     done
     